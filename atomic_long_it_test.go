@@ -18,6 +18,7 @@ package hazelcast_test
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"sort"
 	"testing"
@@ -25,6 +26,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	hz "github.com/hazelcast/hazelcast-go-client"
+	"github.com/hazelcast/hazelcast-go-client/hzerrors"
 	"github.com/hazelcast/hazelcast-go-client/internal/it"
 	"github.com/hazelcast/hazelcast-go-client/internal/it/skip"
 	"github.com/hazelcast/hazelcast-go-client/serialization"
@@ -42,6 +44,7 @@ func TestAtomicLong(t *testing.T) {
 		{name: "AtomicLongAlter", f: atomicLongAlterTest},
 		{name: "AtomicLongAlterAndGet", f: atomicLongAlterAndGetTest},
 		{name: "AtomicLongApply", f: atomicLongApplyTest},
+		{name: "AtomicLongApplyFunctionError", f: atomicLongApplyFunctionErrorTest},
 		{name: "AtomicLongCompareAndSet_Fail", f: atomicLongCompareAndSetFailTest},
 		{name: "AtomicLongCompareAndSet_Success", f: atomicLongCompareAndSetSuccessTest},
 		{name: "AtomicLongDecrementAndGet", f: atomicLongDecrementAndGetTest},
@@ -51,6 +54,9 @@ func TestAtomicLong(t *testing.T) {
 		{name: "AtomicLongGetAndDecrement", f: atomicLongGetAndDecrementTest},
 		{name: "AtomicLongGetAndIncrement", f: atomicLongGetAndIncrementTest},
 		{name: "AtomicLongGetAndSet", f: atomicLongGetAndSetTest},
+		{name: "AtomicLongGetAtomicLongs", f: atomicLongGetAtomicLongsTest},
+		{name: "AtomicLongGetCached", f: atomicLongGetCachedTest},
+		{name: "AtomicLongGetInvalidName", f: atomicLongGetInvalidNameTest},
 		{name: "AtomicLongIncrementAndGet", f: atomicLongIncrementAndGetTest},
 		{name: "AtomicLongSet", f: atomicLongSetTest},
 	}
@@ -80,6 +86,65 @@ func atomicLongSetTest(t *testing.T) {
 	})
 }
 
+func atomicLongGetAtomicLongsTest(t *testing.T) {
+	it.CPSubsystemTester(t, func(t *testing.T, cp hz.CPSubsystem) {
+		ctx := context.Background()
+		names := []string{
+			it.NewUniqueObjectName("atomic-long"),
+			it.NewUniqueObjectName("atomic-long") + "@group1",
+			it.NewUniqueObjectName("atomic-long") + "@group1",
+			it.NewUniqueObjectName("atomic-long") + "@default",
+		}
+		als, err := cp.GetAtomicLongs(ctx, names...)
+		require.NoError(t, err)
+		require.Len(t, als, len(names))
+		for i, al := range als {
+			require.NoError(t, al.Set(ctx, int64(i+1)))
+		}
+		// the proxies returned by GetAtomicLong refer to the same counters
+		for i, name := range names {
+			al, err := cp.GetAtomicLong(ctx, name)
+			require.NoError(t, err)
+			v, err := al.Get(ctx)
+			require.NoError(t, err)
+			require.Equal(t, int64(i+1), v)
+		}
+		for _, al := range als {
+			require.NoError(t, al.Destroy(ctx))
+		}
+	})
+}
+
+func atomicLongGetCachedTest(t *testing.T) {
+	it.CPSubsystemTester(t, func(t *testing.T, cp hz.CPSubsystem) {
+		ctx := context.Background()
+		name := it.NewUniqueObjectName("atomic-long")
+		al, err := cp.GetAtomicLong(ctx, name)
+		require.NoError(t, err)
+		// the default group name does not change the proxy
+		for _, n := range []string{name, name + "@default"} {
+			cached, err := cp.GetAtomicLong(ctx, n)
+			require.NoError(t, err)
+			require.Same(t, al, cached)
+		}
+		als, err := cp.GetAtomicLongs(ctx, name)
+		require.NoError(t, err)
+		require.Same(t, al, als[0])
+		require.NoError(t, al.Destroy(ctx))
+	})
+}
+
+func atomicLongGetInvalidNameTest(t *testing.T) {
+	it.CPSubsystemTester(t, func(t *testing.T, cp hz.CPSubsystem) {
+		for _, name := range []string{"", "@group", "counter@", "counter@metadata"} {
+			_, err := cp.GetAtomicLong(context.Background(), name)
+			if !errors.Is(err, hzerrors.ErrIllegalArgument) {
+				t.Fatalf("name %q: expected ErrIllegalArgument, got: %v", name, err)
+			}
+		}
+	})
+}
+
 func atomicLongGetTest(t *testing.T) {
 	// ported from: com.hazelcast.cp.internal.datastructures.atomiclong.AbstractAtomicLongBasicTest#testGet
 	it.AtomicLongTester(t, func(t *testing.T, a *hz.AtomicLong) {
@@ -214,6 +279,25 @@ func atomicLongApplyTest(t *testing.T) {
 	})
 }
 
+func atomicLongApplyFunctionErrorTest(t *testing.T) {
+	it.AtomicLongTester(t, func(t *testing.T, a *hz.AtomicLong) {
+		ctx := context.Background()
+		require.NoError(t, a.Set(ctx, 2))
+		// the member does not have a factory for the function, so it fails deserializing it.
+		_, err := a.Apply(ctx, &unknownFunction{})
+		var fe *hzerrors.FunctionError
+		require.True(t, errors.As(err, &fe))
+		require.Equal(t, "com.hazelcast.nio.serialization.HazelcastSerializationException", fe.ClassName)
+		require.Contains(t, fe.Message, fmt.Sprint(unknownFunctionFactoryID))
+		err = a.Alter(ctx, &unknownFunction{})
+		require.True(t, errors.As(err, &fe))
+		require.Equal(t, "com.hazelcast.nio.serialization.HazelcastSerializationException", fe.ClassName)
+		v, err := a.Get(ctx)
+		require.NoError(t, err)
+		require.Equal(t, int64(2), v)
+	})
+}
+
 func atomicLongAlterTest(t *testing.T) {
 	// ported from: com.hazelcast.cp.internal.datastructures.atomiclong.AbstractAtomicLongBasicTest#testAlter
 	cb := func(c *hz.Config) {
@@ -298,3 +382,19 @@ func (f MultiplicationFactory) Create(id int32) serialization.IdentifiedDataSeri
 func (f MultiplicationFactory) FactoryID() int32 {
 	return multiplicationFactoryID
 }
+
+const unknownFunctionFactoryID = 6666
+
+type unknownFunction struct{}
+
+func (f unknownFunction) FactoryID() int32 {
+	return unknownFunctionFactoryID
+}
+
+func (f unknownFunction) ClassID() int32 {
+	return 1
+}
+
+func (f unknownFunction) WriteData(output serialization.DataOutput) {}
+
+func (f *unknownFunction) ReadData(input serialization.DataInput) {}
@@ -34,11 +34,13 @@ func TestAtomicRef(t *testing.T) {
 	}{
 		{name: "atomicRefClear", f: atomicRefClearTest},
 		{name: "atomicRefCompareAndSet", f: atomicRefCompareAndSetTest},
+		{name: "atomicRefCompareAndSetFailure", f: atomicRefCompareAndSetFailureTest},
 		{name: "atomicRefContains", f: atomicRefContainsTest},
 		{name: "atomicRefGet", f: atomicRefGetTest},
 		{name: "atomicRefGetAndSet", f: atomicRefGetAndSetTest},
 		{name: "atomicRefIsNil", f: atomicRefIsNilTest},
 		{name: "atomicRefName", f: atomicRefNametest},
+		{name: "atomicRefNil", f: atomicRefNilTest},
 	}
 	for _, tc := range testCases {
 		t.Run(tc.name, tc.f)
@@ -56,6 +58,25 @@ func atomicRefCompareAndSetTest(t *testing.T) {
 	})
 }
 
+func atomicRefCompareAndSetFailureTest(t *testing.T) {
+	tcx := it.AtomicRefTestContext{T: t}
+	tcx.Tester(func(tcx *it.AtomicRefTestContext) {
+		ctx := context.Background()
+		check.Must(tcx.A.Set(ctx, "foo"))
+		// the expected value does not match the current value.
+		ok := check.MustValue(tcx.A.CompareAndSet(ctx, "bar", "baz"))
+		require.False(t, ok)
+		ok = check.MustValue(tcx.A.CompareAndSet(ctx, nil, "baz"))
+		require.False(t, ok)
+		// the values are compared by their serialized form, so int32(1) does not match int64(1).
+		check.Must(tcx.A.Set(ctx, int64(1)))
+		ok = check.MustValue(tcx.A.CompareAndSet(ctx, int32(1), "baz"))
+		require.False(t, ok)
+		v := check.MustValue(tcx.A.Get(ctx))
+		require.Equal(t, int64(1), v)
+	})
+}
+
 func atomicRefGetTest(t *testing.T) {
 	tcx := it.AtomicRefTestContext{T: t}
 	tcx.Tester(func(tcx *it.AtomicRefTestContext) {
@@ -103,6 +124,28 @@ func atomicRefIsNilTest(t *testing.T) {
 	})
 }
 
+func atomicRefNilTest(t *testing.T) {
+	tcx := it.AtomicRefTestContext{T: t}
+	tcx.Tester(func(tcx *it.AtomicRefTestContext) {
+		ctx := context.Background()
+		found := check.MustValue(tcx.A.Contains(ctx, nil))
+		require.True(t, found)
+		check.Must(tcx.A.Set(ctx, "foo"))
+		found = check.MustValue(tcx.A.Contains(ctx, nil))
+		require.False(t, found)
+		ok := check.MustValue(tcx.A.CompareAndSet(ctx, "foo", nil))
+		require.True(t, ok)
+		require.True(t, check.MustValue(tcx.A.IsNil(ctx)))
+		check.Must(tcx.A.Set(ctx, "bar"))
+		v := check.MustValue(tcx.A.GetAndSet(ctx, nil))
+		require.Equal(t, "bar", v)
+		require.True(t, check.MustValue(tcx.A.IsNil(ctx)))
+		check.Must(tcx.A.Set(ctx, nil))
+		v = check.MustValue(tcx.A.Get(ctx))
+		require.Nil(t, v)
+	})
+}
+
 func atomicRefClearTest(t *testing.T) {
 	tcx := it.AtomicRefTestContext{T: t}
 	tcx.Tester(func(tcx *it.AtomicRefTestContext) {
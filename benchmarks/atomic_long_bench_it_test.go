@@ -0,0 +1,66 @@
+/*
+ * Copyright (c) 2008-2023, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package benchmarks_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	hz "github.com/hazelcast/hazelcast-go-client"
+	"github.com/hazelcast/hazelcast-go-client/internal/it"
+)
+
+const atomicLongCount = 100
+
+func BenchmarkAtomicLong_InitSequential(b *testing.B) {
+	it.CPSubsystemBenchmarker(b, func(b *testing.B, cp hz.CPSubsystem) {
+		ctx := context.Background()
+		for i := 0; i < b.N; i++ {
+			for _, name := range makeAtomicLongNames(i) {
+				al, err := cp.GetAtomicLong(ctx, name)
+				if err != nil {
+					b.Fatal(err)
+				}
+				it.Must(al.Set(ctx, 1))
+			}
+		}
+	})
+}
+
+func BenchmarkAtomicLong_InitBatched(b *testing.B) {
+	it.CPSubsystemBenchmarker(b, func(b *testing.B, cp hz.CPSubsystem) {
+		ctx := context.Background()
+		for i := 0; i < b.N; i++ {
+			als, err := cp.GetAtomicLongs(ctx, makeAtomicLongNames(i)...)
+			if err != nil {
+				b.Fatal(err)
+			}
+			for _, al := range als {
+				it.Must(al.Set(ctx, 1))
+			}
+		}
+	})
+}
+
+func makeAtomicLongNames(i int) []string {
+	names := make([]string, atomicLongCount)
+	for j := range names {
+		names[j] = fmt.Sprintf("bm-counter-%d-%d@bm-group", i, j)
+	}
+	return names
+}
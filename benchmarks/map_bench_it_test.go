@@ -19,10 +19,13 @@ package benchmarks_test
 import (
 	"context"
 	"fmt"
+	"math/rand"
 	"testing"
 
 	hz "github.com/hazelcast/hazelcast-go-client"
 	"github.com/hazelcast/hazelcast-go-client/internal/it"
+	"github.com/hazelcast/hazelcast-go-client/logger"
+	"github.com/hazelcast/hazelcast-go-client/nearcache"
 )
 
 const kb = 1024
@@ -120,6 +123,38 @@ func BenchmarkMap_GetParallel(b *testing.B) {
 	})
 }
 
+func BenchmarkMap_NearCacheSetGetSameKey(b *testing.B) {
+	testCases := []struct {
+		name   string
+		prefix string
+		policy nearcache.LocalUpdatePolicy
+	}{
+		{name: "Invalidate", prefix: "bm-nc-invalidate", policy: nearcache.LocalUpdatePolicyInvalidate},
+		{name: "CacheOnUpdate", prefix: "bm-nc-cache-on-update", policy: nearcache.LocalUpdatePolicyCacheOnUpdate},
+	}
+	for _, tc := range testCases {
+		b.Run(tc.name, func(b *testing.B) {
+			makeMapName := func() string {
+				return fmt.Sprintf("%s-%d", tc.prefix, rand.Int())
+			}
+			configCallback := func(cfg *hz.Config) {
+				cfg.Logger.Level = logger.WarnLevel
+				cfg.AddNearCache(nearcache.Config{
+					Name:              tc.prefix + "-*",
+					LocalUpdatePolicy: tc.policy,
+				})
+			}
+			it.MapBenchmarkerWithConfigAndName(b, makeMapName, configCallback, nil, func(b *testing.B, m *hz.Map) {
+				ctx := context.Background()
+				for i := 0; i < b.N; i++ {
+					it.Must(m.Set(ctx, "key", i))
+					it.MustValue(m.Get(ctx, "key"))
+				}
+			})
+		})
+	}
+}
+
 func makeByteArrayPayload(size int) []byte {
 	payload := make([]byte, size)
 	for i := 0; i < len(payload); i++ {
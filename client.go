@@ -36,6 +36,7 @@ import (
 	"github.com/hazelcast/hazelcast-go-client/internal/serialization"
 	isql "github.com/hazelcast/hazelcast-go-client/internal/sql"
 	"github.com/hazelcast/hazelcast-go-client/internal/stats"
+	"github.com/hazelcast/hazelcast-go-client/nearcache"
 	"github.com/hazelcast/hazelcast-go-client/sql"
 	"github.com/hazelcast/hazelcast-go-client/types"
 )
@@ -48,7 +49,12 @@ const (
 type AtomicLong = icp.AtomicLong
 type AtomicRef = icp.AtomicRef
 type CPMap = icp.Map
+type CountDownLatch = icp.CountDownLatch
 type CPSubsystem = icp.Subsystem
+type FencedLock = icp.FencedLock
+
+// InvalidFence is the fencing token returned by the FencedLock functions when the lock is not acquired.
+const InvalidFence = icp.InvalidFence
 
 func NewLockContext(ctx context.Context) context.Context {
 	return iproxy.NewLockContext(ctx)
@@ -78,7 +84,11 @@ type Client struct {
 	membershipListenerMapMu *sync.Mutex
 	proxyManager            *proxyManager
 	membershipListenerMap   map[types.UUID]int64
+	membersCoalescers       map[types.UUID]*icluster.MembersCoalescer
+	connectedMembersMap     map[types.UUID]int64
+	connectedMembersMapMu   *sync.Mutex
 	lifecycleListenerMap    map[types.UUID]int64
+	lifecycleListenerKeys   map[string]types.UUID
 	lifecycleListenerMapMu  *sync.Mutex
 	ic                      *client.Client
 	sqlService              isql.Service
@@ -86,7 +96,9 @@ type Client struct {
 	nearCacheMgrsMu         *sync.RWMutex
 	nearCacheMgrs           map[string]*inearcache.Manager
 	cfg                     *Config
-	doneCh                  chan struct{}
+	// preloadCtx is cancelled when the client shuts down, so that Near Cache preloading stops.
+	preloadCtx    context.Context
+	cancelPreload context.CancelFunc
 }
 
 func newClient(config Config) (*Client, error) {
@@ -97,11 +109,14 @@ func newClient(config Config) (*Client, error) {
 	var c *Client
 	icc := &client.Config{
 		Name:          config.ClientName,
+		NamePrefix:    config.ClientNamePrefix,
+		UniqueName:    config.UniqueClientName,
 		Cluster:       &config.Cluster,
 		Failover:      &config.Failover,
 		Serialization: &config.Serialization,
 		Logger:        &config.Logger,
 		Labels:        config.Labels,
+		UUIDSource:    config.uuidSource,
 		StatsEnabled:  config.Stats.Enabled,
 		StatsPeriod:   time.Duration(config.Stats.Period),
 	}
@@ -113,15 +128,19 @@ func newClient(config Config) (*Client, error) {
 	}
 	c = &Client{
 		ic:                      ic,
+		connectedMembersMap:     map[types.UUID]int64{},
+		connectedMembersMapMu:   &sync.Mutex{},
 		lifecycleListenerMap:    map[types.UUID]int64{},
+		lifecycleListenerKeys:   map[string]types.UUID{},
 		lifecycleListenerMapMu:  &sync.Mutex{},
 		membershipListenerMap:   map[types.UUID]int64{},
+		membersCoalescers:       map[types.UUID]*icluster.MembersCoalescer{},
 		membershipListenerMapMu: &sync.Mutex{},
 		nearCacheMgrsMu:         &sync.RWMutex{},
 		nearCacheMgrs:           map[string]*inearcache.Manager{},
 		cfg:                     &config,
-		doneCh:                  make(chan struct{}),
 	}
+	c.preloadCtx, c.cancelPreload = context.WithCancel(context.Background())
 	if c.ic.StatsService != nil {
 		c.ic.StatsService.SetNCStatsGetter(func(service string) stats.NearCacheStatsGetter {
 			ncmgr, ok := c.nearCacheMgrs[service]
@@ -133,44 +152,15 @@ func newClient(config Config) (*Client, error) {
 	}
 	c.addConfigEvents(&config)
 	c.createComponents(&config)
+	c.ic.AddBeforeShutdownHandler(c.stopNearCachePreloads)
 	c.ic.AddBeforeShutdownHandler(c.destroyProxies)
+	c.ic.AddBeforeShutdownHandler(c.stopLockLeaseRenewals)
+	c.ic.AddBeforeShutdownHandler(c.stopMembersCoalescers)
+	c.ic.AddBeforeShutdownHandler(c.closeCPSessions)
 	c.ic.AddAfterShutdownHandler(c.stopNearCacheManagers)
-	c.ic.AddAfterShutdownHandler(func(ctx context.Context) {
-		close(c.doneCh)
-	})
-	go c.schemaInvoker(schemaCh)
 	return c, nil
 }
 
-func (c *Client) schemaInvoker(ch chan serialization.SchemaMsg) {
-	c.ic.Logger.Debug(func() string {
-		return "Started the schema invoker"
-	})
-	ctx, cancel := context.WithCancel(context.Background())
-	defer cancel()
-	for {
-		select {
-		case msg := <-ch:
-			req := codec.EncodeClientFetchSchemaRequest(msg.ID)
-			resp, err := c.ic.Invoker.InvokeOnRandomTarget(ctx, req, nil)
-			if err != nil {
-				c.ic.Logger.Errorf("invoking ClientFetchSchema with schema ID: %d: %s", msg.ID, err)
-				msg.ResponseCh <- nil
-				continue
-			}
-			schema := codec.DecodeClientFetchSchemaResponse(resp)
-			msg.ResponseCh <- schema
-		case <-ctx.Done():
-			c.ic.Logger.Debug(func() string {
-				return "Stopped the schema invoker"
-			})
-			return
-		case <-c.doneCh:
-			cancel()
-		}
-	}
-}
-
 // Name returns client's name
 // Use config.Name to set the client name.
 // If not set manually, an automatically generated name is used.
@@ -215,6 +205,10 @@ func (c *Client) GetMap(ctx context.Context, name string) (*Map, error) {
 			return nil, err
 		}
 		m.hasNearCache = true
+		if ncc.Preloader.Enabled {
+			// preloading starts only after the Near Cache map is complete, since it uses the Near Cache map.
+			go m.ncm.preload(c.preloadCtx, m)
+		}
 		return m, nil
 	})
 }
@@ -288,6 +282,110 @@ func (c *Client) GetDistributedObjectsInfo(ctx context.Context) ([]types.Distrib
 	return codec.DecodeClientGetDistributedObjectsResponse(resp), nil
 }
 
+// NearCacheNames returns the names of the maps which have a Near Cache on this client, in ascending order.
+// A Near Cache is created when a map with a matching Near Cache configuration is retrieved with GetMap.
+func (c *Client) NearCacheNames() []string {
+	c.nearCacheMgrsMu.RLock()
+	mgr, ok := c.nearCacheMgrs[ServiceNameMap]
+	c.nearCacheMgrsMu.RUnlock()
+	if !ok {
+		return []string{}
+	}
+	return mgr.NearCacheNames()
+}
+
+// NearCacheConfig returns the Near Cache configuration which applies to the map with the given name.
+// The configuration is resolved with the same wildcard matching rules used by GetMap, see Config.GetNearCache.
+// Returns false if no configuration applies to the map.
+func (c *Client) NearCacheConfig(name string) (nearcache.Config, bool, error) {
+	ncc, ok, err := c.cfg.GetNearCache(name)
+	if err != nil || !ok {
+		return nearcache.Config{}, false, err
+	}
+	return ncc.Clone(), true, nil
+}
+
+// AddMapConfig adds the given map configuration to the cluster.
+// The configuration is used for maps created after this call with a matching name.
+// If a map configuration with the same name already exists on the cluster, an error is returned, unless both configurations are the same.
+func (c *Client) AddMapConfig(ctx context.Context, config MapConfig) error {
+	if c.ic.State() != client.Ready {
+		return hzerrors.ErrClientNotActive
+	}
+	config = config.Clone()
+	if err := config.Validate(); err != nil {
+		return err
+	}
+	request := codec.EncodeDynamicConfigAddMapConfigRequest(
+		config.Name,
+		int32(config.BackupCount()),
+		int32(config.AsyncBackupCount),
+		int32(config.TimeToLiveSeconds),
+		int32(config.MaxIdleSeconds),
+		config.ReadBackupData,
+		mapCacheDeserializedMode,
+		config.MergePolicy.Policy,
+		int32(config.MergePolicy.BatchSize),
+		mapInMemoryFormat,
+		true,
+		0,
+		false,
+	)
+	_, err := c.proxyManager.invokeOnRandomTarget(ctx, request, nil)
+	return err
+}
+
+// ClusterVersion returns the version of the cluster.
+// The cluster operates at the lowest major and minor version of its members, e.g., during a rolling upgrade.
+// So the returned version is the lowest major and minor version of the members, and its patch version is always 0.
+func (c *Client) ClusterVersion(ctx context.Context) (cluster.MemberVersion, error) {
+	if c.ic.State() != client.Ready {
+		return cluster.MemberVersion{}, hzerrors.ErrClientNotActive
+	}
+	mems := c.ic.ClusterService.OrderedMembers()
+	if len(mems) == 0 {
+		return cluster.MemberVersion{}, fmt.Errorf("member list is not available: %w", hzerrors.ErrIllegalState)
+	}
+	v := cluster.MemberVersion{Major: mems[0].Version.Major, Minor: mems[0].Version.Minor}
+	for _, mem := range mems[1:] {
+		if mem.Version.MajorMinor() < v.MajorMinor() {
+			v = cluster.MemberVersion{Major: mem.Version.Major, Minor: mem.Version.Minor}
+		}
+	}
+	return v, nil
+}
+
+// ClusterCapabilities returns the features which are supported by the cluster.
+// See cluster.Capabilities for the available features.
+func (c *Client) ClusterCapabilities(ctx context.Context) (cluster.Capabilities, error) {
+	v, err := c.ClusterVersion(ctx)
+	if err != nil {
+		return cluster.Capabilities{}, err
+	}
+	return cluster.CapabilitiesForVersion(v), nil
+}
+
+// ConnectedServerVersions returns the Hazelcast versions of the members the client is connected to, keyed by member UUID.
+// The version of a member is reported by the member when the client authenticates to it.
+// Unlike ClusterVersion, the versions include the patch version, e.g., 5.2.1.
+// Returns an empty map if the client is not connected to any members.
+func (c *Client) ConnectedServerVersions() map[types.UUID]string {
+	conns := c.ic.ConnectionManager.ActiveConnections()
+	vs := make(map[types.UUID]string, len(conns))
+	for _, conn := range conns {
+		vs[conn.MemberUUID()] = conn.ServerVersion()
+	}
+	return vs
+}
+
+// SelectedMembers returns the members in the most recent member list of the cluster for which the selector returns true.
+// Use cluster.MemberWithAttribute to select the members with a member attribute, e.g., the ones tagged with "role=analytics".
+// The order of the member list is preserved.
+// Returns an empty slice if the member list is not available yet.
+func (c *Client) SelectedMembers(selector cluster.MemberSelector) []cluster.MemberInfo {
+	return c.ic.ClusterService.SelectedMembers(selector)
+}
+
 // Shutdown disconnects the client from the cluster and frees resources allocated by the client.
 func (c *Client) Shutdown(ctx context.Context) error {
 	return c.ic.Shutdown(ctx)
@@ -301,6 +399,8 @@ func (c *Client) Running() bool {
 // AddLifecycleListener adds a lifecycle state change handler after the client starts.
 // Use the returned subscription ID to remove the listener.
 // The handler must not block.
+// Each call adds a new listener, so adding the same handler twice causes it to be called twice for each event.
+// Use AddLifecycleListenerWithKey to avoid that.
 func (c *Client) AddLifecycleListener(handler LifecycleStateChangeHandler) (types.UUID, error) {
 	if c.ic.State() >= client.Stopping {
 		return types.UUID{}, hzerrors.ErrClientNotActive
@@ -314,6 +414,28 @@ func (c *Client) AddLifecycleListener(handler LifecycleStateChangeHandler) (type
 	return uuid, nil
 }
 
+// AddLifecycleListenerWithKey adds a lifecycle state change handler identified by the given key after the client starts.
+// If a listener with the same key was already added and not removed, the handler is not added and the subscription ID of the existing listener is returned.
+// That makes it safe to call AddLifecycleListenerWithKey more than once for the same handler, e.g., in an initialization function which may run several times.
+// Use the returned subscription ID to remove the listener.
+// The handler must not block.
+func (c *Client) AddLifecycleListenerWithKey(key string, handler LifecycleStateChangeHandler) (types.UUID, error) {
+	if c.ic.State() >= client.Stopping {
+		return types.UUID{}, hzerrors.ErrClientNotActive
+	}
+	c.lifecycleListenerMapMu.Lock()
+	defer c.lifecycleListenerMapMu.Unlock()
+	if uuid, ok := c.lifecycleListenerKeys[key]; ok {
+		return uuid, nil
+	}
+	uuid := types.NewUUID()
+	subscriptionID := event.NextSubscriptionID()
+	c.addLifecycleListener(subscriptionID, handler)
+	c.lifecycleListenerMap[uuid] = subscriptionID
+	c.lifecycleListenerKeys[key] = uuid
+	return uuid, nil
+}
+
 // RemoveLifecycleListener removes the lifecycle state change handler with the given subscription ID
 func (c *Client) RemoveLifecycleListener(subscriptionID types.UUID) error {
 	if c.ic.State() >= client.Stopping {
@@ -323,6 +445,12 @@ func (c *Client) RemoveLifecycleListener(subscriptionID types.UUID) error {
 	if intID, ok := c.lifecycleListenerMap[subscriptionID]; ok {
 		c.ic.EventDispatcher.Unsubscribe(eventLifecycleEventStateChanged, intID)
 		delete(c.lifecycleListenerMap, subscriptionID)
+		for key, uuid := range c.lifecycleListenerKeys {
+			if uuid == subscriptionID {
+				delete(c.lifecycleListenerKeys, key)
+				break
+			}
+		}
 	}
 	c.lifecycleListenerMapMu.Unlock()
 	return nil
@@ -343,7 +471,30 @@ func (c *Client) AddMembershipListener(handler cluster.MembershipStateChangeHand
 	return uuid, nil
 }
 
-// RemoveMembershipListener removes the member state change handler with the given subscription ID.
+/*
+AddMembersChangedListener adds a handler which is called with the net change of the members and returns a unique subscription ID.
+Membership changes are collected for the duration of config.Cluster.MembersChangedWindow, then the handler is called once with the members added and removed within that window.
+It is useful to avoid being overwhelmed by membership events when many members of a large cluster restart.
+Use RemoveMembershipListener with the returned subscription ID to remove the listener.
+*/
+func (c *Client) AddMembersChangedListener(handler cluster.MembersChangedHandler) (types.UUID, error) {
+	if c.ic.State() >= client.Stopping {
+		return types.UUID{}, hzerrors.ErrClientNotActive
+	}
+	uuid := types.NewUUID()
+	subscriptionID := event.NextSubscriptionID()
+	mc := icluster.NewMembersCoalescer(time.Duration(c.cfg.Cluster.MembersChangedWindow), handler)
+	c.ic.EventDispatcher.Subscribe(icluster.EventMembers, subscriptionID, func(event event.Event) {
+		mc.Handle(event.(*icluster.MembersStateChangedEvent))
+	})
+	c.membershipListenerMapMu.Lock()
+	c.membershipListenerMap[uuid] = subscriptionID
+	c.membersCoalescers[uuid] = mc
+	c.membershipListenerMapMu.Unlock()
+	return uuid, nil
+}
+
+// RemoveMembershipListener removes the member state change or members changed handler with the given subscription ID.
 func (c *Client) RemoveMembershipListener(subscriptionID types.UUID) error {
 	if c.ic.State() >= client.Stopping {
 		return hzerrors.ErrClientNotActive
@@ -353,10 +504,54 @@ func (c *Client) RemoveMembershipListener(subscriptionID types.UUID) error {
 		c.ic.EventDispatcher.Unsubscribe(icluster.EventMembers, intID)
 		delete(c.membershipListenerMap, subscriptionID)
 	}
+	if mc, ok := c.membersCoalescers[subscriptionID]; ok {
+		// the pending window must not call the handler after the listener is removed.
+		mc.Stop()
+		delete(c.membersCoalescers, subscriptionID)
+	}
 	c.membershipListenerMapMu.Unlock()
 	return nil
 }
 
+/*
+AddConnectedMembersListener adds a handler which is called when the set of members the client holds connections to changes and returns a unique subscription ID.
+Unlike AddMembershipListener, the handler reports the connections opened and closed by the client, e.g., due to smart routing, rather than the cluster membership.
+A member is reported as added when its first connection is opened and as removed when its last connection is closed.
+The members which are already connected when the listener is added are not reported.
+Use RemoveConnectedMembersListener with the returned subscription ID to remove the listener.
+*/
+func (c *Client) AddConnectedMembersListener(handler cluster.ConnectedMembersChangedHandler) (types.UUID, error) {
+	if c.ic.State() >= client.Stopping {
+		return types.UUID{}, hzerrors.ErrClientNotActive
+	}
+	uuid := types.NewUUID()
+	subscriptionID := event.NextSubscriptionID()
+	tracker := icluster.NewConnectedMembersTracker(handler)
+	c.ic.EventDispatcher.Subscribe(icluster.EventConnection, subscriptionID, func(event event.Event) {
+		tracker.Handle(event.(*icluster.ConnectionStateChangedEvent))
+	})
+	// seeding after subscribing makes sure a connection opened in between is not missed.
+	tracker.Seed(c.ic.ConnectionManager.ActiveConnections())
+	c.connectedMembersMapMu.Lock()
+	c.connectedMembersMap[uuid] = subscriptionID
+	c.connectedMembersMapMu.Unlock()
+	return uuid, nil
+}
+
+// RemoveConnectedMembersListener removes the connected members handler with the given subscription ID.
+func (c *Client) RemoveConnectedMembersListener(subscriptionID types.UUID) error {
+	if c.ic.State() >= client.Stopping {
+		return hzerrors.ErrClientNotActive
+	}
+	c.connectedMembersMapMu.Lock()
+	if intID, ok := c.connectedMembersMap[subscriptionID]; ok {
+		c.ic.EventDispatcher.Unsubscribe(icluster.EventConnection, intID)
+		delete(c.connectedMembersMap, subscriptionID)
+	}
+	c.connectedMembersMapMu.Unlock()
+	return nil
+}
+
 // AddDistributedObjectListener adds a distributed object listener and returns a unique subscription ID.
 // Use the returned subscription ID to remove the listener.
 func (c *Client) AddDistributedObjectListener(ctx context.Context, handler DistributedObjectNotifiedHandler) (types.UUID, error) {
@@ -469,6 +664,8 @@ func (c *Client) createComponents(config *Config) {
 		ListenerBinder:       listenerBinder,
 		Logger:               c.ic.Logger,
 		Invoker:              c.ic.Invoker,
+		LockLeaseRenewer:     newLockLeaseRenewer(c.ic.Logger),
+		EventDispatcher:      c.ic.EventDispatcher,
 	}
 	destroyNearCacheFun := func(service, object string) {
 		c.nearCacheMgrsMu.RLock()
@@ -481,7 +678,7 @@ func (c *Client) createComponents(config *Config) {
 	}
 	proxyManagerServiceBundle.NCMDestroyFn = destroyNearCacheFun
 	c.proxyManager = newProxyManager(proxyManagerServiceBundle)
-	c.cpSubsystem = icp.NewSubsystem(c.ic.SerializationService, c.ic.InvocationFactory, c.ic.InvocationService, &c.ic.Logger)
+	c.cpSubsystem = icp.NewSubsystem(c.ic.SerializationService, c.ic.InvocationFactory, c.ic.InvocationService, &c.ic.Logger, c.ic.Name())
 	c.sqlService = isql.NewService(c.ic.ConnectionManager, c.ic.SerializationService, c.ic.Invoker, &c.ic.Logger)
 }
 
@@ -515,6 +712,26 @@ func (c *Client) stopNearCacheManagers(ctx context.Context) {
 	c.nearCacheMgrsMu.RUnlock()
 }
 
+func (c *Client) stopNearCachePreloads(ctx context.Context) {
+	c.cancelPreload()
+}
+
+func (c *Client) stopLockLeaseRenewals(ctx context.Context) {
+	c.proxyManager.serviceBundle.LockLeaseRenewer.Stop(ctx)
+}
+
+func (c *Client) stopMembersCoalescers(ctx context.Context) {
+	c.membershipListenerMapMu.Lock()
+	for _, mc := range c.membersCoalescers {
+		mc.Stop()
+	}
+	c.membershipListenerMapMu.Unlock()
+}
+
+func (c *Client) closeCPSessions(ctx context.Context) {
+	icp.CloseSessions(ctx, c.cpSubsystem)
+}
+
 func (c *Client) destroyProxies(ctx context.Context) {
 	c.proxyManager.destroyProxies(ctx)
 }
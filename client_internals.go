@@ -119,6 +119,12 @@ func (ci *ClientInternal) OrderedMembers() []pubcluster.MemberInfo {
 	return ci.client.ic.ClusterService.OrderedMembers()
 }
 
+// SelectedMembers returns the members in the most recent member list which are selected by the given selector.
+// The returned members can be targeted with InvokeOnMember.
+func (ci *ClientInternal) SelectedMembers(selector pubcluster.MemberSelector) []pubcluster.MemberInfo {
+	return ci.client.ic.ClusterService.SelectedMembers(selector)
+}
+
 // ConnectedToMember returns true if there is a connection to the given member.
 func (ci *ClientInternal) ConnectedToMember(uuid types.UUID) bool {
 	return ci.client.ic.ConnectionManager.GetConnectionForUUID(uuid) != nil
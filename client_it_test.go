@@ -23,6 +23,7 @@ import (
 	"log"
 	"reflect"
 	"runtime"
+	"strings"
 	"sync"
 	"sync/atomic"
 	"testing"
@@ -46,8 +47,12 @@ func TestClient(t *testing.T) {
 		name string
 		f    func(t *testing.T)
 	}{
+		{name: "AddConnectedMembersListener", f: clientAddConnectedMembersListenerTest},
 		{name: "AddDistributedObjectListener", f: clientAddDistributedObjectListenerTest},
 		{name: "AddLifecycleListener", f: clientAddLifecycleListenerTest},
+		{name: "AddLifecycleListenerWithKey", f: clientAddLifecycleListenerWithKeyTest},
+		{name: "AddMapConfig", f: clientAddMapConfigTest},
+		{name: "AddMapConfigDefaultTTL", f: clientAddMapConfigDefaultTTLTest},
 		{name: "AddMembershipListener", f: clientAddMembershipListenerTest},
 		{name: "ClusterReconnectionReconnectModeOff", f: clientClusterReconnectionReconnectModeOffTest},
 		{name: "ClusterReconnectionShutdownCluster", f: clientClusterReconnectionShutdownClusterTest},
@@ -62,17 +67,22 @@ func TestClient(t *testing.T) {
 		{name: "GetDistributedObjects", f: clientGetDistributedObjectsTest},
 		{name: "GetProxyInstance", f: clientGetProxyInstanceTest},
 		{name: "Heartbeat", f: clientHeartbeatTest},
+		{name: "ClusterVersion", f: clientClusterVersionTest},
+		{name: "ConnectedServerVersions", f: clientConnectedServerVersionsTest},
 		{name: "InvocationAfterShutdown", f: clientInvocationAfterShutdownTest},
 		{name: "InvocationTimeout", f: clientInvocationTimeoutTest},
+		{name: "ProxyOperationsAfterShutdown", f: clientProxyOperationsAfterShutdownTest},
 		{name: "LifecycleEvents", f: clientLifecycleEventsTest},
 		{name: "MemberEvents", f: clientMemberEventsTest},
 		{name: "Name", f: clientNameTest},
+		{name: "NameUnique", f: clientNameUniqueTest},
 		{name: "PortRangeAllAddresses", f: clientPortRangeAllAddressesTest},
 		{name: "PortRangeMultipleAddresses", f: clientPortRangeMultipleAddressesTest},
 		{name: "PortRangeSingleAddress", f: clientPortRangeSingleAddressTest},
 		{name: "RemoveLifecycleListener", f: clientRemoveLifecycleListenerTest},
 		{name: "RemoveMembershipListener", f: clientRemoveMembershipListenerTest},
 		{name: "Running", f: clientRunningTest},
+		{name: "SelectedMembers", f: clientSelectedMembersTest},
 		{name: "Shutdown", f: clientShutdownTest},
 		{name: "ShutdownRace", f: clientShutdownRaceTest},
 		{name: "StartShutdownMemoryLeak", f: clientStartShutdownMemoryLeakTest},
@@ -173,6 +183,49 @@ func clientAddLifecycleListenerTest(t *testing.T) {
 	})
 }
 
+func clientAddLifecycleListenerWithKeyTest(t *testing.T) {
+	t.Parallel()
+	it.Tester(t, func(t *testing.T, client *hz.Client) {
+		var keyedCount, otherCount int32
+		keyedHandler := func(event hz.LifecycleStateChanged) {
+			if event.State == hz.LifecycleStateShuttingDown {
+				atomic.AddInt32(&keyedCount, 1)
+			}
+		}
+		id1, err := client.AddLifecycleListenerWithKey("my-listener", keyedHandler)
+		require.Nil(t, err)
+		id2, err := client.AddLifecycleListenerWithKey("my-listener", keyedHandler)
+		require.Nil(t, err)
+		assert.Equal(t, id1, id2)
+		// a listener with a different key is added
+		id3, err := client.AddLifecycleListenerWithKey("other-listener", func(event hz.LifecycleStateChanged) {
+			if event.State == hz.LifecycleStateShuttingDown {
+				atomic.AddInt32(&otherCount, 1)
+			}
+		})
+		require.Nil(t, err)
+		assert.NotEqual(t, id1, id3)
+		// the key can be reused after the listener is removed
+		require.Nil(t, client.RemoveLifecycleListener(id3))
+		id4, err := client.AddLifecycleListenerWithKey("other-listener", func(event hz.LifecycleStateChanged) {
+			if event.State == hz.LifecycleStateShuttingDown {
+				atomic.AddInt32(&otherCount, 1)
+			}
+		})
+		require.Nil(t, err)
+		assert.NotEqual(t, id3, id4)
+		if err = client.Shutdown(context.Background()); err != nil {
+			t.Fatal(err)
+		}
+		it.Eventually(t, func() bool {
+			return atomic.LoadInt32(&keyedCount) == 1 && atomic.LoadInt32(&otherCount) == 1
+		})
+		// wait a bit more to make sure the handler is not called twice
+		time.Sleep(100 * time.Millisecond)
+		assert.Equal(t, int32(1), atomic.LoadInt32(&keyedCount))
+	})
+}
+
 func clientRemoveLifecycleListenerTest(t *testing.T) {
 	t.Parallel()
 	it.Tester(t, func(t *testing.T, client *hz.Client) {
@@ -244,6 +297,75 @@ func clientAddMembershipListenerTest(t *testing.T) {
 	wgAdded.Wait()
 }
 
+func clientAddConnectedMembersListenerTest(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	cls := it.StartNewClusterWithOptions(t.Name(), it.NextPort(), 1)
+	defer cls.Shutdown()
+	client := it.MustClient(hz.StartNewClientWithConfig(ctx, cls.DefaultConfig()))
+	defer client.Shutdown(ctx)
+	ch := make(chan cluster.ConnectedMembersChanged, 10)
+	subscriptionID, err := client.AddConnectedMembersListener(func(event cluster.ConnectedMembersChanged) {
+		ch <- event
+	})
+	require.NoError(t, err)
+	require.NotEqual(t, types.UUID{}, subscriptionID, "subscription UUID should not be empty")
+	// the client connects to the new member, since smart routing is enabled by default.
+	mem, err := cls.RC.StartMember(ctx, cls.ClusterID)
+	require.NoError(t, err)
+	select {
+	case e := <-ch:
+		require.Len(t, e.Added, 1)
+		assert.Equal(t, mem.UUID, e.Added[0].UUID.String())
+		assert.NotEqual(t, cluster.Address(""), e.Added[0].Address)
+		assert.Empty(t, e.Removed)
+	case <-time.After(30 * time.Second):
+		t.Fatal("the connected members handler was not called for the new member")
+	}
+	ok, err := cls.RC.TerminateMember(ctx, cls.ClusterID, mem.UUID)
+	require.NoError(t, err)
+	require.True(t, ok, "rc cannot terminate member")
+	select {
+	case e := <-ch:
+		assert.Empty(t, e.Added)
+		require.Len(t, e.Removed, 1)
+		assert.Equal(t, mem.UUID, e.Removed[0].UUID.String())
+	case <-time.After(30 * time.Second):
+		t.Fatal("the connected members handler was not called for the terminated member")
+	}
+	require.NoError(t, client.RemoveConnectedMembersListener(subscriptionID))
+}
+
+func clientSelectedMembersTest(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	port := it.NextPort()
+	config := fmt.Sprintf(`
+		<hazelcast xmlns="http://www.hazelcast.com/schema/config"
+			xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance"
+			xsi:schemaLocation="http://www.hazelcast.com/schema/config
+			http://www.hazelcast.com/schema/config/hazelcast-config-4.0.xsd">
+			<cluster-name>%s</cluster-name>
+			<network>
+				<port>%d</port>
+			</network>
+			<member-attributes>
+				<attribute name="role">analytics</attribute>
+			</member-attributes>
+		</hazelcast>
+	`, t.Name(), port)
+	cls := it.StartNewClusterWithConfig(1, config, port)
+	defer cls.Shutdown()
+	client := it.MustClient(hz.StartNewClientWithConfig(ctx, cls.DefaultConfigWithNoSSL()))
+	defer client.Shutdown(ctx)
+	mems := client.SelectedMembers(cluster.MemberWithAttribute("role", "analytics"))
+	require.Len(t, mems, 1)
+	v, ok := mems[0].Attribute("role")
+	assert.True(t, ok)
+	assert.Equal(t, "analytics", v)
+	assert.Empty(t, client.SelectedMembers(cluster.MemberWithAttribute("role", "storage")))
+}
+
 func clientRemoveMembershipListenerTest(t *testing.T) {
 	t.Parallel()
 	var removed int32
@@ -305,6 +427,23 @@ func clientNameTest(t *testing.T) {
 	})
 }
 
+func clientNameUniqueTest(t *testing.T) {
+	t.Parallel()
+	tc := it.StartNewClusterWithOptions(t.Name(), it.NextPort(), 1)
+	defer tc.Shutdown()
+	ctx := context.Background()
+	config := tc.DefaultConfig()
+	config.ClientNamePrefix = "test-client-"
+	config.UniqueClientName = true
+	c1 := it.MustClient(hz.StartNewClientWithConfig(ctx, config))
+	defer c1.Shutdown(ctx)
+	c2 := it.MustClient(hz.StartNewClientWithConfig(ctx, config))
+	defer c2.Shutdown(ctx)
+	assert.True(t, strings.HasPrefix(c1.Name(), "test-client-"), c1.Name())
+	assert.True(t, strings.HasPrefix(c2.Name(), "test-client-"), c2.Name())
+	assert.NotEqual(t, c1.Name(), c2.Name())
+}
+
 func clientPortRangeAllAddressesTest(t *testing.T) {
 	t.Parallel()
 	portRangeConnectivityTest(t, func(validPort int) []string {
@@ -679,6 +818,56 @@ func clientGetDistributedObjectsTest(t *testing.T) {
 	})
 }
 
+func clientAddMapConfigTest(t *testing.T) {
+	t.Parallel()
+	it.Tester(t, func(t *testing.T, client *hz.Client) {
+		ctx := context.Background()
+		mapName := it.NewUniqueObjectName("map")
+		cfg := hz.MapConfig{Name: mapName, TimeToLiveSeconds: 1}
+		cfg.MergePolicy.Policy = hz.MergePolicyPassThrough
+		it.Must(client.AddMapConfig(ctx, cfg))
+		// adding the same configuration again is ignored.
+		it.Must(client.AddMapConfig(ctx, cfg))
+		// adding a conflicting configuration fails.
+		conflicting := cfg.Clone()
+		conflicting.MergePolicy.Policy = hz.MergePolicyLatestUpdate
+		if err := client.AddMapConfig(ctx, conflicting); err == nil {
+			t.Fatalf("expected an error for the conflicting configuration")
+		}
+		m := it.MustValue(client.GetMap(ctx, mapName)).(*hz.Map)
+		defer m.Destroy(ctx)
+		it.Must(m.Set(ctx, "key", "value"))
+		// the entry expires due to the TTL in the map configuration.
+		it.Eventually(t, func() bool {
+			return !it.MustBool(m.ContainsKey(ctx, "key"))
+		})
+	})
+}
+
+func clientAddMapConfigDefaultTTLTest(t *testing.T) {
+	t.Parallel()
+	it.Tester(t, func(t *testing.T, client *hz.Client) {
+		ctx := context.Background()
+		prefix := it.NewUniqueObjectName("map")
+		cfg := hz.MapConfig{Name: prefix + "-*", TimeToLiveSeconds: 100, MaxIdleSeconds: 200}
+		it.Must(client.AddMapConfig(ctx, cfg))
+		m := it.MustValue(client.GetMap(ctx, prefix+"-ttl")).(*hz.Map)
+		defer m.Destroy(ctx)
+		// the default TTL and max idle are applied to a plain put.
+		it.MustValue(m.Put(ctx, "key", "value"))
+		ev := it.MustValue(m.GetEntryView(ctx, "key")).(*types.SimpleEntryView)
+		assert.Equal(t, int64(100_000), ev.TTL)
+		assert.Equal(t, int64(200_000), ev.MaxIdle)
+		it.Must(m.Set(ctx, "key2", "value"))
+		ev = it.MustValue(m.GetEntryView(ctx, "key2")).(*types.SimpleEntryView)
+		assert.Equal(t, int64(100_000), ev.TTL)
+		// an explicit TTL overrides the default.
+		it.MustValue(m.PutWithTTL(ctx, "key3", "value", 10*time.Second))
+		ev = it.MustValue(m.GetEntryView(ctx, "key3")).(*types.SimpleEntryView)
+		assert.Equal(t, int64(10_000), ev.TTL)
+	})
+}
+
 func clientGetProxyInstanceTest(t *testing.T) {
 	t.Parallel()
 	testCases := []struct {
@@ -894,6 +1083,42 @@ func clientFixConnectionTest(t *testing.T) {
 
 }
 
+func clientClusterVersionTest(t *testing.T) {
+	t.Parallel()
+	it.Tester(t, func(t *testing.T, client *hz.Client) {
+		ctx := context.Background()
+		v := it.MustValue(client.ClusterVersion(ctx)).(cluster.MemberVersion)
+		// the version is populated after connecting and matches the version of the test cluster
+		mm := fmt.Sprintf("%d.%d", v.Major, v.Minor)
+		assert.True(t, strings.HasPrefix(it.HzVersion(), mm), "%s does not match %s", mm, it.HzVersion())
+		assert.Equal(t, byte(0), v.Patch)
+		caps := it.MustValue(client.ClusterCapabilities(ctx)).(cluster.Capabilities)
+		assert.Equal(t, cluster.CapabilitiesForVersion(v), caps)
+		assert.True(t, caps.SQL)
+		it.Must(client.Shutdown(ctx))
+		_, err := client.ClusterVersion(ctx)
+		assert.True(t, errors.Is(err, hzerrors.ErrClientNotActive))
+	})
+}
+
+func clientConnectedServerVersionsTest(t *testing.T) {
+	t.Parallel()
+	it.Tester(t, func(t *testing.T, client *hz.Client) {
+		ctx := context.Background()
+		vs := client.ConnectedServerVersions()
+		if len(vs) == 0 {
+			t.Fatalf("no connected server versions")
+		}
+		for uuid, v := range vs {
+			assert.False(t, uuid.Default(), "member UUID is not set")
+			// the version of the test cluster may not include the patch version
+			assert.True(t, strings.HasPrefix(v, it.HzVersion()), "%s does not match %s", v, it.HzVersion())
+		}
+		it.Must(client.Shutdown(ctx))
+		assert.Equal(t, 0, len(client.ConnectedServerVersions()))
+	})
+}
+
 func clientVersionTest(t *testing.T) {
 	t.Parallel()
 	// adding this test here, so there's no "unused lint warning.
@@ -988,6 +1213,52 @@ func clientInvocationAfterShutdownTest(t *testing.T) {
 	})
 }
 
+func clientProxyOperationsAfterShutdownTest(t *testing.T) {
+	t.Parallel()
+	clientTester(t, func(t *testing.T, smart bool) {
+		tc := it.StartNewClusterWithOptions(t.Name(), it.NextPort(), it.MemberCount())
+		defer tc.Shutdown()
+		config := tc.DefaultConfig()
+		config.Cluster.Unisocket = !smart
+		ctx := context.Background()
+		client := it.MustClient(hz.StartNewClientWithConfig(ctx, config))
+		m := it.MustValue(client.GetMap(ctx, it.NewUniqueObjectName("map"))).(*hz.Map)
+		q := it.MustValue(client.GetQueue(ctx, it.NewUniqueObjectName("queue"))).(*hz.Queue)
+		pn := it.MustValue(client.GetPNCounter(ctx, it.NewUniqueObjectName("pncounter"))).(*hz.PNCounter)
+		it.Must(client.Shutdown(ctx))
+		ops := []struct {
+			name string
+			f    func() error
+		}{
+			{name: "Map.Put", f: func() error {
+				_, err := m.Put(ctx, "k", "v")
+				return err
+			}},
+			{name: "Map.PutAll", f: func() error {
+				return m.PutAll(ctx, types.Entry{Key: "k", Value: "v"})
+			}},
+			{name: "Map.GetAll", f: func() error {
+				_, err := m.GetAll(ctx, "k")
+				return err
+			}},
+			{name: "Queue.Add", f: func() error {
+				_, err := q.Add(ctx, "v")
+				return err
+			}},
+			{name: "PNCounter.Get", f: func() error {
+				_, err := pn.Get(ctx)
+				return err
+			}},
+		}
+		for _, op := range ops {
+			err := op.f()
+			if !errors.Is(err, hzerrors.ErrClientNotActive) {
+				t.Fatalf("%s: expected hzerrors.ErrClientNotActive but received: %v", op.name, err)
+			}
+		}
+	})
+}
+
 func clientClusterShutdownThenCheckOperationsNotHangingTest(t *testing.T) {
 	t.Parallel()
 	clientTester(t, func(t *testing.T, smart bool) {
@@ -43,10 +43,32 @@ type Config struct {
 	ConnectionStrategy ConnectionStrategyConfig
 	// InvocationTimeout is the maximum time to wait for the response of an invocation.
 	InvocationTimeout types.Duration `json:",omitempty"`
+	// InvocationSweepInterval is the frequency of checking for invocations which did not receive a response before their deadline.
+	// Such invocations are removed and completed with hzerrors.ErrOperationTimeout.
+	// Blocking operations, such as Queue.Take or Map.Lock, are not swept, since they may wait on the member for longer than InvocationTimeout.
+	InvocationSweepInterval types.Duration `json:",omitempty"`
 	// HeartbeatInterval is the frequency of sending pings to the cluster to keep the connection alive.
 	HeartbeatInterval types.Duration `json:",omitempty"`
 	// HeartbeatTimeout is the maximum time to wait for the response of a ping before closing the connection.
 	HeartbeatTimeout types.Duration `json:",omitempty"`
+	// KeepAliveInterval is the maximum time a connection stays idle before a ping is sent on it.
+	// A connection is idle if nothing was written to it, so any request resets the idle time.
+	// It is useful when a load balancer between the client and the cluster drops idle connections.
+	// Unlike heartbeats, keepalive pings are not used to detect failed connections.
+	// Set to 0 to disable, which is the default.
+	KeepAliveInterval types.Duration `json:",omitempty"`
+	// MembersChangedWindow is the time to collect membership changes before the members changed listeners are called with their net change.
+	// The window starts with the first membership change after the listeners were last called.
+	// The default is 100 milliseconds.
+	MembersChangedWindow types.Duration `json:",omitempty"`
+	// NoConnectionTimeout is the maximum time a partition-bound invocation waits for a connection to the cluster when there are no connections.
+	// The wait starts with the invocation.
+	// If there is still no connection after that, the invocation fails with hzerrors.ErrNoConnectionToPartition.
+	// The default is 0, which waits until the invocation times out.
+	NoConnectionTimeout types.Duration `json:",omitempty"`
+	// FailOnNoConnection makes partition-bound invocations fail immediately with hzerrors.ErrNoConnectionToPartition when there are no connections to the cluster,
+	// instead of waiting for a connection.
+	FailOnNoConnection bool `json:",omitempty"`
 	// RedoOperation enables retrying some errors even when they are not retried by default.
 	RedoOperation bool `json:",omitempty"`
 	// Unisocket disables smart routing and enables unisocket mode of operation.
@@ -55,18 +77,23 @@ type Config struct {
 
 func (c *Config) Clone() Config {
 	return Config{
-		Name:               c.Name,
-		Unisocket:          c.Unisocket,
-		HeartbeatInterval:  c.HeartbeatInterval,
-		HeartbeatTimeout:   c.HeartbeatTimeout,
-		InvocationTimeout:  c.InvocationTimeout,
-		RedoOperation:      c.RedoOperation,
-		loadBalancer:       c.loadBalancer,
-		Security:           c.Security.Clone(),
-		Cloud:              c.Cloud.Clone(),
-		Discovery:          c.Discovery.Clone(),
-		ConnectionStrategy: c.ConnectionStrategy.Clone(),
-		Network:            c.Network.Clone(),
+		Name:                    c.Name,
+		Unisocket:               c.Unisocket,
+		HeartbeatInterval:       c.HeartbeatInterval,
+		HeartbeatTimeout:        c.HeartbeatTimeout,
+		KeepAliveInterval:       c.KeepAliveInterval,
+		MembersChangedWindow:    c.MembersChangedWindow,
+		NoConnectionTimeout:     c.NoConnectionTimeout,
+		FailOnNoConnection:      c.FailOnNoConnection,
+		InvocationTimeout:       c.InvocationTimeout,
+		InvocationSweepInterval: c.InvocationSweepInterval,
+		RedoOperation:           c.RedoOperation,
+		loadBalancer:            c.loadBalancer,
+		Security:                c.Security.Clone(),
+		Cloud:                   c.Cloud.Clone(),
+		Discovery:               c.Discovery.Clone(),
+		ConnectionStrategy:      c.ConnectionStrategy.Clone(),
+		Network:                 c.Network.Clone(),
 	}
 }
 
@@ -82,10 +109,26 @@ func (c *Config) Validate() error {
 	if err != nil {
 		return err
 	}
+	err = check.EnsureNonNegativeDuration((*time.Duration)(&c.KeepAliveInterval), 0, "invalid keepalive interval")
+	if err != nil {
+		return err
+	}
+	err = check.EnsureNonNegativeDuration((*time.Duration)(&c.MembersChangedWindow), 100*time.Millisecond, "invalid members changed window")
+	if err != nil {
+		return err
+	}
+	err = check.EnsureNonNegativeDuration((*time.Duration)(&c.NoConnectionTimeout), 0, "invalid no connection timeout")
+	if err != nil {
+		return err
+	}
 	err = check.EnsureNonNegativeDuration((*time.Duration)(&c.InvocationTimeout), 120*time.Second, "invalid heartbeat timeout")
 	if err != nil {
 		return err
 	}
+	err = check.EnsureNonNegativeDuration((*time.Duration)(&c.InvocationSweepInterval), 1*time.Second, "invalid invocation sweep interval")
+	if err != nil {
+		return err
+	}
 	if c.loadBalancer == nil {
 		c.loadBalancer = NewRoundRobinLoadBalancer()
 	}
@@ -87,7 +87,7 @@ ConnectionRetryConfig contains configuration to computer the waiting the duratio
 The waiting duration before the next reconnection attempt is found using the following formula:
 
 	backoff = minimum(MaxBackoff, InitialBackoff)
-	duration = backoff + backoff*Jitter*2.0*(RandomFloat64()-1.0)
+	duration = backoff + backoff*Jitter*(2.0*RandomFloat64()-1.0)
 	next(backoff) = minimum(MaxBackoff, backoff*Multiplier)
 
 */
@@ -103,7 +103,9 @@ type ConnectionRetryConfig struct {
 	// Should be greater than or equal to 1.
 	Multiplier float64 `json:",omitempty"`
 	// Jitter controls the amount of randomness introduces to reduce contention.
+	// The waiting duration is randomly picked in the range [backoff*(1-Jitter), backoff*(1+Jitter)].
 	// Defaults to 0.
+	// Should be in the range [0, 1].
 	Jitter float64 `json:",omitempty"`
 }
 
@@ -124,5 +126,8 @@ func (c *ConnectionRetryConfig) Validate() error {
 	if c.Multiplier < 1.0 {
 		return fmt.Errorf("invalid multiplier: %w", hzerrors.ErrIllegalArgument)
 	}
+	if c.Jitter < 0 || c.Jitter > 1 {
+		return fmt.Errorf("invalid jitter: %w", hzerrors.ErrIllegalArgument)
+	}
 	return nil
 }
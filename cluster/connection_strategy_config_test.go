@@ -17,11 +17,13 @@
 package cluster_test
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 
 	"github.com/hazelcast/hazelcast-go-client/cluster"
+	"github.com/hazelcast/hazelcast-go-client/hzerrors"
 )
 
 func TestReconnectMode_MarshalText(t *testing.T) {
@@ -78,3 +80,25 @@ func TestReconnectMode_UnmarshalText(t *testing.T) {
 		})
 	}
 }
+
+func TestConnectionRetryConfig_ValidateJitter(t *testing.T) {
+	testCases := []struct {
+		jitter float64
+		hasErr bool
+	}{
+		{jitter: -0.1, hasErr: true},
+		{jitter: 0},
+		{jitter: 0.5},
+		{jitter: 1},
+		{jitter: 1.1, hasErr: true},
+	}
+	for _, tc := range testCases {
+		c := cluster.ConnectionRetryConfig{Jitter: tc.jitter}
+		err := c.Validate()
+		if tc.hasErr {
+			assert.True(t, errors.Is(err, hzerrors.ErrIllegalArgument), "jitter %f", tc.jitter)
+		} else {
+			assert.NoError(t, err, "jitter %f", tc.jitter)
+		}
+	}
+}
@@ -90,9 +90,11 @@ The client tries to reconnect when the client is disconnected from the cluster.
 The waiting duration before the next reconnection attempt is found using the following formula:
 
 	backoff = minimum(MaxBackoff, InitialBackoff)
-	duration = backoff + backoff*Jitter*2.0*(RandomFloat64()-1.0)
+	duration = backoff + backoff*Jitter*(2.0*RandomFloat64()-1.0)
 	next(backoff) = minimum(MaxBackoff, backoff*Multiplier)
 
+Jitter is applied to each attempt, so the attempts of many clients which were disconnected at the same time, e.g., during a cluster restart, are spread in time.
+
 You can configure the frequency of the reconnection attempts using config.Cluster.ConnectionStrategy.Retry setting:
 
 	config := hazelcast.Config{}
@@ -16,6 +16,10 @@
 
 package cluster
 
+import (
+	"github.com/hazelcast/hazelcast-go-client/types"
+)
+
 type MembershipState int
 
 func (m MembershipState) String() string {
@@ -44,3 +48,39 @@ type MembershipStateChanged struct {
 func (e *MembershipStateChanged) EventName() string {
 	return "cluster.membershipstatechanged"
 }
+
+type MembersChangedHandler func(event MembersChanged)
+
+// MembersChanged contains the net change of the members of the cluster within a time window.
+// A member which was added and removed within the window is not included.
+type MembersChanged struct {
+	Added   []MemberInfo
+	Removed []MemberInfo
+}
+
+func (e *MembersChanged) EventName() string {
+	return "cluster.memberschanged"
+}
+
+// ConnectedMembersChangedHandler is called with the change of the members the client holds connections to.
+// See Client.AddConnectedMembersListener.
+type ConnectedMembersChangedHandler func(event ConnectedMembersChanged)
+
+// MemberConnection identifies a member the client holds a connection to.
+type MemberConnection struct {
+	// Address is the address the client connected to the member at.
+	Address Address
+	// UUID is the UUID of the member.
+	UUID types.UUID
+}
+
+// ConnectedMembersChanged contains the change of the members the client holds connections to.
+// Unlike MembershipStateChanged, it reflects the connections opened and closed by the client, not the cluster membership.
+type ConnectedMembersChanged struct {
+	Added   []MemberConnection
+	Removed []MemberConnection
+}
+
+func (e *ConnectedMembersChanged) EventName() string {
+	return "cluster.connectedmemberschanged"
+}
@@ -48,3 +48,13 @@ func TestMembershipStateChanged_EventName(t *testing.T) {
 	want := "cluster.membershipstatechanged"
 	assert.Equal(t, want, got)
 }
+
+func TestMembersChanged_EventName(t *testing.T) {
+	mc := cluster.MembersChanged{}
+	assert.Equal(t, "cluster.memberschanged", mc.EventName())
+}
+
+func TestConnectedMembersChanged_EventName(t *testing.T) {
+	e := cluster.ConnectedMembersChanged{}
+	assert.Equal(t, "cluster.connectedmemberschanged", e.EventName())
+}
@@ -41,6 +41,12 @@ func (mi MemberInfo) String() string {
 	return fmt.Sprintf("%s:%s", mi.Address, mi.UUID)
 }
 
+// Attribute returns the value of the member attribute with the given key and ok == true if the attribute exists.
+func (mi *MemberInfo) Attribute(key string) (value string, ok bool) {
+	value, ok = mi.Attributes[key]
+	return
+}
+
 // PublicAddress returns the public address and ok == true if member contains a public address.
 func (mi *MemberInfo) PublicAddress() (addr Address, ok bool) {
 	addr, ok = mi.AddressMap[publicEndpointQualifier]
@@ -62,6 +68,27 @@ func (m MemberVersion) String() string {
 	return fmt.Sprintf("%d.%d.%d", m.Major, m.Minor, m.Patch)
 }
 
+// Capabilities contains the features which are supported by a cluster.
+// Use CapabilitiesForVersion to get the capabilities of a cluster version.
+type Capabilities struct {
+	// CompactSerialization is true if the cluster supports Compact serialization.
+	// Compact serialization is supported by Hazelcast 5.2 and later.
+	CompactSerialization bool
+	// SQL is true if the cluster supports SQL queries from this client.
+	// SQL is supported by Hazelcast 5.0 and later.
+	SQL bool
+}
+
+// CapabilitiesForVersion returns the features which are supported by a cluster with the given version.
+// The patch version is not taken into account.
+func CapabilitiesForVersion(v MemberVersion) Capabilities {
+	mm := v.MajorMinor()
+	return Capabilities{
+		CompactSerialization: mm >= MemberVersion{Major: 5, Minor: 2}.MajorMinor(),
+		SQL:                  mm >= MemberVersion{Major: 5, Minor: 0}.MajorMinor(),
+	}
+}
+
 type EndpointQualifier struct {
 	Identifier string
 	Type       EndpointQualifierType
@@ -0,0 +1,49 @@
+/*
+ * Copyright (c) 2008-2023, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cluster
+
+// MemberSelector returns true if the given member should be selected.
+// It is used to target a subset of the members in the cluster.
+type MemberSelector func(mem MemberInfo) bool
+
+// MemberWithAttribute returns a MemberSelector which selects the members having the attribute with the given key and value.
+// Member attributes are set in the member configuration.
+func MemberWithAttribute(key, value string) MemberSelector {
+	return func(mem MemberInfo) bool {
+		v, ok := mem.Attribute(key)
+		return ok && v == value
+	}
+}
+
+// DataMember returns a MemberSelector which selects the members which are not lite members.
+func DataMember() MemberSelector {
+	return func(mem MemberInfo) bool {
+		return !mem.LiteMember
+	}
+}
+
+// SelectMembers returns the members for which the selector returns true.
+// The order of the members is preserved.
+func SelectMembers(mems []MemberInfo, selector MemberSelector) []MemberInfo {
+	selected := make([]MemberInfo, 0, len(mems))
+	for _, mem := range mems {
+		if selector(mem) {
+			selected = append(selected, mem)
+		}
+	}
+	return selected
+}
@@ -88,3 +88,83 @@ func TestEndpointQualifierType_String(t *testing.T) {
 		})
 	}
 }
+
+func TestMemberWithAttribute(t *testing.T) {
+	analytics1 := cluster.MemberInfo{
+		UUID:       types.NewUUIDWith(1, 1),
+		Attributes: map[string]string{"role": "analytics", "zone": "a"},
+	}
+	analytics2 := cluster.MemberInfo{
+		UUID:       types.NewUUIDWith(2, 2),
+		Attributes: map[string]string{"role": "analytics", "zone": "b"},
+	}
+	storage := cluster.MemberInfo{
+		UUID:       types.NewUUIDWith(3, 3),
+		Attributes: map[string]string{"role": "storage"},
+	}
+	noAttrs := cluster.MemberInfo{
+		UUID: types.NewUUIDWith(4, 4),
+	}
+	mems := []cluster.MemberInfo{analytics1, storage, noAttrs, analytics2}
+	testCases := []struct {
+		name     string
+		selector cluster.MemberSelector
+		target   []cluster.MemberInfo
+	}{
+		{
+			name:     "role=analytics",
+			selector: cluster.MemberWithAttribute("role", "analytics"),
+			target:   []cluster.MemberInfo{analytics1, analytics2},
+		},
+		{
+			name:     "role=storage",
+			selector: cluster.MemberWithAttribute("role", "storage"),
+			target:   []cluster.MemberInfo{storage},
+		},
+		{
+			name:     "zone=b",
+			selector: cluster.MemberWithAttribute("zone", "b"),
+			target:   []cluster.MemberInfo{analytics2},
+		},
+		{
+			name:     "role=missing",
+			selector: cluster.MemberWithAttribute("role", "missing"),
+			target:   []cluster.MemberInfo{},
+		},
+		{
+			name:     "empty value does not match missing attribute",
+			selector: cluster.MemberWithAttribute("zone", ""),
+			target:   []cluster.MemberInfo{},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.target, cluster.SelectMembers(mems, tc.selector))
+		})
+	}
+}
+
+func TestDataMember(t *testing.T) {
+	data := cluster.MemberInfo{UUID: types.NewUUIDWith(1, 1)}
+	lite := cluster.MemberInfo{UUID: types.NewUUIDWith(2, 2), LiteMember: true}
+	selected := cluster.SelectMembers([]cluster.MemberInfo{lite, data}, cluster.DataMember())
+	assert.Equal(t, []cluster.MemberInfo{data}, selected)
+}
+
+func TestCapabilitiesForVersion(t *testing.T) {
+	testCases := []struct {
+		version cluster.MemberVersion
+		target  cluster.Capabilities
+	}{
+		{version: cluster.MemberVersion{Major: 4, Minor: 2, Patch: 5}, target: cluster.Capabilities{}},
+		{version: cluster.MemberVersion{Major: 5, Minor: 0}, target: cluster.Capabilities{SQL: true}},
+		{version: cluster.MemberVersion{Major: 5, Minor: 1, Patch: 9}, target: cluster.Capabilities{SQL: true}},
+		{version: cluster.MemberVersion{Major: 5, Minor: 2}, target: cluster.Capabilities{SQL: true, CompactSerialization: true}},
+		{version: cluster.MemberVersion{Major: 6, Minor: 0}, target: cluster.Capabilities{SQL: true, CompactSerialization: true}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.version.String(), func(t *testing.T) {
+			assert.Equal(t, tc.target, cluster.CapabilitiesForVersion(tc.version))
+		})
+	}
+}
@@ -18,6 +18,7 @@ package cluster
 
 import (
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/hazelcast/hazelcast-go-client/hzerrors"
@@ -33,6 +34,7 @@ const (
 )
 
 type NetworkConfig struct {
+	socketInterceptor SocketInterceptor
 	SSL               SSLConfig      `json:",omitempty"`
 	Addresses         []string       `json:",omitempty"`
 	PortRange         PortRange      `json:",omitempty"`
@@ -55,6 +57,7 @@ func (c *NetworkConfig) Clone() NetworkConfig {
 	addrs := make([]string, len(c.Addresses))
 	copy(addrs, c.Addresses)
 	return NetworkConfig{
+		socketInterceptor: c.socketInterceptor,
 		Addresses:         addrs,
 		ConnectionTimeout: c.ConnectionTimeout,
 		SSL:               c.SSL.Clone(),
@@ -84,6 +87,9 @@ func (c *NetworkConfig) Validate() error {
 		c.Addresses = []string{defaultAddress}
 	} else {
 		for _, addr := range c.Addresses {
+			if strings.TrimSpace(addr) == "" {
+				return fmt.Errorf("invalid address '%s': address is blank: %w", addr, hzerrors.ErrInvalidAddress)
+			}
 			if _, _, err := internal.ParseAddr(addr); err != nil {
 				return fmt.Errorf("invalid address '%s': %w", addr, err)
 			}
@@ -104,6 +110,18 @@ func (c *NetworkConfig) SetAddresses(addrs ...string) {
 	c.Addresses = addrs
 }
 
+// SetSocketInterceptor sets the socket interceptor which performs a custom handshake on new connections.
+// See SocketInterceptor for details.
+func (c *NetworkConfig) SetSocketInterceptor(si SocketInterceptor) {
+	c.socketInterceptor = si
+}
+
+// SocketInterceptor returns the socket interceptor.
+// Returns nil if the socket interceptor was not set.
+func (c *NetworkConfig) SocketInterceptor() SocketInterceptor {
+	return c.socketInterceptor
+}
+
 // validatePortRange validates whether the port range given is valid or not
 func (c *NetworkConfig) validatePortRange() error {
 	if c.PortRange.Min > 0 && c.PortRange.Max > c.PortRange.Min {
@@ -0,0 +1,30 @@
+/*
+ * Copyright (c) 2008-2023, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cluster
+
+import "net"
+
+// SocketInterceptor performs a custom handshake on a new connection before the Hazelcast client protocol starts.
+// Set it using NetworkConfig.SetSocketInterceptor.
+type SocketInterceptor interface {
+	// Intercept is called with the raw TCP connection right after it is established.
+	// It is called before the TLS handshake if SSL is enabled, and before any bytes of the Hazelcast client protocol are written.
+	// Intercept may read from and write to conn, but it must not close it.
+	// The connection timeout applies to Intercept, it is set as the deadline of conn.
+	// Returning an error closes the connection and fails the connection attempt.
+	Intercept(conn net.Conn) error
+}
@@ -19,6 +19,7 @@ package hazelcast
 import (
 	"encoding/json"
 	"fmt"
+	"io"
 	"strings"
 	"time"
 	"unsafe"
@@ -47,16 +48,21 @@ type Config struct {
 	lifecycleListeners    map[types.UUID]LifecycleStateChangeHandler
 	membershipListeners   map[types.UUID]cluster.MembershipStateChangeHandler
 	nearCaches            map[string]nearcache.Config
+	defaultNearCache      *nearcache.Config
+	uuidSource            io.Reader
 	NearCaches            []nearcache.Config                `json:",omitempty"`
 	FlakeIDGenerators     map[string]FlakeIDGeneratorConfig `json:",omitempty"`
 	Labels                []string                          `json:",omitempty"`
 	ClientName            string                            `json:",omitempty"`
+	ClientNamePrefix      string                            `json:",omitempty"`
 	Logger                logger.Config                     `json:",omitempty"`
 	Failover              cluster.FailoverConfig            `json:",omitempty"`
 	Serialization         serialization.Config              `json:",omitempty"`
 	Cluster               cluster.Config                    `json:",omitempty"`
 	Stats                 StatsConfig                       `json:",omitempty"`
 	NearCacheInvalidation NearCacheInvalidationConfig       `json:",omitempty"`
+	MaxValueSize          int                               `json:",omitempty"`
+	UniqueClientName      bool                              `json:",omitempty"`
 }
 
 // NewConfig creates the default configuration.
@@ -91,7 +97,25 @@ func (c *Config) AddNearCache(cfg nearcache.Config) {
 	c.nearCaches[cfg.Name] = cfg
 }
 
+// SetDefaultNearCache sets the near cache configuration which is used for maps that do not match any other near cache configuration.
+// This configuration is distinct from the near cache configuration named "default", which takes precedence over it.
+func (c *Config) SetDefaultNearCache(cfg nearcache.Config) {
+	cfg = cfg.Clone()
+	c.defaultNearCache = &cfg
+}
+
+// DefaultNearCache returns the near cache configuration set with SetDefaultNearCache.
+// Returns false if the default near cache configuration was not set.
+func (c *Config) DefaultNearCache() (nearcache.Config, bool) {
+	if c.defaultNearCache == nil {
+		return nearcache.Config{}, false
+	}
+	return c.defaultNearCache.Clone(), true
+}
+
 // GetNearCache returns the first configuration that matches the given pattern.
+// If no configuration matches, the configuration named "default" is returned if it exists.
+// Otherwise, the configuration set with SetDefaultNearCache is returned if it exists.
 // Returns hzerrors.ErrInvalidConfiguration if the pattern matches more than one configuration.
 func (c *Config) GetNearCache(pattern string) (nearcache.Config, bool, error) {
 	c.ensureNearCacheConfigs()
@@ -103,8 +127,23 @@ func (c *Config) GetNearCache(pattern string) (nearcache.Config, bool, error) {
 		return nc, true, nil
 	}
 	// config not found, return the default if it exists
-	nc, ok = c.nearCaches["default"]
-	return nc, ok, nil
+	if nc, ok = c.nearCaches["default"]; ok {
+		return nc, true, nil
+	}
+	if c.defaultNearCache != nil {
+		return c.defaultNearCache.Clone(), true, nil
+	}
+	return nc, false, nil
+}
+
+// SetUUIDSource sets the source of random bytes used to generate the client UUID and the random suffix of the client name when UniqueClientName is set.
+// Other UUIDs, such as listener subscription IDs, are not generated from this source.
+// The default source is crypto/rand.Reader, which should be kept in production.
+// Setting a source with a fixed seed, such as rand.New(rand.NewSource(42)) from math/rand, makes the client UUID and the client name suffix deterministic, which is useful in tests.
+// Clients created with the same seed have the same UUID, so they must not connect to the same cluster at the same time.
+// The source is not safe to share between clients which are created concurrently, unless it is safe for concurrent use.
+func (c *Config) SetUUIDSource(r io.Reader) {
+	c.uuidSource = r
 }
 
 // SetLabels sets the labels for the client.
@@ -113,6 +152,21 @@ func (c *Config) SetLabels(labels ...string) {
 	c.Labels = labels
 }
 
+// SetClusterName sets the name of the cluster to connect to.
+// It is a shortcut for setting config.Cluster.Name.
+// The default cluster name is "dev".
+func (c *Config) SetClusterName(name string) {
+	c.Cluster.Name = name
+}
+
+// SetAddresses sets the candidate addresses of the members which the client uses to establish the initial connection.
+// It is a shortcut for config.Cluster.Network.SetAddresses.
+// Each address must be in host or host:port format.
+// The default address is "127.0.0.1:5701".
+func (c *Config) SetAddresses(addrs ...string) {
+	c.Cluster.Network.SetAddresses(addrs...)
+}
+
 // Clone returns a copy of the configuration.
 func (c *Config) Clone() Config {
 	c.ensureLifecycleListeners()
@@ -123,11 +177,21 @@ func (c *Config) Clone() Config {
 	nccs := c.copyNearCacheConfig()
 	newNCs := make([]nearcache.Config, 0, len(c.NearCaches))
 	newNCs = append(newNCs, c.NearCaches...)
+	var defaultNC *nearcache.Config
+	if c.defaultNearCache != nil {
+		nc := c.defaultNearCache.Clone()
+		defaultNC = &nc
+	}
 	return Config{
 		ClientName:            c.ClientName,
+		ClientNamePrefix:      c.ClientNamePrefix,
+		UniqueClientName:      c.UniqueClientName,
+		MaxValueSize:          c.MaxValueSize,
 		Labels:                newLabels,
 		FlakeIDGenerators:     newFlakeIDConfigs,
 		nearCaches:            nccs,
+		defaultNearCache:      defaultNC,
+		uuidSource:            c.uuidSource,
 		NearCaches:            newNCs,
 		Cluster:               c.Cluster.Clone(),
 		Failover:              c.Failover.Clone(),
@@ -162,6 +226,9 @@ func (c *Config) Validate() error {
 	if err := c.NearCacheInvalidation.Validate(); err != nil {
 		return err
 	}
+	if err := check.NonNegativeInt32Config(c.MaxValueSize); err != nil {
+		return err
+	}
 	c.ensureFlakeIDGenerators()
 	for _, v := range c.FlakeIDGenerators {
 		if err := v.Validate(); err != nil {
@@ -177,6 +244,11 @@ func (c *Config) Validate() error {
 			return err
 		}
 	}
+	if c.defaultNearCache != nil {
+		if err := c.defaultNearCache.Validate(); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -45,6 +45,8 @@ func TestConfig(t *testing.T) {
 		{name: "SetLabels", f: configSetLabelsTest},
 		{name: "Clone", f: configCloneTest},
 		{name: "NewConfigSetAddress", f: configNewConfigSetAddressTest},
+		{name: "SetClusterNameAndAddresses", f: configSetClusterNameAndAddressesTest},
+		{name: "SetAddressesInvalid", f: configSetAddressesInvalidTest},
 		{name: "NewConfigValidate", f: configNewConfigValidateTest},
 		{name: "UnMarshalDefaultJSONConfig", f: configUnMarshalDefaultJSONConfigTest},
 		{name: "UnmarshalJSONConfig", f: configUnmarshalJSONConfigTest},
@@ -56,6 +58,11 @@ func TestConfig(t *testing.T) {
 		{name: "AddExistingFlakeIDGenerator", f: configAddExistingFlakeIDGeneratorTest},
 		{name: "AddNearCache", f: configAddNearCacheTest},
 		{name: "ValidateNearCacheFails", f: configValidateNearCacheFailsTest},
+		{name: "ValidateMaxValueSizeFails", f: configValidateMaxValueSizeFailsTest},
+		{name: "ValidateKeepAliveIntervalFails", f: configValidateKeepAliveIntervalFailsTest},
+		{name: "ValidateMembersChangedWindowFails", f: configValidateMembersChangedWindowFailsTest},
+		{name: "ValidateNoConnectionTimeoutFails", f: configValidateNoConnectionTimeoutFailsTest},
+		{name: "DefaultNearCache", f: configDefaultNearCacheTest},
 		{name: "ServerNameIsAutomaticallySetForViridian", f: configServerNameIsAutomaticallySetForViridian},
 	}
 	for _, tc := range testCases {
@@ -112,8 +119,11 @@ func configCloneTest(t *testing.T) {
 				PrefetchExpiry: types.Duration(time.Minute * 5),
 			},
 		},
-		Labels:     []string{"test-client-label"},
-		ClientName: "test-client",
+		Labels:           []string{"test-client-label"},
+		ClientName:       "test-client",
+		ClientNamePrefix: "test-prefix-",
+		UniqueClientName: true,
+		MaxValueSize:     1024,
 	}
 	err := cfg.Validate()
 	if err != nil {
@@ -123,6 +133,54 @@ func configCloneTest(t *testing.T) {
 	assert.True(t, reflect.DeepEqual(newCfg.FlakeIDGenerators, cfg.FlakeIDGenerators))
 	assert.True(t, reflect.DeepEqual(newCfg.Labels, cfg.Labels))
 	assert.True(t, reflect.DeepEqual(newCfg.ClientName, cfg.ClientName))
+	assert.Equal(t, cfg.ClientNamePrefix, newCfg.ClientNamePrefix)
+	assert.Equal(t, cfg.UniqueClientName, newCfg.UniqueClientName)
+	assert.Equal(t, cfg.MaxValueSize, newCfg.MaxValueSize)
+}
+
+func configSetClusterNameAndAddressesTest(t *testing.T) {
+	testCases := []struct {
+		name  string
+		addrs []string
+	}{
+		{name: "host and port", addrs: []string{"192.168.1.2:5701"}},
+		{name: "host only", addrs: []string{"hz1.server.com"}},
+		{name: "IPv6", addrs: []string{"[::1]:5701"}},
+		{name: "multiple", addrs: []string{"hz1.server.com:5701", "hz2.server.com:5702"}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			config := hazelcast.NewConfig()
+			config.SetClusterName("production")
+			config.SetAddresses(tc.addrs...)
+			require.NoError(t, config.Validate())
+			assert.Equal(t, "production", config.Cluster.Name)
+			assert.Equal(t, tc.addrs, config.Cluster.Network.Addresses)
+		})
+	}
+}
+
+func configSetAddressesInvalidTest(t *testing.T) {
+	testCases := []struct {
+		name  string
+		addrs []string
+	}{
+		{name: "blank", addrs: []string{""}},
+		{name: "whitespace", addrs: []string{"  "}},
+		{name: "blank among valid", addrs: []string{"192.168.1.2:5701", ""}},
+		{name: "non-numeric port", addrs: []string{"192.168.1.2:port"}},
+		{name: "negative port", addrs: []string{"192.168.1.2:-1"}},
+		{name: "port out of range", addrs: []string{"192.168.1.2:65536"}},
+		{name: "missing port", addrs: []string{"192.168.1.2:"}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			config := hazelcast.NewConfig()
+			config.SetAddresses(tc.addrs...)
+			err := config.Validate()
+			assert.True(t, errors.Is(err, hzerrors.ErrInvalidAddress), err)
+		})
+	}
 }
 
 func configNewConfigSetAddressTest(t *testing.T) {
@@ -524,6 +582,37 @@ func configValidateNearCacheFailsTest(t *testing.T) {
 	}
 }
 
+func configDefaultNearCacheTest(t *testing.T) {
+	config := hazelcast.Config{}
+	_, ok, err := config.GetNearCache("unmatched")
+	assert.NoError(t, err)
+	assert.False(t, ok)
+	specific := nearcache.Config{Name: "my*", TimeToLiveSeconds: 10}
+	config.AddNearCache(specific)
+	def := nearcache.Config{Name: "catch-all", MaxIdleSeconds: 20}
+	config.SetDefaultNearCache(def)
+	it.Must(config.Validate())
+	ncc, ok, err := config.GetNearCache("unmatched")
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, "catch-all", ncc.Name)
+	assert.Equal(t, 20, ncc.MaxIdleSeconds)
+	ncc, ok, err = config.GetNearCache("mymap")
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, "my*", ncc.Name)
+	// the near cache configuration named "default" takes precedence over the default near cache configuration.
+	config.AddNearCache(nearcache.Config{Name: "default"})
+	ncc, ok, err = config.GetNearCache("unmatched")
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, "default", ncc.Name)
+	clone := config.Clone()
+	ncc, ok = clone.DefaultNearCache()
+	assert.True(t, ok)
+	assert.Equal(t, "catch-all", ncc.Name)
+}
+
 func configServerNameIsAutomaticallySetForViridian(t *testing.T) {
 	config := hazelcast.Config{}
 	config.Cluster.Cloud.Enabled = true
@@ -532,14 +621,49 @@ func configServerNameIsAutomaticallySetForViridian(t *testing.T) {
 	assert.True(t, config.Cluster.Network.SSL.Enabled)
 }
 
+func configValidateMaxValueSizeFailsTest(t *testing.T) {
+	config := hazelcast.Config{MaxValueSize: -1}
+	err := config.Validate()
+	assert.True(t, errors.Is(err, hzerrors.ErrInvalidConfiguration))
+}
+
+func configValidateKeepAliveIntervalFailsTest(t *testing.T) {
+	config := hazelcast.Config{}
+	config.Cluster.KeepAliveInterval = types.Duration(-1 * time.Second)
+	err := config.Validate()
+	assert.True(t, errors.Is(err, hzerrors.ErrIllegalArgument))
+}
+
+func configValidateMembersChangedWindowFailsTest(t *testing.T) {
+	config := hazelcast.Config{}
+	config.Cluster.MembersChangedWindow = types.Duration(-1 * time.Second)
+	err := config.Validate()
+	assert.True(t, errors.Is(err, hzerrors.ErrIllegalArgument))
+}
+
+func configValidateNoConnectionTimeoutFailsTest(t *testing.T) {
+	config := hazelcast.Config{}
+	config.Cluster.NoConnectionTimeout = types.Duration(-1 * time.Second)
+	err := config.Validate()
+	assert.True(t, errors.Is(err, hzerrors.ErrIllegalArgument))
+}
+
 func checkDefault(t *testing.T, c *hazelcast.Config) {
 	assert.Equal(t, "", c.ClientName)
+	assert.Equal(t, "", c.ClientNamePrefix)
+	assert.Equal(t, false, c.UniqueClientName)
+	assert.Equal(t, 0, c.MaxValueSize)
 	assert.Equal(t, []string(nil), c.Labels)
 
 	assert.Equal(t, "dev", c.Cluster.Name)
 	assert.Equal(t, types.Duration(5*time.Second), c.Cluster.HeartbeatInterval)
 	assert.Equal(t, types.Duration(60*time.Second), c.Cluster.HeartbeatTimeout)
+	assert.Equal(t, types.Duration(0), c.Cluster.KeepAliveInterval)
+	assert.Equal(t, types.Duration(100*time.Millisecond), c.Cluster.MembersChangedWindow)
+	assert.Equal(t, types.Duration(0), c.Cluster.NoConnectionTimeout)
+	assert.Equal(t, false, c.Cluster.FailOnNoConnection)
 	assert.Equal(t, types.Duration(120*time.Second), c.Cluster.InvocationTimeout)
+	assert.Equal(t, types.Duration(1*time.Second), c.Cluster.InvocationSweepInterval)
 	assert.Equal(t, false, c.Cluster.Unisocket)
 	assert.Equal(t, false, c.Cluster.RedoOperation)
 
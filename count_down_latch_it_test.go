@@ -0,0 +1,120 @@
+/*
+ * Copyright (c) 2008-2023, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package hazelcast_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	hz "github.com/hazelcast/hazelcast-go-client"
+	"github.com/hazelcast/hazelcast-go-client/hzerrors"
+	"github.com/hazelcast/hazelcast-go-client/internal/it"
+	"github.com/hazelcast/hazelcast-go-client/internal/it/skip"
+)
+
+func TestCountDownLatch(t *testing.T) {
+	skip.If(t, "enterprise")
+	skip.If(t, "hz < 5")
+	testCases := []struct {
+		name string
+		f    func(t *testing.T)
+	}{
+		{name: "AwaitContextCanceled", f: countDownLatchAwaitContextCanceledTest},
+		{name: "AwaitReachZero", f: countDownLatchAwaitReachZeroTest},
+		{name: "AwaitTimeoutWithCount", f: countDownLatchAwaitTimeoutWithCountTest},
+		{name: "TrySetCountInvalid", f: countDownLatchTrySetCountInvalidTest},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			tc.f(t)
+		})
+	}
+}
+
+func countDownLatchTester(t *testing.T, f func(t *testing.T, l *hz.CountDownLatch)) {
+	it.CPSubsystemTester(t, func(t *testing.T, cp hz.CPSubsystem) {
+		l, err := cp.GetCountDownLatch(context.Background(), it.NewUniqueObjectName("count-down-latch"))
+		require.NoError(t, err)
+		f(t, l)
+	})
+}
+
+func countDownLatchAwaitReachZeroTest(t *testing.T) {
+	countDownLatchTester(t, func(t *testing.T, l *hz.CountDownLatch) {
+		ctx := context.Background()
+		ok, err := l.TrySetCount(ctx, 2)
+		require.NoError(t, err)
+		require.True(t, ok)
+		// the count cannot be set again before it reaches zero
+		ok, err = l.TrySetCount(ctx, 5)
+		require.NoError(t, err)
+		require.False(t, ok)
+		go func() {
+			for i := 0; i < 2; i++ {
+				time.Sleep(100 * time.Millisecond)
+				if err := l.CountDown(ctx); err != nil {
+					panic(err)
+				}
+			}
+		}()
+		reached, count, err := l.Await(ctx, 30*time.Second)
+		require.NoError(t, err)
+		require.True(t, reached)
+		require.Equal(t, int32(0), count)
+	})
+}
+
+func countDownLatchAwaitTimeoutWithCountTest(t *testing.T) {
+	countDownLatchTester(t, func(t *testing.T, l *hz.CountDownLatch) {
+		ctx := context.Background()
+		ok, err := l.TrySetCount(ctx, 3)
+		require.NoError(t, err)
+		require.True(t, ok)
+		require.NoError(t, l.CountDown(ctx))
+		reached, count, err := l.Await(ctx, 100*time.Millisecond)
+		require.NoError(t, err)
+		require.False(t, reached)
+		require.Equal(t, int32(2), count)
+	})
+}
+
+func countDownLatchAwaitContextCanceledTest(t *testing.T) {
+	countDownLatchTester(t, func(t *testing.T, l *hz.CountDownLatch) {
+		ok, err := l.TrySetCount(context.Background(), 1)
+		require.NoError(t, err)
+		require.True(t, ok)
+		ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+		defer cancel()
+		start := time.Now()
+		_, _, err = l.Await(ctx, time.Minute)
+		require.True(t, errors.Is(err, context.DeadlineExceeded))
+		require.Less(t, int64(time.Since(start)), int64(10*time.Second))
+	})
+}
+
+func countDownLatchTrySetCountInvalidTest(t *testing.T) {
+	countDownLatchTester(t, func(t *testing.T, l *hz.CountDownLatch) {
+		_, err := l.TrySetCount(context.Background(), 0)
+		require.True(t, errors.Is(err, hzerrors.ErrIllegalArgument))
+	})
+}
@@ -46,6 +46,12 @@ Note that addresses must include port numbers:
 	config.Cluster.Name = "production"
 	config.Cluster.Network.SetAddresses("hz1.server.com:5701", "hz2.server.com:5701", "hz3.server.com:5701")
 
+The config.SetClusterName and config.SetAddresses methods are shortcuts for the same settings:
+
+	config := hazelcast.Config{}
+	config.SetClusterName("production")
+	config.SetAddresses("hz1.server.com:5701", "hz2.server.com:5701", "hz3.server.com:5701")
+
 You can also load configuration from JSON:
 
 	text := `
@@ -108,13 +114,21 @@ Here are all configuration items with their default values:
 
 	config := hazelcast.Config{}
 	config.ClientName = ""
+	config.ClientNamePrefix = ""
+	config.UniqueClientName = false
+	config.MaxValueSize = 0 // no limit
 	config.SetLabels()
 
 	cc := &config.Cluster
 	cc.Name = "dev"
 	cc.HeartbeatTimeout = types.Duration(5 * time.Second)
 	cc.HeartbeatInterval = types.Duration(60 * time.Second)
+	cc.KeepAliveInterval = 0 // disabled
+	cc.MembersChangedWindow = types.Duration(100 * time.Millisecond)
+	cc.NoConnectionTimeout = 0 // wait until the invocation times out
+	cc.FailOnNoConnection = false
 	cc.InvocationTimeout = types.Duration(120 * time.Second)
+	cc.InvocationSweepInterval = types.Duration(1 * time.Second)
 	cc.RedoOperation = false
 	cc.Unisocket = false
 	cc.SetLoadBalancer(cluster.NewRoundRobinLoadBalancer())
@@ -152,8 +166,29 @@ Here are all configuration items with their default values:
 
 	config.Logger.Level = logger.InfoLevel
 
+If config.ClientName is not set, the client name is generated from config.ClientNamePrefix and a counter which is unique within the process.
+The prefix is "hz.client_" if config.ClientNamePrefix is blank.
+Set config.UniqueClientName to true in order to append a random UUID to the generated name, so the names of clients in different processes do not collide.
+
 Checkout the nearcache package for the documentation about the Near Cache.
 
+# Nil Keys and Values
+
+Hazelcast members do not store null keys or values.
+Passing a nil key or value, including a typed nil pointer, to a distributed data structure method returns an error which wraps hzerrors.ErrIllegalArgument, whether or not a Near Cache is configured.
+Reading a missing key returns nil without an error:
+
+	v, err := m.Get(ctx, "missing-key")
+	// v == nil, err == nil
+
+# Limiting the Value Size
+
+Storing a very large value may exceed the limits of the members and fail with an obscure error.
+Set config.MaxValueSize to a positive number of bytes in order to reject keys and values whose serialized size exceeds it before they are sent to the cluster.
+The limit does not apply to entry processors, predicates and filters, since they are not stored.
+Such calls return an error which wraps hzerrors.ErrIllegalArgument.
+The check is disabled if config.MaxValueSize is 0, which is the default.
+
 # Listening for Distributed Object Events
 
 You can listen to creation and destroy events for distributed objects by attaching a listener to the client.
@@ -202,22 +237,26 @@ The names in parantheses correspond to SQL types:
 
   - string (varchar)
   - int8 (tinyint)
-  - int16 (smallint)
-  - int32 (integer)
-  - int64 (bigint)
+  - int16, uint8 (smallint)
+  - int32, uint16 (integer)
+  - int, int64 (bigint)
   - bool (boolean)
   - float32 (real)
   - float64 (double)
-  - types.Decimal (decimal)
-  - time.Time not supported, use one of types.LocalDate, types.LocalTime, types.LocalDateTime or types.OffsetDateTime
+  - types.Decimal, *big.Int (decimal)
+  - time.Time (timestamp with time zone)
   - types.LocalDate (date)
   - types.LocalTime (time)
   - types.LocalDateTime (timestamp)
   - types.OffsetDateTime (timestamp with time zone)
   - serialization.JSON (json)
 
+Parameters of type int and *big.Int are converted to int64 and types.Decimal respectively before they are sent to the member.
+A nil parameter, including a typed nil pointer, is sent as NULL.
+
 Using Date/Time
 
+time.Time values are sent as TIMESTAMP WITH TIME ZONE.
 In order to force using a specific date/time type, create a time.Time value and cast it to the target type:
 
 	t := time.Now()
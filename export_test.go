@@ -19,6 +19,15 @@ package hazelcast
 import (
 	"context"
 	"time"
+
+	pubcluster "github.com/hazelcast/hazelcast-go-client/cluster"
+	"github.com/hazelcast/hazelcast-go-client/internal/cb"
+	"github.com/hazelcast/hazelcast-go-client/internal/cluster"
+	"github.com/hazelcast/hazelcast-go-client/internal/event"
+	"github.com/hazelcast/hazelcast-go-client/internal/logger"
+	"github.com/hazelcast/hazelcast-go-client/internal/proto"
+	iserialization "github.com/hazelcast/hazelcast-go-client/internal/serialization"
+	"github.com/hazelcast/hazelcast-go-client/types"
 )
 
 // Exports non-exported types and methods to hazelcast_test package.
@@ -54,3 +63,64 @@ func NewFlakeIDBatch(base, increment int64, size int32, expiry time.Duration) fl
 func (f *flakeIDBatch) NextID() int64 {
 	return f.nextID()
 }
+
+// HandleMapEntryEvent passes the given map entry event message to the handler of an entry listener of the map with the given name.
+// The member of the event is not resolved, since the cluster service does not have members.
+func HandleMapEntryEvent(ss *iserialization.Service, mapName string, msg *proto.ClientMessage, handler EntryNotifiedHandler) {
+	lg := logger.LogAdaptor{Logger: logger.New()}
+	cs := cluster.NewService(cluster.CreationBundle{
+		Logger:            lg,
+		InvocationFactory: &cluster.ConnectionInvocationFactory{},
+		EventDispatcher:   &event.DispatchService{},
+		PartitionService:  &cluster.PartitionService{},
+		Config:            &pubcluster.Config{},
+	})
+	m := newMap(&proxy{name: mapName, serializationService: ss, clusterService: cs, logger: lg})
+	m.makeEntryListenerHandler(nil, nil, handler)(msg)
+}
+
+func CastResult[T any](v interface{}) (T, error) {
+	return castResult[T](v)
+}
+
+func NewInitialEntriesHandler(handler EntryNotifiedHandler) (handle EntryNotifiedHandler, deliverInitial func(events []*EntryNotified)) {
+	h := &initialEntriesHandler{handler: handler}
+	return h.handle, h.deliverInitial
+}
+
+func ValidateAndSerialize2(ss *iserialization.Service, maxValueSize int, key, value interface{}) (keyData, valueData iserialization.Data, err error) {
+	p := &proxy{serializationService: ss, config: &Config{MaxValueSize: maxValueSize}}
+	return p.validateAndSerialize2(key, value)
+}
+
+func ValidateAndSerializeArg(ss *iserialization.Service, maxValueSize int, arg interface{}) (iserialization.Data, error) {
+	p := &proxy{serializationService: ss, config: &Config{MaxValueSize: maxValueSize}}
+	return p.validateAndSerializeArg(arg)
+}
+
+// PutAllPartitions writes the entries grouped by partition using putAllPartitions.
+// write is called with the entries of each partition.
+func PutAllPartitions(partitions map[int32][]types.Entry, write func(partitionID int32, entries []types.Entry) error) error {
+	pes := make(map[int32]*partitionEntries, len(partitions))
+	for partitionID, entries := range partitions {
+		pe := &partitionEntries{entries: entries}
+		for _, e := range entries {
+			pe.pairs = append(pe.pairs, proto.NewPair(e.Key, e.Value))
+		}
+		pes[partitionID] = pe
+	}
+	return putAllPartitions(pes, func(partitionID int32, pairs []proto.Pair) cb.Future {
+		entries := make([]types.Entry, len(pairs))
+		for i, p := range pairs {
+			entries[i] = types.Entry{Key: p.Key, Value: p.Value}
+		}
+		if err := write(partitionID, entries); err != nil {
+			return cb.NewFailedFuture(err)
+		}
+		return cb.NewSucceededFuture(nil)
+	})
+}
+
+func ValidateAndNormalizeIndexConfig(ic *types.IndexConfig) error {
+	return validateAndNormalizeIndexConfig(ic)
+}
@@ -0,0 +1,146 @@
+/*
+ * Copyright (c) 2008-2023, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package hazelcast_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	hz "github.com/hazelcast/hazelcast-go-client"
+	"github.com/hazelcast/hazelcast-go-client/hzerrors"
+	"github.com/hazelcast/hazelcast-go-client/internal/it"
+	"github.com/hazelcast/hazelcast-go-client/internal/it/skip"
+)
+
+func TestFencedLock(t *testing.T) {
+	skip.If(t, "enterprise")
+	skip.If(t, "hz < 5")
+	testCases := []struct {
+		name string
+		f    func(t *testing.T)
+	}{
+		{name: "FenceMonotonic", f: fencedLockFenceMonotonicTest},
+		{name: "GetFenceNotOwner", f: fencedLockGetFenceNotOwnerTest},
+		{name: "Reentrant", f: fencedLockReentrantTest},
+		{name: "TryLockWhenLockedByOther", f: fencedLockTryLockWhenLockedByOtherTest},
+		{name: "UnlockNotOwner", f: fencedLockUnlockNotOwnerTest},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			tc.f(t)
+		})
+	}
+}
+
+func fencedLockTester(t *testing.T, f func(t *testing.T, l *hz.FencedLock)) {
+	it.CPSubsystemTester(t, func(t *testing.T, cp hz.CPSubsystem) {
+		l, err := cp.GetLock(context.Background(), it.NewUniqueObjectName("fenced-lock"))
+		require.NoError(t, err)
+		f(t, l)
+	})
+}
+
+func fencedLockReentrantTest(t *testing.T) {
+	fencedLockTester(t, func(t *testing.T, l *hz.FencedLock) {
+		lockCtx := hz.NewLockContext(context.Background())
+		f1, err := l.Lock(lockCtx)
+		require.NoError(t, err)
+		require.NotEqual(t, hz.InvalidFence, f1)
+		// reentrant acquisitions return the same fence
+		f2, err := l.Lock(lockCtx)
+		require.NoError(t, err)
+		require.Equal(t, f1, f2)
+		f3, err := l.TryLock(lockCtx)
+		require.NoError(t, err)
+		require.Equal(t, f1, f3)
+		// the lock is held until it is unlocked as many times as it was locked
+		otherCtx := hz.NewLockContext(context.Background())
+		for i := 0; i < 3; i++ {
+			f, err := l.TryLock(otherCtx)
+			require.NoError(t, err)
+			require.Equal(t, hz.InvalidFence, f)
+			require.NoError(t, l.Unlock(lockCtx))
+		}
+		f, err := l.TryLock(otherCtx)
+		require.NoError(t, err)
+		require.NotEqual(t, hz.InvalidFence, f)
+		require.NoError(t, l.Unlock(otherCtx))
+	})
+}
+
+func fencedLockFenceMonotonicTest(t *testing.T) {
+	fencedLockTester(t, func(t *testing.T, l *hz.FencedLock) {
+		prev := hz.InvalidFence
+		for i := 0; i < 5; i++ {
+			lockCtx := hz.NewLockContext(context.Background())
+			fence, err := l.Lock(lockCtx)
+			require.NoError(t, err)
+			require.Greater(t, fence, prev, fmt.Sprintf("acquisition %d", i))
+			current, err := l.GetFence(lockCtx)
+			require.NoError(t, err)
+			require.Equal(t, fence, current)
+			require.NoError(t, l.Unlock(lockCtx))
+			prev = fence
+		}
+	})
+}
+
+func fencedLockUnlockNotOwnerTest(t *testing.T) {
+	fencedLockTester(t, func(t *testing.T, l *hz.FencedLock) {
+		lockCtx := hz.NewLockContext(context.Background())
+		_, err := l.Lock(lockCtx)
+		require.NoError(t, err)
+		err = l.Unlock(hz.NewLockContext(context.Background()))
+		require.True(t, errors.Is(err, hzerrors.ErrIllegalMonitorState), err)
+		// the owner can still unlock
+		require.NoError(t, l.Unlock(lockCtx))
+		err = l.Unlock(lockCtx)
+		require.True(t, errors.Is(err, hzerrors.ErrIllegalMonitorState), err)
+	})
+}
+
+func fencedLockGetFenceNotOwnerTest(t *testing.T) {
+	fencedLockTester(t, func(t *testing.T, l *hz.FencedLock) {
+		lockCtx := hz.NewLockContext(context.Background())
+		_, err := l.Lock(lockCtx)
+		require.NoError(t, err)
+		_, err = l.GetFence(hz.NewLockContext(context.Background()))
+		require.True(t, errors.Is(err, hzerrors.ErrIllegalMonitorState), err)
+		require.NoError(t, l.Unlock(lockCtx))
+	})
+}
+
+func fencedLockTryLockWhenLockedByOtherTest(t *testing.T) {
+	fencedLockTester(t, func(t *testing.T, l *hz.FencedLock) {
+		lockCtx := hz.NewLockContext(context.Background())
+		_, err := l.Lock(lockCtx)
+		require.NoError(t, err)
+		start := time.Now()
+		fence, err := l.TryLockWithTimeout(hz.NewLockContext(context.Background()), 500*time.Millisecond)
+		require.NoError(t, err)
+		require.Equal(t, hz.InvalidFence, fence)
+		require.GreaterOrEqual(t, time.Since(start), 500*time.Millisecond)
+		require.NoError(t, l.Unlock(lockCtx))
+	})
+}
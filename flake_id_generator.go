@@ -39,6 +39,10 @@ For details, see: https://docs.hazelcast.com/imdg/latest/data-structures/flake-i
 
 Instead of asking cluster for each ID, they are fetched in batches and then served.
 Batch size and expiry duration can be configured via FlakeIDGeneratorConfig.
+IDs of an expired batch are never served.
+
+IDs returned from a FlakeIDGenerator are strictly increasing.
+Since a new batch may be fetched from a different member, IDs of a new batch which are not greater than the IDs of all previous batches are skipped.
 */
 type FlakeIDGenerator struct {
 	*proxy
@@ -46,6 +50,9 @@ type FlakeIDGenerator struct {
 	batch      atomic.Value
 	newBatchFn newFlakeIDBatchFn
 	config     FlakeIDGeneratorConfig
+	// highWaterID is the greatest ID any of the previous batches could serve, it is guarded by mu.
+	// It is never decreased, so a batch which was skipped entirely does not lower it.
+	highWaterID int64
 }
 
 // NewID generates and returns a cluster-wide unique ID.
@@ -72,6 +79,10 @@ func (f *FlakeIDGenerator) tryUpdateBatch(ctx context.Context, current *flakeIDB
 	if b, err := f.newBatchFn(ctx, f); err != nil {
 		return err
 	} else {
+		if id := current.maxID(); id > f.highWaterID {
+			f.highWaterID = id
+		}
+		b.skipUntilAfter(f.highWaterID)
 		f.batch.Store(&b)
 		return nil
 	}
@@ -79,11 +90,12 @@ func (f *FlakeIDGenerator) tryUpdateBatch(ctx context.Context, current *flakeIDB
 
 func newFlakeIdGenerator(p *proxy, config FlakeIDGeneratorConfig, newBatchFn newFlakeIDBatchFn) *FlakeIDGenerator {
 	f := &FlakeIDGenerator{
-		proxy:      p,
-		mu:         &sync.Mutex{},
-		batch:      atomic.Value{},
-		newBatchFn: newBatchFn,
-		config:     config,
+		proxy:       p,
+		mu:          &sync.Mutex{},
+		batch:       atomic.Value{},
+		newBatchFn:  newBatchFn,
+		config:      config,
+		highWaterID: invalidFlakeID,
 	}
 	// Store an invalid batch to fetch an actual batch lazily. The
 	// very first FlakeIDGenerator.NewID call will update the batch.
@@ -125,3 +137,25 @@ func (f *flakeIDBatch) nextID() int64 {
 	}
 	return f.base + idx*f.increment
 }
+
+// maxID returns the greatest ID the batch can serve.
+// Returns invalidFlakeID if the batch is empty.
+func (f *flakeIDBatch) maxID() int64 {
+	if f.size <= 0 {
+		return invalidFlakeID
+	}
+	return f.base + (f.size-1)*f.increment
+}
+
+// skipUntilAfter makes the batch serve only the IDs greater than the given ID.
+// It must be called before the batch is shared.
+func (f *flakeIDBatch) skipUntilAfter(id int64) {
+	if id == invalidFlakeID || f.base > id || f.increment <= 0 {
+		return
+	}
+	skip := (id-f.base)/f.increment + 1
+	if skip > f.size {
+		skip = f.size
+	}
+	f.index = skip - 1
+}
@@ -40,7 +40,10 @@ func TestFlakeIDGenerator(t *testing.T) {
 		{name: "ExpiredBatch", f: flakeIDGeneratorExpiredBatchTest},
 		{name: "IDGeneratorUsedBatch", f: flakeIDGeneratorUsedBatchTest},
 		{name: "NewID", f: flakeIDGeneratorNewIDTest},
+		{name: "NewIDMonotonic", f: flakeIDGeneratorNewIDMonotonicTest},
+		{name: "OverlappingBatch", f: flakeIDGeneratorOverlappingBatchTest},
 		{name: "ServiceName", f: flakeIDGeneratorServiceNameTest},
+		{name: "SkippedBatch", f: flakeIDGeneratorSkippedBatchTest},
 	}
 	for _, tc := range testCases {
 		tc := tc
@@ -72,6 +75,89 @@ func flakeIDGeneratorNewIDTest(t *testing.T) {
 	})
 }
 
+func flakeIDGeneratorNewIDMonotonicTest(t *testing.T) {
+	const idCount = 10_000
+	name := it.NewUniqueObjectName("flake-id-gen")
+	configCallback := func(config *hz.Config) {
+		// use small batches, so that many batches are fetched
+		if err := config.AddFlakeIDGenerator(name, 10, 0); err != nil {
+			panic(err)
+		}
+	}
+	it.TesterWithConfigBuilder(t, configCallback, func(t *testing.T, client *hz.Client) {
+		ctx := context.Background()
+		f, err := client.GetFlakeIDGenerator(ctx, name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer f.Destroy(ctx)
+		ids := map[int64]struct{}{}
+		last := hz.InvalidFlakeID
+		for i := 0; i < idCount; i++ {
+			id, err := f.NewID(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if id <= last {
+				t.Fatalf("ID %d is not greater than the previous ID %d", id, last)
+			}
+			last = id
+			ids[id] = struct{}{}
+		}
+		assert.Equal(t, idCount, len(ids)) // assert uniqueness
+	})
+}
+
+func flakeIDGeneratorOverlappingBatchTest(t *testing.T) {
+	var (
+		ctx     = context.Background()
+		batches = []hz.FlakeIDBatch{
+			hz.FlakeIDBatch(hz.NewFlakeIDBatch(0, 1, 3, time.Minute)),
+			// all IDs are served by the previous batch
+			hz.FlakeIDBatch(hz.NewFlakeIDBatch(0, 1, 3, time.Minute)),
+			// some IDs are served by the previous batch
+			hz.FlakeIDBatch(hz.NewFlakeIDBatch(1, 1, 4, time.Minute)),
+		}
+	)
+	f := hz.NewFlakeIdGenerator(hz.FlakeIDGeneratorConfig{}, func(context.Context, *hz.FlakeIDGenerator) (hz.FlakeIDBatch, error) {
+		b := batches[0]
+		batches = batches[1:]
+		return b, nil
+	})
+	var ids []int64
+	for i := 0; i < 5; i++ {
+		id, err := f.NewID(ctx)
+		require.NoError(t, err)
+		ids = append(ids, id)
+	}
+	assert.Equal(t, []int64{0, 1, 2, 3, 4}, ids)
+}
+
+func flakeIDGeneratorSkippedBatchTest(t *testing.T) {
+	var (
+		ctx     = context.Background()
+		batches = []hz.FlakeIDBatch{
+			hz.FlakeIDBatch(hz.NewFlakeIDBatch(10, 1, 3, time.Minute)),
+			// all IDs are below the IDs served by the first batch
+			hz.FlakeIDBatch(hz.NewFlakeIDBatch(0, 1, 3, time.Minute)),
+			// the IDs up to 12 must be skipped, although the previous batch ends at 2
+			hz.FlakeIDBatch(hz.NewFlakeIDBatch(5, 1, 20, time.Minute)),
+		}
+	)
+	f := hz.NewFlakeIdGenerator(hz.FlakeIDGeneratorConfig{}, func(context.Context, *hz.FlakeIDGenerator) (hz.FlakeIDBatch, error) {
+		b := batches[0]
+		batches = batches[1:]
+		return b, nil
+	})
+	var ids []int64
+	for i := 0; i < 5; i++ {
+		id, err := f.NewID(ctx)
+		require.NoError(t, err)
+		ids = append(ids, id)
+	}
+	assert.Equal(t, []int64{10, 11, 12, 13, 14}, ids)
+}
+
 func flakeIDGeneratorExpiredBatchTest(t *testing.T) {
 	var (
 		ctx    = context.Background()
@@ -14,5 +14,5 @@
  * limitations under the License.
  */
 
-// Package hzerrors provides sentinel errors.
+// Package hzerrors provides sentinel errors and the error types for exceptions thrown on the members.
 package hzerrors
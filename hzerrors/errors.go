@@ -16,7 +16,13 @@
 
 package hzerrors
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hazelcast/hazelcast-go-client/types"
+)
 
 var (
 	ErrClientOffline                    = errors.New("client offline error")
@@ -91,6 +97,7 @@ var (
 	ErrLogin                            = errors.New("login error")
 	ErrUnsupportedCallback              = errors.New("unsupported callback error")
 	ErrNoDataMember                     = errors.New("no data member error")
+	ErrNoConnectionToPartition          = retryable("no connection to partition error")
 	ErrReplicatedMapCantBeCreated       = errors.New("replicated map cant be created error")
 	ErrMaxMessageSizeExceeded           = errors.New("max message sized exceeded error")
 	ErrWANReplicationQueueFull          = errors.New("wan replication query full error")
@@ -136,3 +143,171 @@ func retryable(s string) error {
 func (e RetryableError) Error() string {
 	return string(e)
 }
+
+// FunctionError is returned when a function sent to the member, such as the one passed to AtomicLong.Apply or AtomicLong.Alter, fails on the member.
+// Failures of the cluster or the CP subsystem, such as a destroyed CP group or an expired session, are not wrapped with FunctionError.
+type FunctionError struct {
+	// Err is the error returned from the member.
+	Err error
+	// ClassName is the fully qualified class name of the exception thrown on the member.
+	ClassName string
+	// Message is the message of the exception thrown on the member.
+	Message string
+}
+
+func (e *FunctionError) Error() string {
+	return fmt.Sprintf("function failed on the member: %s: %s", e.ClassName, e.Message)
+}
+
+func (e *FunctionError) Unwrap() error {
+	return e.Err
+}
+
+// PutAllError is returned by Map.PutAll and ReplicatedMap.PutAll when writing the entries of some partitions fails.
+// The entries of the other partitions are written, so only the failed entries need to be retried.
+// errors.Is and errors.As match the errors of all failed partitions.
+type PutAllError struct {
+	// Failures contains a failure for each partition which could not be written, ordered by the partition ID.
+	Failures []PutAllFailure
+}
+
+// PutAllFailure is the failure of writing the entries of a partition.
+// The entries may or may not have been written.
+type PutAllFailure struct {
+	// Err is the error of the partition.
+	Err error
+	// Entries are the entries of the partition.
+	Entries []types.Entry
+	// PartitionID is the ID of the partition.
+	PartitionID int32
+}
+
+func (e *PutAllError) Error() string {
+	if len(e.Failures) == 0 {
+		return "put all failed"
+	}
+	return fmt.Sprintf("put all failed for %d entries in %d partitions: %s", len(e.FailedEntries()), len(e.Failures), e.Failures[0].Err.Error())
+}
+
+// FailedEntries returns the entries of all failed partitions.
+func (e *PutAllError) FailedEntries() []types.Entry {
+	var entries []types.Entry
+	for _, f := range e.Failures {
+		entries = append(entries, f.Entries...)
+	}
+	return entries
+}
+
+func (e *PutAllError) Unwrap() []error {
+	errs := make([]error, len(e.Failures))
+	for i, f := range e.Failures {
+		errs[i] = f.Err
+	}
+	return errs
+}
+
+// StackTraceElement contains stacktrace information for server side exception.
+type StackTraceElement struct {
+	// ClassName is the fully qualified name of the class containing the execution point represented by the stack trace element.
+	ClassName string
+	// MethodName is the name of the method containing the execution point represented by this stack trace element.
+	MethodName string
+	// FileName returns the name of the file containing the execution point represented by the stack trace element,
+	FileName string
+	// LineNumber returns the line number of the source line containing the execution point represented by this stack trace element,
+	// or a negative number if this information is unavailable
+	// A value of -2 indicates that the method containing the execution point is a native method.
+	LineNumber int32
+}
+
+// ServerError is returned when an operation fails with an exception on the member.
+// Some well-known exceptions have their own error types, such as *TargetNotMemberError, which embed ServerError.
+// errors.As matches *ServerError for those as well.
+// errors.Is matches the sentinel error for the error code of the exception, such as ErrTargetNotMember.
+type ServerError struct {
+	// Err is the sentinel error for the error code of the exception.
+	Err error
+	// Cause is the cause of the exception, or nil if the exception does not have a cause.
+	Cause *ServerError
+	// ClassName is the fully qualified class name of the exception.
+	ClassName string
+	// Message is the message of the exception.
+	Message string
+	// StackTrace is the stack trace of the exception on the member.
+	StackTrace []StackTraceElement
+	// ErrorCode is the error code of the exception in the client protocol.
+	ErrorCode int32
+}
+
+func (e *ServerError) Error() string {
+	return fmt.Sprintf("server error: %s: %s", e.ClassName, e.Message)
+}
+
+func (e *ServerError) Unwrap() error {
+	return e.Err
+}
+
+// StackTraceString returns the exception and its causes with their stack traces, formatted like a Java stack trace.
+func (e *ServerError) StackTraceString() string {
+	var sb strings.Builder
+	for c := e; c != nil; c = c.Cause {
+		if c != e {
+			sb.WriteString("Caused by: ")
+		}
+		sb.WriteString(fmt.Sprintf("%s: %s\n", c.ClassName, c.Message))
+		for _, trace := range c.StackTrace {
+			sb.WriteString(fmt.Sprintf("\tat %s.%s(%s:%d)\n", trace.ClassName, trace.MethodName, trace.FileName, trace.LineNumber))
+		}
+	}
+	return sb.String()
+}
+
+// TargetNotMemberError is returned when the target of an operation is not a member of the cluster.
+// It corresponds to TargetNotMemberException on the member.
+type TargetNotMemberError struct {
+	ServerError
+}
+
+func (e *TargetNotMemberError) Unwrap() error {
+	return &e.ServerError
+}
+
+// InstanceNotActiveError is returned when the member which runs the operation is shutting down or not started yet.
+// It corresponds to HazelcastInstanceNotActiveException on the member.
+type InstanceNotActiveError struct {
+	ServerError
+}
+
+func (e *InstanceNotActiveError) Unwrap() error {
+	return &e.ServerError
+}
+
+// QueryError is returned when running a query, such as a predicate or aggregation, fails on the member.
+// It corresponds to QueryException on the member.
+type QueryError struct {
+	ServerError
+}
+
+func (e *QueryError) Unwrap() error {
+	return &e.ServerError
+}
+
+// AuthenticationError is returned when the member rejects the credentials of the client.
+// It corresponds to AuthenticationException on the member.
+type AuthenticationError struct {
+	ServerError
+}
+
+func (e *AuthenticationError) Unwrap() error {
+	return &e.ServerError
+}
+
+// OperationTimeoutError is returned when an operation does not complete on the member in time.
+// It corresponds to OperationTimeoutException on the member.
+type OperationTimeoutError struct {
+	ServerError
+}
+
+func (e *OperationTimeoutError) Unwrap() error {
+	return &e.ServerError
+}
@@ -19,6 +19,7 @@ import (
 	"testing"
 
 	"github.com/hazelcast/hazelcast-go-client/hzerrors"
+	"github.com/hazelcast/hazelcast-go-client/types"
 )
 
 func TestRetryableError_Error(t *testing.T) {
@@ -29,3 +30,22 @@ func TestRetryableError_Error(t *testing.T) {
 		t.Fatalf("got %v want %v", got, name)
 	}
 }
+
+func TestPutAllError_Error(t *testing.T) {
+	err := &hzerrors.PutAllError{Failures: []hzerrors.PutAllFailure{
+		{Err: hzerrors.ErrOperationTimeout, Entries: []types.Entry{{Key: "k1"}, {Key: "k2"}}, PartitionID: 1},
+		{Err: hzerrors.ErrClientOffline, Entries: []types.Entry{{Key: "k3"}}, PartitionID: 5},
+	}}
+	want := "put all failed for 3 entries in 2 partitions: operation timeout error"
+	if got := err.Error(); want != got {
+		t.Fatalf("got %v want %v", got, want)
+	}
+}
+
+func TestPutAllError_ErrorWithoutFailures(t *testing.T) {
+	err := &hzerrors.PutAllError{}
+	want := "put all failed"
+	if got := err.Error(); want != got {
+		t.Fatalf("got %v want %v", got, want)
+	}
+}
@@ -19,6 +19,7 @@ package client
 import (
 	"context"
 	"fmt"
+	"io"
 	"math"
 	"sync/atomic"
 	"time"
@@ -32,14 +33,18 @@ import (
 	"github.com/hazelcast/hazelcast-go-client/internal/invocation"
 	"github.com/hazelcast/hazelcast-go-client/internal/lifecycle"
 	ilogger "github.com/hazelcast/hazelcast-go-client/internal/logger"
+	"github.com/hazelcast/hazelcast-go-client/internal/proto/codec"
 	"github.com/hazelcast/hazelcast-go-client/internal/serialization"
 	"github.com/hazelcast/hazelcast-go-client/internal/stats"
 	"github.com/hazelcast/hazelcast-go-client/logger"
 	pubserialization "github.com/hazelcast/hazelcast-go-client/serialization"
+	"github.com/hazelcast/hazelcast-go-client/types"
 )
 
 var nextId int32
 
+const defaultNamePrefix = "hz.client_"
+
 const (
 	Created int32 = iota
 	Starting
@@ -52,13 +57,18 @@ var handleClusterEventSubID = event.NextSubscriptionID()
 
 type Config struct {
 	Name          string
+	NamePrefix    string
+	UniqueName    bool
 	Cluster       *cluster.Config
 	Failover      *cluster.FailoverConfig
 	Serialization *pubserialization.Config
 	Logger        *logger.Config
 	Labels        []string
-	StatsEnabled  bool
-	StatsPeriod   time.Duration
+	// UUIDSource is the source of random bytes for the client UUID and the unique client name.
+	// Defaults to crypto/rand.Reader.
+	UUIDSource   io.Reader
+	StatsEnabled bool
+	StatsPeriod  time.Duration
 }
 
 func NewConfig() *Config {
@@ -89,6 +99,40 @@ func (c *Config) Validate() error {
 	return nil
 }
 
+// clientName returns the configured client name if it is set.
+// Otherwise, it generates a name from the prefix and a process-wide counter.
+// If UniqueName is set, a random UUID is appended to the generated name, so it does not collide with the names of clients in other processes.
+func clientName(config *Config) (string, error) {
+	if config.Name != "" {
+		return config.Name, nil
+	}
+	prefix := config.NamePrefix
+	if prefix == "" {
+		prefix = defaultNamePrefix
+	}
+	id := atomic.AddInt32(&nextId, 1)
+	if config.UniqueName {
+		uuid, err := newUUID(config.UUIDSource)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("%s%d_%s", prefix, id, uuid), nil
+	}
+	return fmt.Sprintf("%s%d", prefix, id), nil
+}
+
+// newUUID generates a UUID using the given random source, or crypto/rand.Reader if it is nil.
+func newUUID(r io.Reader) (types.UUID, error) {
+	if r == nil {
+		return types.NewUUID(), nil
+	}
+	uuid, err := types.NewUUIDFromReader(r)
+	if err != nil {
+		return types.UUID{}, fmt.Errorf("generating UUID: %w", err)
+	}
+	return uuid, nil
+}
+
 type shutdownHandler func(context.Context)
 
 type Client struct {
@@ -106,6 +150,8 @@ type Client struct {
 	PartitionService       *icluster.PartitionService
 	ClusterService         *icluster.Service
 	Invoker                *Invoker
+	schemaCh               chan serialization.SchemaMsg
+	doneCh                 chan struct{}
 	name                   string
 	beforeShutdownHandlers []shutdownHandler
 	afterShutdownHandlers  []shutdownHandler
@@ -123,10 +169,13 @@ func (c *Client) AddAfterShutdownHandler(handler func(ctx context.Context)) {
 }
 
 func New(config *Config, schemaCh chan serialization.SchemaMsg) (*Client, error) {
-	id := atomic.AddInt32(&nextId, 1)
-	name := config.Name
-	if name == "" {
-		name = fmt.Sprintf("hz.client_%d", id)
+	name, err := clientName(config)
+	if err != nil {
+		return nil, err
+	}
+	clientUUID, err := newUUID(config.UUIDSource)
+	if err != nil {
+		return nil, err
 	}
 	clientLogger, err := loggerFromConf(config.Logger)
 	if err != nil {
@@ -143,8 +192,10 @@ func New(config *Config, schemaCh chan serialization.SchemaMsg) (*Client, error)
 		SerializationService: serService,
 		EventDispatcher:      event.NewDispatchService(clientLogger),
 		Logger:               clientLogger,
+		schemaCh:             schemaCh,
+		doneCh:               make(chan struct{}),
 	}
-	c.createComponents(config)
+	c.createComponents(config, clientUUID)
 	return c, nil
 }
 
@@ -173,6 +224,7 @@ func (c *Client) Start(ctx context.Context) error {
 	if c.StatsService != nil {
 		c.StatsService.Start()
 	}
+	go c.fetchSchemas()
 	c.EventDispatcher.Subscribe(icluster.EventCluster, handleClusterEventSubID, c.handleClusterEvent)
 	atomic.StoreInt32(&c.state, Ready)
 	c.EventDispatcher.Publish(lifecycle.NewLifecycleStateChanged(lifecycle.StateStarted))
@@ -202,6 +254,7 @@ func (c *Client) Shutdown(ctx context.Context) error {
 	for _, f := range c.afterShutdownHandlers {
 		f(ctx)
 	}
+	close(c.doneCh)
 	atomic.StoreInt32(&c.state, Stopped)
 	c.EventDispatcher.Publish(lifecycle.NewLifecycleStateChanged(lifecycle.StateShutDown))
 	if err := c.EventDispatcher.Stop(ctx); err != nil {
@@ -214,7 +267,13 @@ func (c *Client) State() int32 {
 	return atomic.LoadInt32(&c.state)
 }
 
-func (c *Client) createComponents(config *Config) {
+// shuttingDown returns true if the client is stopping or stopped.
+func (c *Client) shuttingDown() bool {
+	s := c.State()
+	return s == Stopping || s == Stopped
+}
+
+func (c *Client) createComponents(config *Config, clientUUID types.UUID) {
 	partitionService := icluster.NewPartitionService(icluster.PartitionServiceCreationBundle{
 		EventDispatcher: c.EventDispatcher,
 		Logger:          c.Logger,
@@ -248,6 +307,7 @@ func (c *Client) createComponents(config *Config) {
 			return atomic.LoadInt32(&c.state) == Stopped
 		},
 		ClientName:      c.name,
+		ClientUUID:      clientUUID,
 		FailoverService: failoverService,
 		FailoverConfig:  config.Failover,
 		Labels:          config.Labels,
@@ -259,10 +319,14 @@ func (c *Client) createComponents(config *Config) {
 		Logger:            c.Logger,
 		Config:            config.Cluster,
 	})
-	invocationService := invocation.NewService(invocationHandler, c.EventDispatcher, c.Logger)
+	invocationService := invocation.NewService(invocationHandler, c.EventDispatcher, c.Logger, time.Duration(config.Cluster.InvocationSweepInterval))
+	if sc := config.Logger.InvocationSampling; sc.Enabled() {
+		invocationService.SetSampler(invocation.NewSampler(c.Logger, sc.Every, time.Duration(sc.SlowerThan)))
+	}
 	iv := time.Duration(c.clusterConfig.HeartbeatInterval)
 	it := time.Duration(c.clusterConfig.HeartbeatTimeout)
-	c.heartbeatService = icluster.NewHeartbeatService(connectionManager, c.InvocationFactory, invocationService, c.Logger, iv, it)
+	ka := time.Duration(c.clusterConfig.KeepAliveInterval)
+	c.heartbeatService = icluster.NewHeartbeatService(connectionManager, c.InvocationFactory, invocationService, c.Logger, iv, it, ka)
 	if config.StatsEnabled {
 		c.StatsService = stats.NewService(
 			invocationService,
@@ -281,11 +345,38 @@ func (c *Client) createComponents(config *Config) {
 	c.ViewListenerService = viewListener
 	c.ConnectionManager.SetInvocationService(invocationService)
 	c.ClusterService.SetInvocationService(invocationService)
-	c.Invoker = NewInvoker(c.InvocationFactory, c.InvocationService, &c.Logger)
+	c.Invoker = NewInvoker(c.InvocationFactory, c.InvocationService, &c.Logger, c.shuttingDown)
 	c.ConnectionManager.SetInvoker(c.Invoker)
 	c.addDiscoveryDestroyer()
 }
 
+// fetchSchemas fetches the Compact schemas which are requested by the serialization service from the cluster.
+// Schemas are requested when data is deserialized with a schema that is not known locally, e.g., a Compact value in an SQL result.
+func (c *Client) fetchSchemas() {
+	c.Logger.Debug(func() string {
+		return "Started the schema invoker"
+	})
+	ctx := context.Background()
+	for {
+		select {
+		case msg := <-c.schemaCh:
+			req := codec.EncodeClientFetchSchemaRequest(msg.ID)
+			resp, err := c.Invoker.InvokeOnRandomTarget(ctx, req, nil)
+			if err != nil {
+				c.Logger.Errorf("invoking ClientFetchSchema with schema ID: %d: %s", msg.ID, err)
+				msg.ResponseCh <- nil
+				continue
+			}
+			msg.ResponseCh <- codec.DecodeClientFetchSchemaResponse(resp)
+		case <-c.doneCh:
+			c.Logger.Debug(func() string {
+				return "Stopped the schema invoker"
+			})
+			return
+		}
+	}
+}
+
 func (c *Client) handleClusterEvent(event event.Event) {
 	e := event.(*icluster.ClusterStateChangedEvent)
 	if e.State == icluster.ClusterStateConnected {
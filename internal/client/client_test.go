@@ -0,0 +1,123 @@
+/*
+ * Copyright (c) 2008-2023, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package client_test
+
+import (
+	"context"
+	"math/rand"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hazelcast/hazelcast-go-client/internal/client"
+)
+
+func TestClientName(t *testing.T) {
+	testCases := []struct {
+		name string
+		f    func(t *testing.T)
+	}{
+		{name: "Default", f: clientNameDefaultTest},
+		{name: "Explicit", f: clientNameExplicitTest},
+		{name: "Prefix", f: clientNamePrefixTest},
+		{name: "Unique", f: clientNameUniqueTest},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, tc.f)
+	}
+}
+
+func clientNameDefaultTest(t *testing.T) {
+	name := newClientName(t, func(cfg *client.Config) {})
+	assert.True(t, strings.HasPrefix(name, "hz.client_"), name)
+}
+
+func clientNameExplicitTest(t *testing.T) {
+	name := newClientName(t, func(cfg *client.Config) {
+		cfg.Name = "my-client"
+		cfg.NamePrefix = "my-prefix-"
+		cfg.UniqueName = true
+	})
+	assert.Equal(t, "my-client", name)
+}
+
+func clientNamePrefixTest(t *testing.T) {
+	name := newClientName(t, func(cfg *client.Config) {
+		cfg.NamePrefix = "my-prefix-"
+	})
+	assert.True(t, strings.HasPrefix(name, "my-prefix-"), name)
+}
+
+func clientNameUniqueTest(t *testing.T) {
+	const count = 10
+	names := map[string]struct{}{}
+	for i := 0; i < count; i++ {
+		name := newClientName(t, func(cfg *client.Config) {
+			cfg.NamePrefix = "my-prefix-"
+			cfg.UniqueName = true
+		})
+		assert.True(t, strings.HasPrefix(name, "my-prefix-"), name)
+		// the random UUID suffix has the form xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx
+		idx := strings.LastIndex(name, "_")
+		require.NotEqual(t, -1, idx, name)
+		assert.Equal(t, 36, len(name[idx+1:]), name)
+		names[name] = struct{}{}
+	}
+	assert.Equal(t, count, len(names))
+}
+
+func TestClientUUIDSource(t *testing.T) {
+	newClient := func() *client.Client {
+		cfg := client.NewConfig()
+		cfg.NamePrefix = "my-prefix-"
+		cfg.UniqueName = true
+		cfg.UUIDSource = rand.New(rand.NewSource(42))
+		require.NoError(t, cfg.Validate())
+		c, err := client.New(cfg, nil)
+		require.NoError(t, err)
+		return c
+	}
+	c1 := newClient()
+	defer stopComponents(t, c1)
+	c2 := newClient()
+	defer stopComponents(t, c2)
+	assert.Equal(t, c1.ConnectionManager.ClientUUID(), c2.ConnectionManager.ClientUUID())
+	suffix := func(name string) string {
+		return name[strings.LastIndex(name, "_")+1:]
+	}
+	assert.Equal(t, suffix(c1.Name()), suffix(c2.Name()))
+	assert.NotEqual(t, suffix(c1.Name()), c1.ConnectionManager.ClientUUID().String())
+}
+
+func newClientName(t *testing.T, configure func(cfg *client.Config)) string {
+	cfg := client.NewConfig()
+	configure(cfg)
+	require.NoError(t, cfg.Validate())
+	c, err := client.New(cfg, nil)
+	require.NoError(t, err)
+	defer stopComponents(t, c)
+	return c.Name()
+}
+
+// stopComponents stops the components which run after the client is created.
+// Shutdown has no effect on a client which was not started.
+func stopComponents(t *testing.T, c *client.Client) {
+	c.InvocationService.Stop()
+	require.NoError(t, c.EventDispatcher.Stop(context.Background()))
+}
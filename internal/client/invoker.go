@@ -18,6 +18,7 @@ package client
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"math"
 	"time"
@@ -36,13 +37,16 @@ import (
 type CRDTOperationTargetFn func(excluded map[types.UUID]struct{}) (*pubcluster.MemberInfo, []proto.Pair)
 
 type Invoker struct {
-	factory *cluster.ConnectionInvocationFactory
-	svc     *invocation.Service
-	cb      *cb.CircuitBreaker
-	lg      *logger.LogAdaptor
+	factory      *cluster.ConnectionInvocationFactory
+	svc          *invocation.Service
+	cb           *cb.CircuitBreaker
+	lg           *logger.LogAdaptor
+	shuttingDown func() bool
 }
 
-func NewInvoker(factory *cluster.ConnectionInvocationFactory, svc *invocation.Service, lg *logger.LogAdaptor) *Invoker {
+// NewInvoker creates an invoker.
+// Invocations fail with hzerrors.ErrClientNotActive once shuttingDown returns true.
+func NewInvoker(factory *cluster.ConnectionInvocationFactory, svc *invocation.Service, lg *logger.LogAdaptor, shuttingDown func() bool) *Invoker {
 	cbr := cb.NewCircuitBreaker(
 		cb.MaxRetries(math.MaxInt32),
 		cb.RetryPolicy(func(attempt int) time.Duration {
@@ -50,10 +54,11 @@ func NewInvoker(factory *cluster.ConnectionInvocationFactory, svc *invocation.Se
 		}),
 	)
 	return &Invoker{
-		factory: factory,
-		svc:     svc,
-		cb:      cbr,
-		lg:      lg,
+		factory:      factory,
+		svc:          svc,
+		cb:           cbr,
+		lg:           lg,
+		shuttingDown: shuttingDown,
 	}
 }
 
@@ -167,6 +172,9 @@ func (iv *Invoker) Factory() *cluster.ConnectionInvocationFactory {
 }
 
 func (iv *Invoker) SendInvocation(ctx context.Context, inv invocation.Invocation) error {
+	if iv.shuttingDown() {
+		return cb.WrapNonRetryableError(errClientShuttingDown(nil))
+	}
 	return iv.svc.SendRequest(ctx, inv)
 }
 
@@ -174,9 +182,29 @@ func (iv *Invoker) TryInvoke(ctx context.Context, f cb.TryHandler) (*proto.Clien
 	if ctx == nil {
 		ctx = context.Background()
 	}
-	res, err := iv.cb.TryContext(ctx, f)
+	if iv.shuttingDown() {
+		return nil, errClientShuttingDown(nil)
+	}
+	res, err := iv.cb.TryContext(ctx, func(ctx context.Context, attempt int) (interface{}, error) {
+		if attempt > 0 {
+			iv.svc.RecordRetry()
+			iv.lg.Trace(func() string {
+				return fmt.Sprintf("retrying invocation, attempt: %d", attempt)
+			})
+		}
+		return f(ctx, attempt)
+	})
 	if err != nil {
+		if iv.shuttingDown() && !errors.Is(err, hzerrors.ErrClientNotActive) {
+			// the client started shutting down while the invocation was in flight,
+			// report that instead of the connection or invocation error it caused.
+			return nil, errClientShuttingDown(err)
+		}
 		return nil, err
 	}
 	return res.(*proto.ClientMessage), nil
 }
+
+func errClientShuttingDown(wrapped error) error {
+	return ihzerrors.NewClientError("client is shutting down", wrapped, hzerrors.ErrClientNotActive)
+}
@@ -0,0 +1,91 @@
+/*
+ * Copyright (c) 2008-2023, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package client_test
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/hazelcast/hazelcast-go-client/hzerrors"
+	"github.com/hazelcast/hazelcast-go-client/internal/cb"
+	"github.com/hazelcast/hazelcast-go-client/internal/client"
+	"github.com/hazelcast/hazelcast-go-client/internal/event"
+	ihzerrors "github.com/hazelcast/hazelcast-go-client/internal/hzerrors"
+	"github.com/hazelcast/hazelcast-go-client/internal/invocation"
+	"github.com/hazelcast/hazelcast-go-client/internal/logger"
+	"github.com/hazelcast/hazelcast-go-client/internal/proto"
+)
+
+func TestInvoker_TryInvokeWhenShuttingDown(t *testing.T) {
+	lg := logger.LogAdaptor{Logger: logger.New()}
+	iv := client.NewInvoker(nil, nil, &lg, func() bool { return true })
+	called := false
+	_, err := iv.TryInvoke(context.Background(), func(ctx context.Context, attempt int) (interface{}, error) {
+		called = true
+		return nil, nil
+	})
+	assert.False(t, called)
+	assert.True(t, errors.Is(err, hzerrors.ErrClientNotActive))
+}
+
+func TestInvoker_TryInvokeShutdownWhileInFlight(t *testing.T) {
+	lg := logger.LogAdaptor{Logger: logger.New()}
+	var shuttingDown int32
+	iv := client.NewInvoker(nil, nil, &lg, func() bool { return atomic.LoadInt32(&shuttingDown) == 1 })
+	connErr := ihzerrors.NewIOError("connection closed", nil)
+	_, err := iv.TryInvoke(context.Background(), func(ctx context.Context, attempt int) (interface{}, error) {
+		atomic.StoreInt32(&shuttingDown, 1)
+		return nil, cb.WrapNonRetryableError(connErr)
+	})
+	assert.True(t, errors.Is(err, hzerrors.ErrClientNotActive))
+	assert.True(t, errors.Is(err, connErr))
+}
+
+func TestInvoker_SendInvocationWhenShuttingDown(t *testing.T) {
+	lg := logger.LogAdaptor{Logger: logger.New()}
+	iv := client.NewInvoker(nil, nil, &lg, func() bool { return true })
+	err := iv.SendInvocation(context.Background(), nil)
+	assert.True(t, errors.Is(err, hzerrors.ErrClientNotActive))
+}
+
+func TestInvoker_TryInvokeRecordsRetries(t *testing.T) {
+	lg := logger.LogAdaptor{Logger: logger.New()}
+	ed := event.NewDispatchService(lg)
+	defer ed.Stop(context.Background())
+	svc := invocation.NewService(nil, ed, lg, 0)
+	defer svc.Stop()
+	iv := client.NewInvoker(nil, svc, &lg, func() bool { return false })
+	const failures = 3
+	_, err := iv.TryInvoke(context.Background(), func(ctx context.Context, attempt int) (interface{}, error) {
+		if attempt < failures {
+			return nil, ihzerrors.NewIOError("connection closed", nil)
+		}
+		return proto.NewClientMessageForEncode(), nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(failures), svc.Retries())
+	// an invocation which succeeds at the first attempt is not counted.
+	_, err = iv.TryInvoke(context.Background(), func(ctx context.Context, attempt int) (interface{}, error) {
+		return proto.NewClientMessageForEncode(), nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(failures), svc.Retries())
+}
@@ -97,6 +97,11 @@ func (s *Service) OrderedMembers() []pubcluster.MemberInfo {
 	return s.membersMap.OrderedMembers()
 }
 
+// SelectedMembers returns the members in the most recent member list for which the selector returns true.
+func (s *Service) SelectedMembers(selector pubcluster.MemberSelector) []pubcluster.MemberInfo {
+	return pubcluster.SelectMembers(s.membersMap.OrderedMembers(), selector)
+}
+
 func (s *Service) SQLMember() *pubcluster.MemberInfo {
 	return s.membersMap.SQLMember()
 }
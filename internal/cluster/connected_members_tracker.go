@@ -0,0 +1,96 @@
+/*
+ * Copyright (c) 2008-2023, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cluster
+
+import (
+	"sync"
+
+	pubcluster "github.com/hazelcast/hazelcast-go-client/cluster"
+	"github.com/hazelcast/hazelcast-go-client/types"
+)
+
+// ConnectedMembersTracker keeps the set of members the client holds connections to and reports its changes.
+// A member is added when its first connection is opened and removed when its last connection is closed.
+type ConnectedMembersTracker struct {
+	handler pubcluster.ConnectedMembersChangedHandler
+	mu      *sync.Mutex
+	conns   map[int64]pubcluster.MemberConnection
+	counts  map[types.UUID]int
+}
+
+func NewConnectedMembersTracker(handler pubcluster.ConnectedMembersChangedHandler) *ConnectedMembersTracker {
+	return &ConnectedMembersTracker{
+		handler: handler,
+		mu:      &sync.Mutex{},
+		conns:   map[int64]pubcluster.MemberConnection{},
+		counts:  map[types.UUID]int{},
+	}
+}
+
+// Seed records the given connections as the current ones without calling the handler.
+func (t *ConnectedMembersTracker) Seed(conns []*Connection) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	for _, conn := range conns {
+		t.record(conn)
+	}
+}
+
+// Handle updates the connected members with the connection in the event and calls the handler if they changed.
+// Closed connections which were not reported as opened, such as the ones which failed authentication, are ignored.
+func (t *ConnectedMembersTracker) Handle(e *ConnectionStateChangedEvent) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	var ev pubcluster.ConnectedMembersChanged
+	switch e.state {
+	case ConnectionStateOpened:
+		mc, added := t.record(e.Conn)
+		if !added {
+			return
+		}
+		ev.Added = []pubcluster.MemberConnection{mc}
+	case ConnectionStateClosed:
+		id := e.Conn.ConnectionID()
+		mc, ok := t.conns[id]
+		if !ok {
+			return
+		}
+		delete(t.conns, id)
+		t.counts[mc.UUID]--
+		if t.counts[mc.UUID] > 0 {
+			return
+		}
+		delete(t.counts, mc.UUID)
+		ev.Removed = []pubcluster.MemberConnection{mc}
+	default:
+		return
+	}
+	// the handler is called while holding the lock, so the changes are reported in order.
+	t.handler(ev)
+}
+
+// record adds the connection and reports whether it is the first connection to its member.
+// The caller must hold t.mu.
+func (t *ConnectedMembersTracker) record(conn *Connection) (pubcluster.MemberConnection, bool) {
+	mc := pubcluster.MemberConnection{Address: conn.Endpoint(), UUID: conn.MemberUUID()}
+	if _, ok := t.conns[conn.ConnectionID()]; ok {
+		return mc, false
+	}
+	t.conns[conn.ConnectionID()] = mc
+	t.counts[mc.UUID]++
+	return mc, t.counts[mc.UUID] == 1
+}
@@ -0,0 +1,81 @@
+/*
+ * Copyright (c) 2008-2023, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cluster
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	pubcluster "github.com/hazelcast/hazelcast-go-client/cluster"
+	"github.com/hazelcast/hazelcast-go-client/types"
+)
+
+func TestConnectedMembersTracker_Handle(t *testing.T) {
+	m1 := pubcluster.MemberConnection{Address: "10.0.0.1:5701", UUID: types.NewUUID()}
+	m2 := pubcluster.MemberConnection{Address: "10.0.0.2:5701", UUID: types.NewUUID()}
+	c1 := newTrackerTestConnection(1, m1)
+	c2 := newTrackerTestConnection(2, m2)
+	// c3 is a second connection to the first member.
+	c3 := newTrackerTestConnection(3, m1)
+	var events []pubcluster.ConnectedMembersChanged
+	tr := NewConnectedMembersTracker(func(e pubcluster.ConnectedMembersChanged) {
+		events = append(events, e)
+	})
+	tr.Handle(NewConnectionOpened(c1))
+	tr.Handle(NewConnectionOpened(c2))
+	// duplicate open events and extra connections to a connected member are not reported.
+	tr.Handle(NewConnectionOpened(c1))
+	tr.Handle(NewConnectionOpened(c3))
+	tr.Handle(NewConnectionClosed(c1, nil))
+	tr.Handle(NewConnectionClosed(c2, nil))
+	tr.Handle(NewConnectionClosed(c3, nil))
+	// closing a connection which was never opened is not reported.
+	tr.Handle(NewConnectionClosed(newTrackerTestConnection(4, m2), nil))
+	target := []pubcluster.ConnectedMembersChanged{
+		{Added: []pubcluster.MemberConnection{m1}},
+		{Added: []pubcluster.MemberConnection{m2}},
+		{Removed: []pubcluster.MemberConnection{m2}},
+		{Removed: []pubcluster.MemberConnection{m1}},
+	}
+	assert.Equal(t, target, events)
+}
+
+func TestConnectedMembersTracker_Seed(t *testing.T) {
+	m := pubcluster.MemberConnection{Address: "10.0.0.1:5701", UUID: types.NewUUID()}
+	c := newTrackerTestConnection(1, m)
+	var events []pubcluster.ConnectedMembersChanged
+	tr := NewConnectedMembersTracker(func(e pubcluster.ConnectedMembersChanged) {
+		events = append(events, e)
+	})
+	tr.Seed([]*Connection{c})
+	assert.Empty(t, events)
+	tr.Handle(NewConnectionOpened(c))
+	assert.Empty(t, events)
+	tr.Handle(NewConnectionClosed(c, nil))
+	target := []pubcluster.ConnectedMembersChanged{
+		{Removed: []pubcluster.MemberConnection{m}},
+	}
+	assert.Equal(t, target, events)
+}
+
+func newTrackerTestConnection(id int64, m pubcluster.MemberConnection) *Connection {
+	c := &Connection{connectionID: id}
+	c.SetEndpoint(m.Address)
+	c.setMemberUUID(m.UUID)
+	return c
+}
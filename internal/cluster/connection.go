@@ -136,8 +136,15 @@ func (c *Connection) sendProtocolStarter() error {
 func (c *Connection) createSocket(networkCfg *pubcluster.NetworkConfig, address pubcluster.Address) (net.Conn, error) {
 	conTimeout := positiveDurationOrMax(time.Duration(networkCfg.ConnectionTimeout))
 	if socket, err := c.dialToAddressWithTimeout(address, conTimeout); err != nil {
-		return nil, err
+		return nil, fmt.Errorf("dialing %s: %w", address, err)
 	} else {
+		if si := networkCfg.SocketInterceptor(); si != nil {
+			if err = interceptSocket(si, socket, time.Duration(networkCfg.ConnectionTimeout)); err != nil {
+				// ignoring the socket close error
+				_ = socket.Close()
+				return nil, err
+			}
+		}
 		if !networkCfg.SSL.Enabled {
 			return socket, err
 		}
@@ -146,12 +153,29 @@ func (c *Connection) createSocket(networkCfg *pubcluster.NetworkConfig, address
 		})
 		tlsCon := tls.Client(socket, networkCfg.SSL.TLSConfig())
 		if err = tlsCon.Handshake(); err != nil {
-			return nil, err
+			// ignoring the socket close error
+			_ = socket.Close()
+			return nil, fmt.Errorf("TLS handshake with %s: %w", address, err)
 		}
 		return tlsCon, nil
 	}
 }
 
+// interceptSocket runs the socket interceptor on the given socket before the client protocol starts.
+// The interceptor must complete within the connection timeout.
+func interceptSocket(si pubcluster.SocketInterceptor, socket net.Conn, timeout time.Duration) error {
+	if timeout > 0 {
+		if err := socket.SetDeadline(time.Now().Add(timeout)); err != nil {
+			return err
+		}
+	}
+	if err := si.Intercept(socket); err != nil {
+		return fmt.Errorf("intercepting socket: %w", err)
+	}
+	// clear the deadline, so it does not apply to the client protocol
+	return socket.SetDeadline(time.Time{})
+}
+
 func (c *Connection) dialToAddressWithTimeout(addr pubcluster.Address, conTimeout time.Duration) (*net.TCPConn, error) {
 	if conn, err := net.DialTimeout("tcp", addr.String(), conTimeout); err != nil {
 		return nil, err
@@ -170,7 +194,8 @@ func (c *Connection) dialToAddressWithTimeout(addr pubcluster.Address, conTimeou
 	}
 }
 
-func (c *Connection) isAlive() bool {
+// IsAlive returns true if the connection is not closed.
+func (c *Connection) IsAlive() bool {
 	return atomic.LoadInt32(&c.status) == open
 }
 
@@ -326,7 +351,7 @@ func (c *Connection) close(closeErr error) {
 
 func (c *Connection) String() string {
 	return fmt.Sprintf("ClientConnection{isAlive=%t, connectionID=%d, endpoint=%s, lastReadTime=%s, lastWriteTime=%s, closedTime=%s, connected server version=%s",
-		c.isAlive(), c.connectionID, c.Endpoint(), c.lastRead.Load(), c.lastWrite.Load(), c.closedTime.Load(), c.connectedServerVersionStr)
+		c.IsAlive(), c.connectionID, c.Endpoint(), c.lastRead.Load(), c.lastWrite.Load(), c.closedTime.Load(), c.connectedServerVersionStr)
 }
 
 func positiveDurationOrMax(duration time.Duration) time.Duration {
@@ -338,6 +363,7 @@ func positiveDurationOrMax(duration time.Duration) time.Duration {
 
 func wrapError(err *ihzerrors.ServerError) error {
 	targetErr := convertErrorCodeToError(errorCode(err.ErrorCode()))
+	err.SetErr(targetErr)
 	return ihzerrors.NewClientError(err.String(), err, targetErr)
 }
 
@@ -19,8 +19,11 @@ package cluster
 import (
 	"errors"
 	"fmt"
+	"time"
 
 	pubcluster "github.com/hazelcast/hazelcast-go-client/cluster"
+	"github.com/hazelcast/hazelcast-go-client/hzerrors"
+	"github.com/hazelcast/hazelcast-go-client/internal/cb"
 	ihzerrors "github.com/hazelcast/hazelcast-go-client/internal/hzerrors"
 	"github.com/hazelcast/hazelcast-go-client/internal/invocation"
 	"github.com/hazelcast/hazelcast-go-client/internal/logger"
@@ -51,19 +54,25 @@ func (b ConnectionInvocationHandlerCreationBundle) Check() {
 }
 
 type ConnectionInvocationHandler struct {
-	logger            logger.LogAdaptor
-	connectionManager *ConnectionManager
-	clusterService    *Service
-	smart             bool
+	logger              logger.LogAdaptor
+	connectionManager   *ConnectionManager
+	clusterService      *Service
+	invocationTimeout   time.Duration
+	noConnectionTimeout time.Duration
+	failOnNoConnection  bool
+	smart               bool
 }
 
 func NewConnectionInvocationHandler(bundle ConnectionInvocationHandlerCreationBundle) *ConnectionInvocationHandler {
 	bundle.Check()
 	return &ConnectionInvocationHandler{
-		connectionManager: bundle.ConnectionManager,
-		clusterService:    bundle.ClusterService,
-		logger:            bundle.Logger,
-		smart:             !bundle.Config.Unisocket,
+		connectionManager:   bundle.ConnectionManager,
+		clusterService:      bundle.ClusterService,
+		logger:              bundle.Logger,
+		invocationTimeout:   time.Duration(bundle.Config.InvocationTimeout),
+		noConnectionTimeout: time.Duration(bundle.Config.NoConnectionTimeout),
+		failOnNoConnection:  bundle.Config.FailOnNoConnection,
+		smart:               !bundle.Config.Unisocket,
 	}
 }
 
@@ -130,9 +139,29 @@ func (h *ConnectionInvocationHandler) sendToAddress(inv invocation.Invocation, a
 
 func (h *ConnectionInvocationHandler) sendToRandomAddress(inv invocation.Invocation) (int64, error) {
 	if conn := h.connectionManager.RandomConnection(); conn == nil {
+		if inv.PartitionID() != -1 {
+			return 0, h.noConnectionToPartitionError(inv)
+		}
 		// TODO: use correct error type
 		return 0, ihzerrors.NewIOError("no connection found", nil)
 	} else {
 		return h.sendToConnection(inv, conn)
 	}
 }
+
+// noConnectionToPartitionError returns the error for a partition-bound invocation when there are no connections.
+// The error is retried, unless failing on no connection is enabled or the invocation waited longer than the no connection timeout.
+func (h *ConnectionInvocationHandler) noConnectionToPartitionError(inv invocation.Invocation) error {
+	err := ihzerrors.NewClientError(fmt.Sprintf("no connection to partition %d", inv.PartitionID()), nil, hzerrors.ErrNoConnectionToPartition)
+	if h.failOnNoConnection {
+		return cb.WrapNonRetryableError(err)
+	}
+	if h.noConnectionTimeout > 0 {
+		// all attempts of an invocation share the same deadline, so the start of the invocation can be derived from it.
+		start := inv.Deadline().Add(-h.invocationTimeout)
+		if time.Since(start) >= h.noConnectionTimeout {
+			return cb.WrapNonRetryableError(err)
+		}
+	}
+	return err
+}
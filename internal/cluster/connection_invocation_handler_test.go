@@ -0,0 +1,125 @@
+/*
+ * Copyright (c) 2008-2023, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cluster
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	pubcluster "github.com/hazelcast/hazelcast-go-client/cluster"
+	"github.com/hazelcast/hazelcast-go-client/hzerrors"
+	"github.com/hazelcast/hazelcast-go-client/internal/cb"
+	"github.com/hazelcast/hazelcast-go-client/internal/invocation"
+	"github.com/hazelcast/hazelcast-go-client/internal/logger"
+	"github.com/hazelcast/hazelcast-go-client/internal/proto"
+	"github.com/hazelcast/hazelcast-go-client/types"
+)
+
+const testInvocationTimeout = 2 * time.Minute
+
+func TestConnectionInvocationHandler_FailOnNoConnection(t *testing.T) {
+	h := newTestInvocationHandler(func(h *ConnectionInvocationHandler) {
+		h.failOnNoConnection = true
+	})
+	inv := newTestPartitionInvocation(time.Now())
+	_, err := h.Invoke(inv)
+	assert.True(t, errors.Is(err, hzerrors.ErrNoConnectionToPartition))
+	assert.False(t, inv.CanRetry(err))
+}
+
+func TestConnectionInvocationHandler_NoConnectionRetriedByDefault(t *testing.T) {
+	h := newTestInvocationHandler(nil)
+	// the invocation started long ago, but it is retried until it times out.
+	inv := newTestPartitionInvocation(time.Now().Add(-time.Minute))
+	_, err := h.Invoke(inv)
+	assert.True(t, errors.Is(err, hzerrors.ErrNoConnectionToPartition))
+	assert.True(t, inv.CanRetry(err))
+}
+
+func TestConnectionInvocationHandler_NoConnectionTimeoutExceeded(t *testing.T) {
+	h := newTestInvocationHandler(func(h *ConnectionInvocationHandler) {
+		h.noConnectionTimeout = time.Second
+	})
+	inv := newTestPartitionInvocation(time.Now().Add(-2 * time.Second))
+	_, err := h.Invoke(inv)
+	assert.True(t, errors.Is(err, hzerrors.ErrNoConnectionToPartition))
+	assert.False(t, inv.CanRetry(err))
+}
+
+func TestConnectionInvocationHandler_WaitForConnection(t *testing.T) {
+	h := newTestInvocationHandler(func(h *ConnectionInvocationHandler) {
+		h.noConnectionTimeout = 10 * time.Second
+	})
+	conn := &Connection{
+		memberUUID:   valueOf(types.NewUUID()),
+		endpoint:     valueOf(pubcluster.Address("1.2.3.4:5701")),
+		pending:      make(chan invocation.Invocation, 1),
+		doneCh:       make(chan struct{}),
+		connectionID: 42,
+		status:       open,
+	}
+	// the connection appears after the first attempts of the invocation failed.
+	time.AfterFunc(300*time.Millisecond, func() {
+		h.connectionManager.connMap.GetOrAddConnection(conn, "1.2.3.4:5701")
+	})
+	start := time.Now()
+	cbr := cb.NewCircuitBreaker(
+		cb.MaxRetries(100),
+		cb.RetryPolicy(func(attempt int) time.Duration {
+			return 50 * time.Millisecond
+		}),
+	)
+	var attempts int
+	res, err := cbr.TryContext(context.Background(), func(ctx context.Context, attempt int) (interface{}, error) {
+		attempts++
+		inv := newTestPartitionInvocation(start)
+		if _, err := h.Invoke(inv); err != nil {
+			if !inv.CanRetry(err) {
+				return nil, cb.WrapNonRetryableError(err)
+			}
+			return nil, err
+		}
+		return inv, nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Greater(t, attempts, 1)
+	assert.Equal(t, res, <-conn.pending)
+}
+
+func newTestInvocationHandler(opt func(h *ConnectionInvocationHandler)) *ConnectionInvocationHandler {
+	h := &ConnectionInvocationHandler{
+		logger: logger.LogAdaptor{Logger: logger.New()},
+		connectionManager: &ConnectionManager{
+			connMap: newConnectionMap(pubcluster.NewRoundRobinLoadBalancer()),
+		},
+		invocationTimeout: testInvocationTimeout,
+	}
+	if opt != nil {
+		opt(h)
+	}
+	return h
+}
+
+func newTestPartitionInvocation(start time.Time) *invocation.Impl {
+	return invocation.NewImpl(proto.NewClientMessageForEncode(), 1, "", start.Add(testInvocationTimeout), false)
+}
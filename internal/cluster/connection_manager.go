@@ -80,8 +80,15 @@ type ConnectionManagerCreationBundle struct {
 	FailoverService      *FailoverService
 	FailoverConfig       *pubcluster.FailoverConfig
 	IsClientShutdown     func() bool
-	ClientName           string
-	Labels               []string
+	// RandSource is the source of randomness for the jitter of connection attempts.
+	// It is optional and can be set to get deterministic delays in tests.
+	// Defaults to a source seeded by the current time.
+	RandSource rand.Source
+	ClientName string
+	Labels     []string
+	// ClientUUID is the UUID sent to the members during authentication.
+	// A random UUID is generated if it is not set.
+	ClientUUID types.UUID
 }
 
 func (b ConnectionManagerCreationBundle) Check() {
@@ -149,6 +156,13 @@ type ConnectionManager struct {
 
 func NewConnectionManager(bundle ConnectionManagerCreationBundle) *ConnectionManager {
 	bundle.Check()
+	if bundle.RandSource == nil {
+		// clients started at the same second must not have the same jitter, so use nanosecond resolution
+		bundle.RandSource = rand.NewSource(time.Now().UnixNano())
+	}
+	if bundle.ClientUUID.Default() {
+		bundle.ClientUUID = types.NewUUID()
+	}
 	manager := &ConnectionManager{
 		clusterService:       bundle.ClusterService,
 		partitionService:     bundle.PartitionService,
@@ -158,14 +172,14 @@ func NewConnectionManager(bundle ConnectionManagerCreationBundle) *ConnectionMan
 		isClientShutDown:     bundle.IsClientShutdown,
 		clientName:           bundle.ClientName,
 		labels:               bundle.Labels,
-		clientUUID:           types.NewUUID(),
+		clientUUID:           bundle.ClientUUID,
 		connMap:              newConnectionMap(bundle.ClusterConfig.LoadBalancer()),
 		smartRouting:         !bundle.ClusterConfig.Unisocket,
 		logger:               bundle.Logger,
 		failoverService:      bundle.FailoverService,
 		failoverConfig:       bundle.FailoverConfig,
 		clusterIDMu:          &sync.Mutex{},
-		randGen:              rand.New(rand.NewSource(time.Now().Unix())),
+		randGen:              rand.New(bundle.RandSource),
 		doneChMu:             &sync.RWMutex{},
 	}
 	return manager
@@ -814,13 +828,13 @@ func (m *connectionMap) RandomConn() *Connection {
 		addr = m.lb.OneOf(m.addrs)
 	}
 	conn := m.addrToConn[addr]
-	if conn != nil && conn.isAlive() {
+	if conn != nil && conn.IsAlive() {
 		return conn
 	}
 	// if the connection was not found by using the load balancer, select the first open one.
 	for _, conn = range m.uuidToConn {
 		// Go randomizes maps, this is random enough.
-		if conn.isAlive() {
+		if conn.IsAlive() {
 			return conn
 		}
 	}
@@ -831,7 +845,7 @@ func (m *connectionMap) ActiveConnections() []*Connection {
 	m.mu.RLock()
 	conns := make([]*Connection, 0, len(m.uuidToConn))
 	for _, conn := range m.uuidToConn {
-		if conn.isAlive() {
+		if conn.IsAlive() {
 			conns = append(conns, conn)
 		}
 	}
@@ -69,3 +69,43 @@ func TestMakeRetryPolicy_WithJitter(t *testing.T) {
 	target := []int64{1041, 1235, 1175, 1128, 1178, 1371, 1107, 1213, 1239, 1427}
 	assert.Equal(t, target, ts)
 }
+
+func TestMakeRetryPolicy_JitterBounds(t *testing.T) {
+	const (
+		clientCount  = 1000
+		attemptCount = 10
+		jitter       = 0.2
+	)
+	noJitterConfig := &cluster.ConnectionRetryConfig{}
+	noJitterConfig.Validate()
+	config := &cluster.ConnectionRetryConfig{Jitter: jitter}
+	config.Validate()
+	noJitter := makeRetryPolicy(rand.New(rand.NewSource(1)), noJitterConfig)
+	var backoffs []float64
+	for i := 0; i < attemptCount; i++ {
+		backoffs = append(backoffs, float64(noJitter(i)))
+	}
+	// simulate clients which start reconnecting at the same time
+	mins := make([]float64, attemptCount)
+	maxs := make([]float64, attemptCount)
+	for i := 0; i < clientCount; i++ {
+		f := makeRetryPolicy(rand.New(rand.NewSource(int64(i))), config)
+		for j := 0; j < attemptCount; j++ {
+			d := float64(f(j))
+			if d < backoffs[j]*(1-jitter) || d > backoffs[j]*(1+jitter) {
+				t.Fatalf("client %d, attempt %d: delay %v is out of jitter bounds of backoff %v", i, j, time.Duration(d), time.Duration(backoffs[j]))
+			}
+			if i == 0 || d < mins[j] {
+				mins[j] = d
+			}
+			if i == 0 || d > maxs[j] {
+				maxs[j] = d
+			}
+		}
+	}
+	// the delays must be spread over the jitter range on every attempt
+	for j := 0; j < attemptCount; j++ {
+		assert.Less(t, mins[j], backoffs[j]*(1-jitter/2), "attempt %d", j)
+		assert.Greater(t, maxs[j], backoffs[j]*(1+jitter/2), "attempt %d", j)
+	}
+}
@@ -1,6 +1,25 @@
 package cluster
 
-import "testing"
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	pubcluster "github.com/hazelcast/hazelcast-go-client/cluster"
+	"github.com/hazelcast/hazelcast-go-client/hzerrors"
+	ihzerrors "github.com/hazelcast/hazelcast-go-client/internal/hzerrors"
+	"github.com/hazelcast/hazelcast-go-client/internal/logger"
+	"github.com/hazelcast/hazelcast-go-client/internal/proto"
+	"github.com/hazelcast/hazelcast-go-client/internal/proto/codec"
+	"github.com/hazelcast/hazelcast-go-client/types"
+)
 
 func TestCalculateVersion(t *testing.T) {
 	// TODO: convert this test to table driven
@@ -26,3 +45,258 @@ func testVersionString(t *testing.T, version string, expected int32) {
 		t.Errorf("expected %d got %d", result, expected)
 	}
 }
+
+type challengeInterceptor struct {
+	response string
+}
+
+func (ci challengeInterceptor) Intercept(conn net.Conn) error {
+	buf := make([]byte, len("CHALLENGE"))
+	if _, err := io.ReadFull(conn, buf); err != nil {
+		return err
+	}
+	if string(buf) != "CHALLENGE" {
+		return errors.New("unexpected challenge")
+	}
+	_, err := conn.Write([]byte(ci.response))
+	return err
+}
+
+type errInterceptor struct{}
+
+func (errInterceptor) Intercept(conn net.Conn) error {
+	return errors.New("handshake rejected")
+}
+
+// startChallengeServer starts a server which sends a challenge and expects "RESPONSE" before the protocol starter.
+// It sends the bytes received after the response, or the error to the returned channel.
+func startChallengeServer(t *testing.T) (pubcluster.Address, <-chan string) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { ln.Close() })
+	ch := make(chan string, 1)
+	go func() {
+		conn, err := ln.Accept()
+		if err != nil {
+			ch <- err.Error()
+			return
+		}
+		defer conn.Close()
+		if _, err := conn.Write([]byte("CHALLENGE")); err != nil {
+			ch <- err.Error()
+			return
+		}
+		buf := make([]byte, len("RESPONSE"))
+		if _, err := io.ReadFull(conn, buf); err != nil {
+			ch <- err.Error()
+			return
+		}
+		if string(buf) != "RESPONSE" {
+			ch <- "unexpected response: " + string(buf)
+			return
+		}
+		buf = make([]byte, len(protocolStarter))
+		if _, err := io.ReadFull(conn, buf); err != nil {
+			ch <- err.Error()
+			return
+		}
+		ch <- string(buf)
+	}()
+	return pubcluster.Address(ln.Addr().String()), ch
+}
+
+func TestConnection_SocketInterceptor(t *testing.T) {
+	addr, ch := startChallengeServer(t)
+	nc := pubcluster.NetworkConfig{ConnectionTimeout: types.Duration(5 * time.Second)}
+	nc.SetSocketInterceptor(challengeInterceptor{response: "RESPONSE"})
+	c := &Connection{logger: logger.LogAdaptor{Logger: logger.New()}}
+	socket, err := c.createSocket(&nc, addr)
+	require.NoError(t, err)
+	defer socket.Close()
+	c.socket = socket
+	require.NoError(t, c.sendProtocolStarter())
+	select {
+	case s := <-ch:
+		// the protocol starter is sent after the handshake
+		assert.Equal(t, protocolStarter, s)
+	case <-time.After(5 * time.Second):
+		t.Fatal("the server did not receive the protocol starter")
+	}
+}
+
+func TestConnection_SocketInterceptorError(t *testing.T) {
+	addr, ch := startChallengeServer(t)
+	nc := pubcluster.NetworkConfig{ConnectionTimeout: types.Duration(5 * time.Second)}
+	nc.SetSocketInterceptor(errInterceptor{})
+	c := &Connection{logger: logger.LogAdaptor{Logger: logger.New()}}
+	_, err := c.createSocket(&nc, addr)
+	assert.EqualError(t, err, "intercepting socket: handshake rejected")
+	select {
+	case s := <-ch:
+		// the socket is closed before the response and the protocol starter are sent
+		assert.NotEqual(t, protocolStarter, s)
+	case <-time.After(5 * time.Second):
+		t.Fatal("the connection was not closed")
+	}
+}
+
+func TestConnection_SocketInterceptorTimeout(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer ln.Close()
+	// the server never sends the challenge, so the interceptor times out
+	nc := pubcluster.NetworkConfig{ConnectionTimeout: types.Duration(100 * time.Millisecond)}
+	nc.SetSocketInterceptor(challengeInterceptor{response: "RESPONSE"})
+	c := &Connection{logger: logger.LogAdaptor{Logger: logger.New()}}
+	_, err = c.createSocket(&nc, pubcluster.Address(ln.Addr().String()))
+	var nerr net.Error
+	require.True(t, errors.As(err, &nerr), "unexpected error: %v", err)
+	assert.True(t, nerr.Timeout())
+}
+
+func TestConnectionManager_ConnectionRefusedReason(t *testing.T) {
+	// close the listener, so connecting to its address is refused
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	addr := pubcluster.Address(ln.Addr().String())
+	require.NoError(t, ln.Close())
+	m := &ConnectionManager{logger: logger.LogAdaptor{Logger: logger.New()}}
+	nc := pubcluster.NetworkConfig{ConnectionTimeout: types.Duration(5 * time.Second)}
+	_, err = m.ensureConnection(context.Background(), addr, &nc)
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, hzerrors.ErrTargetDisconnected), "unexpected error: %v", err)
+	assert.True(t, errors.Is(err, syscall.ECONNREFUSED), "unexpected error: %v", err)
+	assert.Contains(t, err.Error(), "dialing "+addr.String())
+}
+
+func TestConnection_TLSHandshakeFailureReason(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer ln.Close()
+	go func() {
+		conn, err := ln.Accept()
+		if err != nil {
+			return
+		}
+		// not a TLS server
+		_, _ = conn.Write([]byte("not TLS\n"))
+		conn.Close()
+	}()
+	nc := pubcluster.NetworkConfig{ConnectionTimeout: types.Duration(5 * time.Second)}
+	nc.SSL.Enabled = true
+	c := &Connection{logger: logger.LogAdaptor{Logger: logger.New()}}
+	addr := pubcluster.Address(ln.Addr().String())
+	_, err = c.createSocket(&nc, addr)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "TLS handshake with "+addr.String())
+}
+
+func TestWrapError_ServerErrorTypes(t *testing.T) {
+	testCases := []struct {
+		target    func(err error) bool
+		sentinel  error
+		className string
+		code      errorCode
+	}{
+		{
+			className: "com.hazelcast.spi.exception.TargetNotMemberException",
+			code:      errorCodeTargetNotMember,
+			sentinel:  hzerrors.ErrTargetNotMember,
+			target: func(err error) bool {
+				var e *hzerrors.TargetNotMemberError
+				return errors.As(err, &e)
+			},
+		},
+		{
+			className: "com.hazelcast.core.HazelcastInstanceNotActiveException",
+			code:      errorCodeHazelcastInstanceNotActive,
+			sentinel:  hzerrors.ErrHazelcastInstanceNotActive,
+			target: func(err error) bool {
+				var e *hzerrors.InstanceNotActiveError
+				return errors.As(err, &e)
+			},
+		},
+		{
+			className: "com.hazelcast.query.QueryException",
+			code:      errorCodeQuery,
+			sentinel:  hzerrors.ErrQuery,
+			target: func(err error) bool {
+				var e *hzerrors.QueryError
+				return errors.As(err, &e)
+			},
+		},
+		{
+			className: "com.hazelcast.client.AuthenticationException",
+			code:      errorCodeAuthentication,
+			sentinel:  hzerrors.ErrAuthentication,
+			target: func(err error) bool {
+				var e *hzerrors.AuthenticationError
+				return errors.As(err, &e)
+			},
+		},
+		{
+			className: "com.hazelcast.core.OperationTimeoutException",
+			code:      errorCodeOperationTimeout,
+			sentinel:  hzerrors.ErrOperationTimeout,
+			target: func(err error) bool {
+				var e *hzerrors.OperationTimeoutError
+				return errors.As(err, &e)
+			},
+		},
+		{
+			className: "java.lang.IllegalStateException",
+			code:      errorCodeIllegalState,
+			sentinel:  hzerrors.ErrIllegalState,
+			target: func(err error) bool {
+				var e *hzerrors.QueryError
+				// not a well-known exception, so only *hzerrors.ServerError matches.
+				return !errors.As(err, &e)
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.className, func(t *testing.T) {
+			trace := []ihzerrors.StackTraceElement{
+				{ClassName: "com.hazelcast.Foo", MethodName: "bar", FileName: "Foo.java", LineNumber: 42},
+			}
+			msg := encodeErrorMessage(
+				ihzerrors.NewErrorHolder(int32(tc.code), tc.className, "the message", trace),
+				ihzerrors.NewErrorHolder(int32(errorCodeIO), "java.io.IOException", "the cause", nil),
+			)
+			se := codec.DecodeError(msg)
+			require.NotNil(t, se)
+			err := wrapError(se)
+			assert.True(t, tc.target(err))
+			assert.True(t, errors.Is(err, tc.sentinel))
+			var pse *hzerrors.ServerError
+			require.True(t, errors.As(err, &pse))
+			assert.Equal(t, tc.className, pse.ClassName)
+			assert.Equal(t, "the message", pse.Message)
+			assert.Equal(t, int32(tc.code), pse.ErrorCode)
+			assert.Equal(t, trace, pse.StackTrace)
+			require.NotNil(t, pse.Cause)
+			assert.Equal(t, "java.io.IOException", pse.Cause.ClassName)
+			assert.Equal(t, "the cause", pse.Cause.Message)
+			assert.Nil(t, pse.Cause.Cause)
+			target := tc.className + ": the message\n" +
+				"\tat com.hazelcast.Foo.bar(Foo.java:42)\n" +
+				"Caused by: java.io.IOException: the cause\n"
+			assert.Equal(t, target, pse.StackTraceString())
+			// the internal server error is still in the chain.
+			var ise *ihzerrors.ServerError
+			require.True(t, errors.As(err, &ise))
+			assert.Equal(t, tc.className, ise.ClassName())
+		})
+	}
+}
+
+func encodeErrorMessage(holders ...ihzerrors.ErrorHolder) *proto.ClientMessage {
+	msg := proto.NewClientMessageForEncode()
+	msg.AddFrame(proto.NewFrame(make([]byte, proto.ResponseBackupAcksOffset+proto.ByteSizeInBytes)))
+	msg.AddFrame(proto.NewBeginFrame())
+	for _, h := range holders {
+		codec.EncodeErrorHolder(msg, h)
+	}
+	msg.AddFrame(proto.NewEndFrame())
+	return msg
+}
@@ -36,10 +36,12 @@ type HeartbeatService struct {
 	logger     logger.LogAdaptor
 	interval   time.Duration
 	timeout    time.Duration
-	state      int32
+	// keepAlive is the maximum idle time of a connection before a keepalive ping is sent, zero disables keepalive pings
+	keepAlive time.Duration
+	state     int32
 }
 
-func NewHeartbeatService(cm *ConnectionManager, f *ConnectionInvocationFactory, invService *invocation.Service, logger logger.LogAdaptor, interval, timeout time.Duration) *HeartbeatService {
+func NewHeartbeatService(cm *ConnectionManager, f *ConnectionInvocationFactory, invService *invocation.Service, logger logger.LogAdaptor, interval, timeout, keepAlive time.Duration) *HeartbeatService {
 	return &HeartbeatService{
 		cm:         cm,
 		invFactory: f,
@@ -48,12 +50,16 @@ func NewHeartbeatService(cm *ConnectionManager, f *ConnectionInvocationFactory,
 		doneCh:     make(chan struct{}),
 		interval:   interval,
 		timeout:    timeout,
+		keepAlive:  keepAlive,
 		state:      ready,
 	}
 }
 
 func (hs *HeartbeatService) Start() {
 	go hs.checkConnections()
+	if hs.keepAlive > 0 {
+		go hs.keepConnectionsAlive()
+	}
 }
 
 func (hs *HeartbeatService) Stop() {
@@ -78,7 +84,7 @@ func (hs *HeartbeatService) checkConnections() {
 }
 
 func (hs *HeartbeatService) sendHeartbeat(conn *Connection, timeout, interval time.Duration) {
-	if !conn.isAlive() {
+	if !conn.IsAlive() {
 		return
 	}
 	now := time.Now()
@@ -96,11 +102,51 @@ func (hs *HeartbeatService) sendHeartbeat(conn *Connection, timeout, interval ti
 	hs.logger.Trace(func() string {
 		return fmt.Sprintf("heartbeat: %s", conn.String())
 	})
+	hs.sendPing(conn)
+}
+
+func (hs *HeartbeatService) keepConnectionsAlive() {
+	// checking at every keepalive interval would let a connection stay idle for almost two intervals, checking at half of it limits that to one and a half.
+	period := hs.keepAlive / 2
+	if period <= 0 {
+		period = hs.keepAlive
+	}
+	ticker := time.NewTicker(period)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-hs.doneCh:
+			return
+		case <-ticker.C:
+			for _, conn := range hs.cm.ActiveConnections() {
+				hs.sendKeepAlive(conn, hs.keepAlive)
+			}
+		}
+	}
+}
+
+// sendKeepAlive sends a ping to the member if nothing was written to the connection for the idle duration.
+// The response of the ping is not checked, failed connections are detected by heartbeats.
+func (hs *HeartbeatService) sendKeepAlive(conn *Connection, idle time.Duration) {
+	if !conn.IsAlive() {
+		return
+	}
+	if conn.lastWrite.Load().(time.Time).After(time.Now().Add(-idle)) {
+		// the connection is not idle
+		return
+	}
+	hs.logger.Trace(func() string {
+		return fmt.Sprintf("keepalive: %s", conn.String())
+	})
+	hs.sendPing(conn)
+}
+
+func (hs *HeartbeatService) sendPing(conn *Connection) {
 	request := codec.EncodeClientPingRequest()
 	inv := hs.invFactory.NewConnectionBoundInvocation(request, conn, nil, time.Now())
 	if err := hs.invService.SendUrgentRequest(context.Background(), inv); err != nil {
 		hs.logger.Debug(func() string {
-			return fmt.Sprintf("Failed to send the heartbeat request: %s", err.Error())
+			return fmt.Sprintf("Failed to send the ping request: %s", err.Error())
 		})
 	}
 }
@@ -0,0 +1,71 @@
+/*
+ * Copyright (c) 2008-2023, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cluster
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	pubcluster "github.com/hazelcast/hazelcast-go-client/cluster"
+	"github.com/hazelcast/hazelcast-go-client/internal/event"
+	"github.com/hazelcast/hazelcast-go-client/internal/invocation"
+	"github.com/hazelcast/hazelcast-go-client/internal/logger"
+	"github.com/hazelcast/hazelcast-go-client/internal/proto/codec"
+)
+
+func TestHeartbeatService_SendKeepAlive(t *testing.T) {
+	lg := logger.LogAdaptor{Logger: logger.New()}
+	ed := event.NewDispatchService(lg)
+	defer ed.Stop(context.Background())
+	h := &messageTypeRecorder{ch: make(chan int32, 10)}
+	is := invocation.NewService(h, ed, lg, 0)
+	defer is.Stop()
+	hs := NewHeartbeatService(nil, NewConnectionInvocationFactory(&pubcluster.Config{}), is, lg, time.Minute, time.Minute, 50*time.Millisecond)
+	conn := &Connection{status: open}
+	conn.lastWrite.Store(time.Now())
+	// the connection was written to recently, so it is not idle.
+	hs.sendKeepAlive(conn, 50*time.Millisecond)
+	assert.Len(t, h.ch, 0)
+	time.Sleep(100 * time.Millisecond)
+	hs.sendKeepAlive(conn, 50*time.Millisecond)
+	select {
+	case mt := <-h.ch:
+		assert.Equal(t, codec.ClientPingCodecRequestMessageType, mt)
+	case <-time.After(5 * time.Second):
+		t.Fatalf("keepalive ping was not sent")
+	}
+	// a write resets the idle time.
+	conn.lastWrite.Store(time.Now())
+	hs.sendKeepAlive(conn, 50*time.Millisecond)
+	// pings are not sent on closed connections.
+	conn.status = closed
+	conn.lastWrite.Store(time.Time{})
+	hs.sendKeepAlive(conn, 50*time.Millisecond)
+	assert.Len(t, h.ch, 0)
+}
+
+type messageTypeRecorder struct {
+	ch chan int32
+}
+
+func (r *messageTypeRecorder) Invoke(inv invocation.Invocation) (int64, error) {
+	r.ch <- inv.Request().Type()
+	return 0, nil
+}
@@ -0,0 +1,113 @@
+/*
+ * Copyright (c) 2008-2023, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cluster
+
+import (
+	"sync"
+	"time"
+
+	pubcluster "github.com/hazelcast/hazelcast-go-client/cluster"
+)
+
+// MembersCoalescer collects the membership changes within a time window and reports their net delta at once.
+// A member which is added and removed within the same window, or vice versa, is not reported.
+type MembersCoalescer struct {
+	handler   pubcluster.MembersChangedHandler
+	timer     *time.Timer
+	mu        *sync.Mutex
+	handlerMu *sync.Mutex
+	added     []pubcluster.MemberInfo
+	removed   []pubcluster.MemberInfo
+	window    time.Duration
+	stopped   bool
+}
+
+func NewMembersCoalescer(window time.Duration, handler pubcluster.MembersChangedHandler) *MembersCoalescer {
+	return &MembersCoalescer{
+		handler:   handler,
+		mu:        &sync.Mutex{},
+		handlerMu: &sync.Mutex{},
+		window:    window,
+	}
+}
+
+// Handle records the members in the event.
+// The handler is called once the window which started with the first change after the last call elapses.
+// Events are ignored after Stop is called.
+func (c *MembersCoalescer) Handle(e *MembersStateChangedEvent) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.stopped {
+		return
+	}
+	for _, m := range e.Members {
+		if e.State == MembersStateAdded {
+			c.added, c.removed = addMember(c.added, c.removed, m)
+		} else {
+			c.removed, c.added = addMember(c.removed, c.added, m)
+		}
+	}
+	if c.timer == nil {
+		c.timer = time.AfterFunc(c.window, c.flush)
+	}
+}
+
+// Stop stops the pending window, if there is one, and drops the changes collected in it.
+// The handler is not called after Stop returns, unless it was already being called.
+func (c *MembersCoalescer) Stop() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.stopped = true
+	if c.timer != nil {
+		c.timer.Stop()
+		c.timer = nil
+	}
+	c.added, c.removed = nil, nil
+}
+
+func (c *MembersCoalescer) flush() {
+	// handlerMu makes sure the handler is not called concurrently by a later window.
+	c.handlerMu.Lock()
+	defer c.handlerMu.Unlock()
+	c.mu.Lock()
+	added, removed := c.added, c.removed
+	c.added, c.removed = nil, nil
+	c.timer = nil
+	c.mu.Unlock()
+	if len(added) == 0 && len(removed) == 0 {
+		return
+	}
+	c.handler(pubcluster.MembersChanged{
+		Added:   added,
+		Removed: removed,
+	})
+}
+
+// addMember adds m to members, unless it is in opposite, in which case the two changes cancel each other.
+func addMember(members, opposite []pubcluster.MemberInfo, m pubcluster.MemberInfo) ([]pubcluster.MemberInfo, []pubcluster.MemberInfo) {
+	for i, om := range opposite {
+		if om.UUID == m.UUID {
+			return members, append(opposite[:i], opposite[i+1:]...)
+		}
+	}
+	for _, em := range members {
+		if em.UUID == m.UUID {
+			return members, opposite
+		}
+	}
+	return append(members, m), opposite
+}
@@ -0,0 +1,99 @@
+/*
+ * Copyright (c) 2008-2023, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cluster
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	pubcluster "github.com/hazelcast/hazelcast-go-client/cluster"
+	"github.com/hazelcast/hazelcast-go-client/types"
+)
+
+func TestMembersCoalescer_NetDelta(t *testing.T) {
+	members := make([]pubcluster.MemberInfo, 6)
+	for i := range members {
+		members[i] = pubcluster.MemberInfo{UUID: types.NewUUID()}
+	}
+	ch := make(chan pubcluster.MembersChanged, 10)
+	mc := NewMembersCoalescer(100*time.Millisecond, func(e pubcluster.MembersChanged) {
+		ch <- e
+	})
+	// members 0, 1 and 2 restart with new UUIDs: 3, 4 and 5.
+	// member 2 is removed and added back within the window.
+	mc.Handle(NewMemberRemoved(members[0:3]))
+	mc.Handle(NewMembersAdded(members[3:4]))
+	mc.Handle(NewMembersAdded(members[2:3]))
+	mc.Handle(NewMembersAdded(members[4:6]))
+	// member 5 is added and removed within the window.
+	mc.Handle(NewMemberRemoved(members[5:6]))
+	select {
+	case e := <-ch:
+		assert.Equal(t, members[3:5], e.Added)
+		assert.Equal(t, members[0:2], e.Removed)
+	case <-time.After(5 * time.Second):
+		t.Fatal("the members changed handler was not called")
+	}
+	select {
+	case e := <-ch:
+		t.Fatalf("unexpected members changed event: %v", e)
+	case <-time.After(200 * time.Millisecond):
+	}
+	// the next change starts a new window
+	mc.Handle(NewMemberRemoved(members[3:4]))
+	select {
+	case e := <-ch:
+		assert.Empty(t, e.Added)
+		assert.Equal(t, members[3:4], e.Removed)
+	case <-time.After(5 * time.Second):
+		t.Fatal("the members changed handler was not called")
+	}
+}
+
+func TestMembersCoalescer_NoNetChange(t *testing.T) {
+	m := pubcluster.MemberInfo{UUID: types.NewUUID()}
+	ch := make(chan pubcluster.MembersChanged, 1)
+	mc := NewMembersCoalescer(50*time.Millisecond, func(e pubcluster.MembersChanged) {
+		ch <- e
+	})
+	mc.Handle(NewMembersAdded([]pubcluster.MemberInfo{m}))
+	mc.Handle(NewMemberRemoved([]pubcluster.MemberInfo{m}))
+	select {
+	case e := <-ch:
+		t.Fatalf("unexpected members changed event: %v", e)
+	case <-time.After(200 * time.Millisecond):
+	}
+}
+
+func TestMembersCoalescer_Stop(t *testing.T) {
+	m := pubcluster.MemberInfo{UUID: types.NewUUID()}
+	ch := make(chan pubcluster.MembersChanged, 1)
+	mc := NewMembersCoalescer(50*time.Millisecond, func(e pubcluster.MembersChanged) {
+		ch <- e
+	})
+	mc.Handle(NewMembersAdded([]pubcluster.MemberInfo{m}))
+	mc.Stop()
+	// events after stop are ignored
+	mc.Handle(NewMemberRemoved([]pubcluster.MemberInfo{m}))
+	select {
+	case e := <-ch:
+		t.Fatalf("unexpected members changed event: %v", e)
+	case <-time.After(200 * time.Millisecond):
+	}
+}
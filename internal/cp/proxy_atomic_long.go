@@ -18,7 +18,10 @@ package cp
 
 import (
 	"context"
+	"errors"
 
+	"github.com/hazelcast/hazelcast-go-client/hzerrors"
+	ihzerrors "github.com/hazelcast/hazelcast-go-client/internal/hzerrors"
 	"github.com/hazelcast/hazelcast-go-client/internal/proto"
 	"github.com/hazelcast/hazelcast-go-client/internal/proto/codec"
 )
@@ -118,7 +121,7 @@ func (a *AtomicLong) Apply(ctx context.Context, function interface{}) (interface
 	request := codec.EncodeAtomicLongApplyRequest(a.groupID, a.name, data)
 	response, err := a.invokeOnRandomTarget(ctx, request, nil)
 	if err != nil {
-		return nil, err
+		return nil, wrapFunctionError(err)
 	}
 	obj, err := a.ss.ToObject(codec.DecodeAtomicLongApplyResponse(response))
 	if err != nil {
@@ -135,7 +138,7 @@ func (a *AtomicLong) alter(ctx context.Context, function interface{}, valueType
 	request := codec.EncodeAtomicLongAlterRequest(a.groupID, a.name, data, valueType)
 	response, err := a.invokeOnRandomTarget(ctx, request, nil)
 	if err != nil {
-		return nil, err
+		return nil, wrapFunctionError(err)
 	}
 	return response, nil
 }
@@ -189,3 +192,38 @@ func (a *AtomicLong) GetAndDecrement(ctx context.Context) (int64, error) {
 func (a *AtomicLong) GetAndIncrement(ctx context.Context) (int64, error) {
 	return a.GetAndAdd(ctx, 1)
 }
+
+// nonFunctionErrors are raised by the cluster or the CP subsystem rather than by executing a function, so they are not wrapped with *hzerrors.FunctionError.
+var nonFunctionErrors = []error{
+	hzerrors.ErrCPGroupDestroyedException,
+	hzerrors.ErrSessionExpiredException,
+	hzerrors.ErrNotLeaderException,
+	hzerrors.ErrLeaderDemotedException,
+	hzerrors.ErrStaleAppendRequestException,
+	hzerrors.ErrMutationDisallowedException,
+	hzerrors.ErrConsistencyLostException,
+	hzerrors.ErrIndeterminateOperationState,
+	hzerrors.ErrHazelcastInstanceNotActive,
+	hzerrors.ErrDistributedObjectDestroyed,
+	hzerrors.ErrOperationTimeout,
+	hzerrors.ErrSplitBrainProtection,
+}
+
+// wrapFunctionError wraps the error raised on the member while executing a function with *hzerrors.FunctionError.
+// Other errors are returned as is.
+func wrapFunctionError(err error) error {
+	var se *ihzerrors.ServerError
+	if !errors.As(err, &se) || ihzerrors.IsRetryable(err) {
+		return err
+	}
+	for _, e := range nonFunctionErrors {
+		if errors.Is(err, e) {
+			return err
+		}
+	}
+	return &hzerrors.FunctionError{
+		ClassName: se.ClassName(),
+		Message:   se.Message(),
+		Err:       err,
+	}
+}
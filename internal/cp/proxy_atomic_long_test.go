@@ -0,0 +1,71 @@
+/*
+ * Copyright (c) 2008-2023, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cp
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hazelcast/hazelcast-go-client/hzerrors"
+	ihzerrors "github.com/hazelcast/hazelcast-go-client/internal/hzerrors"
+)
+
+func TestWrapFunctionError(t *testing.T) {
+	se := ihzerrors.NewServerError([]ihzerrors.ErrorHolder{
+		ihzerrors.NewErrorHolder(0, "java.lang.IllegalStateException", "function failed", nil),
+		ihzerrors.NewErrorHolder(0, "java.lang.ArithmeticException", "/ by zero", nil),
+	})
+	serverErr := ihzerrors.NewClientError(se.String(), se, hzerrors.ErrIllegalState)
+	err := wrapFunctionError(serverErr)
+	var fe *hzerrors.FunctionError
+	require.True(t, errors.As(err, &fe))
+	assert.Equal(t, "java.lang.IllegalStateException", fe.ClassName)
+	assert.Equal(t, "function failed", fe.Message)
+	assert.Equal(t, "function failed on the member: java.lang.IllegalStateException: function failed", fe.Error())
+	assert.True(t, errors.Is(err, hzerrors.ErrIllegalState))
+}
+
+func TestWrapFunctionError_NonServerError(t *testing.T) {
+	err := ihzerrors.NewIOError("connection closed", nil)
+	assert.Equal(t, err, wrapFunctionError(err))
+}
+
+func TestWrapFunctionError_CPSubsystemError(t *testing.T) {
+	testCases := []struct {
+		className string
+		err       error
+	}{
+		{className: "com.hazelcast.cp.exception.CPGroupDestroyedException", err: hzerrors.ErrCPGroupDestroyedException},
+		{className: "com.hazelcast.cp.internal.session.SessionExpiredException", err: hzerrors.ErrSessionExpiredException},
+		{className: "com.hazelcast.spi.exception.WrongTargetException", err: hzerrors.ErrWrongTarget},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.className, func(t *testing.T) {
+			se := ihzerrors.NewServerError([]ihzerrors.ErrorHolder{
+				ihzerrors.NewErrorHolder(0, tc.className, "not caused by the function", nil),
+			})
+			serverErr := ihzerrors.NewClientError(se.String(), se, tc.err)
+			err := wrapFunctionError(serverErr)
+			var fe *hzerrors.FunctionError
+			assert.False(t, errors.As(err, &fe))
+			assert.Equal(t, serverErr, err)
+		})
+	}
+}
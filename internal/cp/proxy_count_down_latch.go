@@ -0,0 +1,127 @@
+/*
+ * Copyright (c) 2008-2023, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cp
+
+import (
+	"context"
+	"time"
+
+	ihzerrors "github.com/hazelcast/hazelcast-go-client/internal/hzerrors"
+	"github.com/hazelcast/hazelcast-go-client/internal/proto/codec"
+	"github.com/hazelcast/hazelcast-go-client/types"
+)
+
+/*
+CountDownLatch is a linearizable and distributed synchronization aid that allows one or more goroutines to wait until a set of operations being performed in other goroutines or processes completes.
+It works on top of the Raft consensus algorithm and is CP with respect to the CAP principle.
+
+A latch is initialized with a count using TrySetCount.
+The Await functions block until the count reaches zero due to invocations of CountDown.
+After the count reaches zero, the latch can be reused by setting a new count.
+
+	latch, err := client.CPSubsystem().GetCountDownLatch(ctx, "my-latch")
+	ok, err := latch.TrySetCount(ctx, 2)
+	// in other goroutines or processes:
+	err = latch.CountDown(ctx)
+	// ...
+	reachedZero, count, err := latch.Await(ctx, 10*time.Second)
+*/
+type CountDownLatch struct {
+	*proxy
+}
+
+/*
+CountDownLatch implementation is type aliased in the public API so all the exported fields and methods are directly accessible by users.
+Be aware of that while editing the fields and methods of both proxy and CountDownLatch structs.
+*/
+
+/*
+Await waits until the count reaches zero, the given timeout elapses or the context is done.
+If the count reaches zero within the timeout, it returns true and a zero count.
+Otherwise, it returns false and the count at the end of the wait, so the caller can decide whether to keep waiting.
+The wait is performed by the cluster in a single call, the count is fetched only once after the timeout elapses.
+If the context is done before the timeout elapses, the context error is returned without waiting for the timeout.
+A non-positive timeout does not wait and only checks the count.
+*/
+func (c *CountDownLatch) Await(ctx context.Context, timeout time.Duration) (reachedZero bool, count int32, err error) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if timeout < 0 {
+		timeout = 0
+	}
+	request := codec.EncodeCountDownLatchAwaitRequest(c.groupID, c.name, types.NewUUID(), timeout.Milliseconds())
+	response, err := c.invokeOnRandomTarget(ctx, request, nil)
+	if err != nil {
+		return false, 0, err
+	}
+	if codec.DecodeCountDownLatchAwaitResponse(response) {
+		return true, 0, nil
+	}
+	count, err = c.GetCount(ctx)
+	if err != nil {
+		return false, 0, err
+	}
+	return false, count, nil
+}
+
+// CountDown decrements the count of the latch, releasing all waiting goroutines if the count reaches zero.
+// If the count is already zero, nothing happens.
+func (c *CountDownLatch) CountDown(ctx context.Context) error {
+	round, err := c.getRound(ctx)
+	if err != nil {
+		return err
+	}
+	// the same invocation UID is used when the request is retried, so the count is decremented at most once.
+	request := codec.EncodeCountDownLatchCountDownRequest(c.groupID, c.name, types.NewUUID(), round)
+	_, err = c.invokeOnRandomTarget(ctx, request, nil)
+	return err
+}
+
+// GetCount returns the current count.
+func (c *CountDownLatch) GetCount(ctx context.Context) (int32, error) {
+	request := codec.EncodeCountDownLatchGetCountRequest(c.groupID, c.name)
+	response, err := c.invokeOnRandomTarget(ctx, request, nil)
+	if err != nil {
+		return 0, err
+	}
+	return codec.DecodeCountDownLatchGetCountResponse(response), nil
+}
+
+// TrySetCount sets the count to the given value if the current count is zero.
+// Returns true if the count was set, false if the current count is not zero.
+// The count must be positive.
+func (c *CountDownLatch) TrySetCount(ctx context.Context, count int32) (bool, error) {
+	if count <= 0 {
+		return false, ihzerrors.NewIllegalArgumentError("count must be positive", nil)
+	}
+	request := codec.EncodeCountDownLatchTrySetCountRequest(c.groupID, c.name, count)
+	response, err := c.invokeOnRandomTarget(ctx, request, nil)
+	if err != nil {
+		return false, err
+	}
+	return codec.DecodeCountDownLatchTrySetCountResponse(response), nil
+}
+
+func (c *CountDownLatch) getRound(ctx context.Context) (int32, error) {
+	request := codec.EncodeCountDownLatchGetRoundRequest(c.groupID, c.name)
+	response, err := c.invokeOnRandomTarget(ctx, request, nil)
+	if err != nil {
+		return 0, err
+	}
+	return codec.DecodeCountDownLatchGetRoundResponse(response), nil
+}
@@ -19,6 +19,7 @@ package cp
 import (
 	"context"
 	"strings"
+	"sync"
 
 	"github.com/hazelcast/hazelcast-go-client/internal/cluster"
 	"github.com/hazelcast/hazelcast-go-client/internal/cp/types"
@@ -30,11 +31,13 @@ import (
 )
 
 const (
-	atomicLongService   = "hz:raft:atomicLongService"
-	atomicRefService    = "hz:raft:atomicRefService"
-	cpMapService        = "hz:raft:mapService"
-	defaultGroupName    = "default"
-	metadataCPGroupName = "metadata"
+	atomicLongService     = "hz:raft:atomicLongService"
+	atomicRefService      = "hz:raft:atomicRefService"
+	cpMapService          = "hz:raft:mapService"
+	countDownLatchService = "hz:raft:countDownLatchService"
+	lockService           = "hz:raft:lockService"
+	defaultGroupName      = "default"
+	metadataCPGroupName   = "metadata"
 )
 
 type proxyFactory struct {
@@ -42,41 +45,86 @@ type proxyFactory struct {
 	ss         *iserialization.Service
 	invFactory *cluster.ConnectionInvocationFactory
 	lg         *logger.LogAdaptor
+	sm         *sessionManager
+	// proxies caches the proxies keyed by the service and the name without the default group name.
+	proxies   map[string]interface{}
+	proxiesMu *sync.Mutex
 }
 
-func newProxyFactory(ss *iserialization.Service, invFactory *cluster.ConnectionInvocationFactory, is *invocation.Service, lg *logger.LogAdaptor) *proxyFactory {
+func newProxyFactory(ss *iserialization.Service, invFactory *cluster.ConnectionInvocationFactory, is *invocation.Service, lg *logger.LogAdaptor, clientName string) *proxyFactory {
 	return &proxyFactory{
 		is:         is,
 		invFactory: invFactory,
 		ss:         ss,
 		lg:         lg,
+		sm:         newSessionManager(newProxy(ss, invFactory, is, lg, "", ""), lg, clientName),
+		proxies:    map[string]interface{}{},
+		proxiesMu:  &sync.Mutex{},
 	}
 }
 
-func (m *proxyFactory) getOrCreateProxy(ctx context.Context, service string, nameWithGroup string) (interface{}, error) {
+// getOrCreateProxy returns the cached proxy for the given service and name, or creates and caches it.
+// If gids is not nil, it is used as a cache of group IDs keyed by the group name, so the METADATA CP group is committed to once per group.
+func (m *proxyFactory) getOrCreateProxy(ctx context.Context, service string, nameWithGroup string, gids map[string]types.RaftGroupID) (interface{}, error) {
 	name, err := withoutDefaultGroupName(nameWithGroup)
 	if err != nil {
 		return nil, err
 	}
-	obj, err := objectNameForProxy(name)
-	if err != nil {
-		return nil, err
+	key := service + ":" + name
+	m.proxiesMu.Lock()
+	obj, ok := m.proxies[key]
+	m.proxiesMu.Unlock()
+	if ok {
+		return obj, nil
 	}
-	p := newProxy(m.ss, m.invFactory, m.is, m.lg, service, obj)
-	gid, err := m.createGroupID(ctx, p, name)
+	p, err := m.newProxyInGroup(ctx, service, name, gids)
 	if err != nil {
 		return nil, err
 	}
-	p.groupID = gid
 	switch service {
 	case atomicLongService:
-		return &AtomicLong{p}, nil
+		obj = &AtomicLong{p}
 	case atomicRefService:
-		return &AtomicRef{p}, nil
+		obj = &AtomicRef{p}
 	case cpMapService:
-		return &Map{p}, nil
+		obj = &Map{p}
+	case countDownLatchService:
+		obj = &CountDownLatch{p}
+	case lockService:
+		obj = newFencedLock(p, m.sm)
+	default:
+		return nil, hzerrors.NewIllegalArgumentError("requested data structure is not supported by Go Client CP Subsystem", nil)
+	}
+	m.proxiesMu.Lock()
+	defer m.proxiesMu.Unlock()
+	// another goroutine may have created the same proxy in the meantime, the first one wins.
+	if cached, ok := m.proxies[key]; ok {
+		return cached, nil
+	}
+	m.proxies[key] = obj
+	return obj, nil
+}
+
+// newProxyInGroup creates a proxy and resolves the ID of its CP group.
+// name must be passed through withoutDefaultGroupName.
+func (m *proxyFactory) newProxyInGroup(ctx context.Context, service string, name string, gids map[string]types.RaftGroupID) (*proxy, error) {
+	obj, err := objectNameForProxy(name)
+	if err != nil {
+		return nil, err
+	}
+	p := newProxy(m.ss, m.invFactory, m.is, m.lg, service, obj)
+	group := groupNameForProxy(name)
+	gid, ok := gids[group]
+	if !ok {
+		if gid, err = m.createGroupID(ctx, p, name); err != nil {
+			return nil, err
+		}
+		if gids != nil {
+			gids[group] = gid
+		}
 	}
-	return nil, hzerrors.NewIllegalArgumentError("requested data structure is not supported by Go Client CP Subsystem", nil)
+	p.groupID = gid
+	return p, nil
 }
 
 func (m *proxyFactory) createGroupID(ctx context.Context, p *proxy, proxyName string) (types.RaftGroupID, error) {
@@ -92,6 +140,9 @@ func objectNameForProxy(name string) (string, error) {
 	// ported from: com.hazelcast.cp.internal.RaftService#getObjectNameForProxy
 	idx := strings.Index(name, "@")
 	if idx == -1 {
+		if strings.TrimSpace(name) == "" {
+			return "", hzerrors.NewIllegalArgumentError("object name cannot be empty string", nil)
+		}
 		return name, nil
 	}
 	group := strings.TrimSpace(name[idx+1:])
@@ -105,6 +156,16 @@ func objectNameForProxy(name string) (string, error) {
 	return obj, nil
 }
 
+// groupNameForProxy returns the CP group name of a proxy name which was passed through withoutDefaultGroupName.
+// It returns a blank string for the default CP group.
+func groupNameForProxy(name string) string {
+	idx := strings.Index(name, "@")
+	if idx == -1 {
+		return ""
+	}
+	return strings.TrimSpace(name[idx+1:])
+}
+
 func withoutDefaultGroupName(proxyName string) (string, error) {
 	// ported from: com.hazelcast.cp.internal.RaftService#withoutDefaultGroupName
 	name := strings.TrimSpace(proxyName)
@@ -126,15 +187,28 @@ func withoutDefaultGroupName(proxyName string) (string, error) {
 }
 
 func (m *proxyFactory) getAtomicLong(ctx context.Context, name string) (*AtomicLong, error) {
-	p, err := m.getOrCreateProxy(ctx, atomicLongService, name)
+	als, err := m.getAtomicLongs(ctx, []string{name})
 	if err != nil {
 		return nil, err
 	}
-	return p.(*AtomicLong), nil
+	return als[0], nil
+}
+
+func (m *proxyFactory) getAtomicLongs(ctx context.Context, names []string) ([]*AtomicLong, error) {
+	gids := map[string]types.RaftGroupID{}
+	als := make([]*AtomicLong, len(names))
+	for i, name := range names {
+		p, err := m.getOrCreateProxy(ctx, atomicLongService, name, gids)
+		if err != nil {
+			return nil, err
+		}
+		als[i] = p.(*AtomicLong)
+	}
+	return als, nil
 }
 
 func (m *proxyFactory) getAtomicRef(ctx context.Context, name string) (*AtomicRef, error) {
-	p, err := m.getOrCreateProxy(ctx, atomicRefService, name)
+	p, err := m.getOrCreateProxy(ctx, atomicRefService, name, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -142,9 +216,25 @@ func (m *proxyFactory) getAtomicRef(ctx context.Context, name string) (*AtomicRe
 }
 
 func (m *proxyFactory) getMap(ctx context.Context, name string) (*Map, error) {
-	p, err := m.getOrCreateProxy(ctx, cpMapService, name)
+	p, err := m.getOrCreateProxy(ctx, cpMapService, name, nil)
 	if err != nil {
 		return nil, err
 	}
 	return p.(*Map), nil
 }
+
+func (m *proxyFactory) getCountDownLatch(ctx context.Context, name string) (*CountDownLatch, error) {
+	p, err := m.getOrCreateProxy(ctx, countDownLatchService, name, nil)
+	if err != nil {
+		return nil, err
+	}
+	return p.(*CountDownLatch), nil
+}
+
+func (m *proxyFactory) getLock(ctx context.Context, name string) (*FencedLock, error) {
+	p, err := m.getOrCreateProxy(ctx, lockService, name, nil)
+	if err != nil {
+		return nil, err
+	}
+	return p.(*FencedLock), nil
+}
@@ -16,11 +16,15 @@
 package cp
 
 import (
+	"context"
+	"errors"
 	"sort"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	"github.com/hazelcast/hazelcast-go-client/hzerrors"
 )
 
 func TestProxyFactory(t *testing.T) {
@@ -32,9 +36,14 @@ func TestProxyFactory(t *testing.T) {
 		{name: "ObjectNameForProxy", f: objectNameForProxyTest, noParallel: false},
 		{name: "ObjectNameForProxy_WithEmptyObjectName", f: objectNameForProxyWithEmptyObjectNameTest, noParallel: false},
 		{name: "ObjectNameForProxy_WithEmptyProxyName", f: objectNameForProxyWithEmptyProxyNameTest, noParallel: false},
+		{name: "GroupNameForProxy", f: groupNameForProxyTest, noParallel: false},
 		{name: "WithoutDefaultGroupName", f: withoutDefaultGroupNameTest, noParallel: false},
 		{name: "WithoutDefaultGroupName_WithMultipleGroupNames", f: withoutDefaultGroupNameWithMultipleGroupNamesTest, noParallel: false},
 		{name: "WithoutDefaultGroupName_WithMetadataGroupName", f: withoutDefaultGroupNameWithMetadataGroupNameTest, noParallel: false},
+		{name: "GetAtomicLong_Cached", f: getAtomicLongCachedTest, noParallel: false},
+		{name: "GetAtomicLong_InvalidName", f: getAtomicLongInvalidNameTest, noParallel: false},
+		{name: "GetAtomicRef_Cached", f: getAtomicRefCachedTest, noParallel: false},
+		{name: "GetAtomicRef_InvalidName", f: getAtomicRefInvalidNameTest, noParallel: false},
 	}
 	// run no-parallel test first
 	sort.Slice(tests, func(i, j int) bool {
@@ -74,6 +83,15 @@ func objectNameForProxyWithEmptyProxyNameTest(t *testing.T) {
 	assert.Error(t, err, "Custom CP group name cannot be empty string")
 }
 
+func groupNameForProxyTest(t *testing.T) {
+	require.Equal(t, "", groupNameForProxy("test"))
+	require.Equal(t, "custom", groupNameForProxy("test@custom"))
+	require.Equal(t, "custom", groupNameForProxy("test@ custom "))
+	n, err := withoutDefaultGroupName("test@default")
+	require.NoError(t, err)
+	require.Equal(t, "", groupNameForProxy(n))
+}
+
 func withoutDefaultGroupNameTest(t *testing.T) {
 	n, err := withoutDefaultGroupName("test@default")
 	require.NoError(t, err)
@@ -97,3 +115,52 @@ func withoutDefaultGroupNameWithMetadataGroupNameTest(t *testing.T) {
 	_, err = withoutDefaultGroupName("test@metadata")
 	require.Error(t, err, "CP data structures cannot run on the METADATA CP group!")
 }
+
+func getAtomicLongCachedTest(t *testing.T) {
+	// the proxy factory has no invocation service, so creating a proxy would fail.
+	pf := newProxyFactory(nil, nil, nil, nil, "test-client")
+	al := &AtomicLong{newProxy(nil, nil, nil, nil, atomicLongService, "counter")}
+	pf.proxies[atomicLongService+":counter"] = al
+	for _, name := range []string{"counter", "counter@default", " counter@DEFAULT "} {
+		cached, err := pf.getAtomicLong(context.Background(), name)
+		require.NoError(t, err)
+		assert.Same(t, al, cached)
+	}
+	als, err := pf.getAtomicLongs(context.Background(), []string{"counter", "counter@default"})
+	require.NoError(t, err)
+	assert.Same(t, al, als[0])
+	assert.Same(t, al, als[1])
+}
+
+func getAtomicLongInvalidNameTest(t *testing.T) {
+	pf := newProxyFactory(nil, nil, nil, nil, "test-client")
+	for _, name := range []string{"", "   ", "@group", "counter@", "counter@group@other", "counter@metadata"} {
+		_, err := pf.getAtomicLong(context.Background(), name)
+		assert.True(t, errors.Is(err, hzerrors.ErrIllegalArgument), "name: %q, err: %v", name, err)
+	}
+	assert.Empty(t, pf.proxies)
+}
+
+func getAtomicRefCachedTest(t *testing.T) {
+	pf := newProxyFactory(nil, nil, nil, nil, "test-client")
+	ar := &AtomicRef{newProxy(nil, nil, nil, nil, atomicRefService, "ref")}
+	pf.proxies[atomicRefService+":ref"] = ar
+	for _, name := range []string{"ref", "ref@default", " ref@DEFAULT "} {
+		cached, err := pf.getAtomicRef(context.Background(), name)
+		require.NoError(t, err)
+		assert.Same(t, ar, cached)
+	}
+	// proxies of different services with the same name are kept apart.
+	_, ok := pf.proxies[atomicLongService+":ref"]
+	assert.False(t, ok)
+}
+
+func getAtomicRefInvalidNameTest(t *testing.T) {
+	// the proxy factory has no invocation service, so the names must be rejected before a CP group is created.
+	pf := newProxyFactory(nil, nil, nil, nil, "test-client")
+	for _, name := range []string{"", "   ", "@group", "ref@", "ref@metadata", "ref@group@other"} {
+		_, err := pf.getAtomicRef(context.Background(), name)
+		assert.True(t, errors.Is(err, hzerrors.ErrIllegalArgument), "name: %q, err: %v", name, err)
+	}
+	assert.Empty(t, pf.proxies)
+}
@@ -0,0 +1,314 @@
+/*
+ * Copyright (c) 2008-2023, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cp
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/hazelcast/hazelcast-go-client/hzerrors"
+	ihzerrors "github.com/hazelcast/hazelcast-go-client/internal/hzerrors"
+	"github.com/hazelcast/hazelcast-go-client/internal/proto/codec"
+	iproxy "github.com/hazelcast/hazelcast-go-client/internal/proxy"
+	"github.com/hazelcast/hazelcast-go-client/types"
+)
+
+// InvalidFence is the fencing token returned by the FencedLock functions when the lock is not acquired.
+const InvalidFence = int64(0)
+
+/*
+FencedLock is a linearizable, distributed and reentrant lock.
+It works on top of the Raft consensus algorithm and is CP with respect to the CAP principle.
+
+Lock ownership is explicit, similar to Map locks.
+The owner of the lock is identified by the lock context passed to the functions, see hazelcast.NewLockContext.
+Functions called with the same lock context are considered to be called by the same owner.
+A context without a lock context can be used too, but then all such contexts share the same owner.
+
+	lockCtx := hazelcast.NewLockContext(ctx)
+	lock, err := client.CPSubsystem().GetLock(ctx, "my-lock")
+	fence, err := lock.Lock(lockCtx)
+	// ...
+	err = lock.Unlock(lockCtx)
+
+The lock is reentrant, the owner can acquire it again without waiting.
+A lock acquired N times must be released N times before another owner can acquire it.
+
+Each time the lock is acquired by a new owner, a monotonic fencing token is returned.
+Fencing tokens can be passed to external services to reject operations by an earlier owner of the lock, for instance after its session expired.
+Reentrant acquisitions by the same owner return the same fencing token.
+
+The lock is held within a CP session of the client.
+The session is kept alive in the background while the lock is held.
+If the session expires, for instance because the client was disconnected for too long, the lock is released and its ownership is lost.
+In that case, the functions of the lock return an error which wraps hzerrors.ErrLockOwnershipLostException.
+*/
+type FencedLock struct {
+	*proxy
+	sm *sessionManager
+	// lockedSessionIDs keeps the session IDs of the locks held through this proxy by thread ID.
+	lockedSessionIDs map[int64]int64
+	mu               *sync.Mutex
+}
+
+/*
+FencedLock implementation is type aliased in the public API so all the exported fields and methods are directly accessible by users.
+Be aware of that while editing the fields and methods of both proxy and FencedLock structs.
+*/
+
+func newFencedLock(p *proxy, sm *sessionManager) *FencedLock {
+	return &FencedLock{
+		proxy:            p,
+		sm:               sm,
+		lockedSessionIDs: map[int64]int64{},
+		mu:               &sync.Mutex{},
+	}
+}
+
+// Lock acquires the lock and returns the fencing token.
+// If the lock is held by another owner, Lock blocks until the lock is released or the context is done.
+// Returns an error which wraps hzerrors.ErrLockAcquireLimitReachedException if the lock cannot be acquired again because of the reentrancy limit.
+func (f *FencedLock) Lock(ctx context.Context) (int64, error) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	tid, err := f.sm.threadID(ctx, f.groupID, iproxy.ExtractLockID(ctx))
+	if err != nil {
+		return InvalidFence, err
+	}
+	invUID := types.NewUUID()
+	for {
+		sid, err := f.sm.acquireSession(ctx, f.groupID)
+		if err != nil {
+			return InvalidFence, err
+		}
+		if err := f.verifyLockedSessionID(tid, sid, true); err != nil {
+			return InvalidFence, err
+		}
+		request := codec.EncodeFencedLockLockRequest(f.groupID, f.name, sid, tid, invUID)
+		response, err := f.invokeOnRandomTarget(ctx, request, nil)
+		if err != nil {
+			if errors.Is(err, hzerrors.ErrSessionExpiredException) {
+				f.sm.invalidateSession(f.groupID, sid)
+				if err := f.verifyNoLockedSessionID(tid); err != nil {
+					return InvalidFence, err
+				}
+				continue
+			}
+			f.sm.releaseSession(f.groupID, sid)
+			return InvalidFence, err
+		}
+		fence := codec.DecodeFencedLockLockResponse(response)
+		if fence == InvalidFence {
+			f.sm.releaseSession(f.groupID, sid)
+			return InvalidFence, ihzerrors.NewClientError("lock acquire limit is reached", nil, hzerrors.ErrLockAcquireLimitReachedException)
+		}
+		f.setLockedSessionID(tid, sid)
+		return fence, nil
+	}
+}
+
+// TryLock acquires the lock only if it is free or already held by the owner at the time of invocation.
+// Returns the fencing token if the lock was acquired, otherwise InvalidFence.
+func (f *FencedLock) TryLock(ctx context.Context) (int64, error) {
+	return f.tryLock(ctx, 0)
+}
+
+// TryLockWithTimeout acquires the lock if it is free within the given waiting time, or already held by the owner.
+// Returns the fencing token if the lock was acquired, otherwise InvalidFence.
+func (f *FencedLock) TryLockWithTimeout(ctx context.Context, timeout time.Duration) (int64, error) {
+	return f.tryLock(ctx, timeout)
+}
+
+func (f *FencedLock) tryLock(ctx context.Context, timeout time.Duration) (int64, error) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if timeout < 0 {
+		timeout = 0
+	}
+	tid, err := f.sm.threadID(ctx, f.groupID, iproxy.ExtractLockID(ctx))
+	if err != nil {
+		return InvalidFence, err
+	}
+	invUID := types.NewUUID()
+	deadline := time.Now().Add(timeout)
+	for {
+		sid, err := f.sm.acquireSession(ctx, f.groupID)
+		if err != nil {
+			return InvalidFence, err
+		}
+		if err := f.verifyLockedSessionID(tid, sid, true); err != nil {
+			return InvalidFence, err
+		}
+		request := codec.EncodeFencedLockTryLockRequest(f.groupID, f.name, sid, tid, invUID, timeout.Milliseconds())
+		response, err := f.invokeOnRandomTarget(ctx, request, nil)
+		if err != nil {
+			if errors.Is(err, hzerrors.ErrSessionExpiredException) {
+				f.sm.invalidateSession(f.groupID, sid)
+				if err := f.verifyNoLockedSessionID(tid); err != nil {
+					return InvalidFence, err
+				}
+				if timeout = time.Until(deadline); timeout <= 0 {
+					return InvalidFence, nil
+				}
+				continue
+			}
+			if errors.Is(err, hzerrors.ErrWaitKeyCancelledException) {
+				f.sm.releaseSession(f.groupID, sid)
+				return InvalidFence, nil
+			}
+			f.sm.releaseSession(f.groupID, sid)
+			return InvalidFence, err
+		}
+		fence := codec.DecodeFencedLockTryLockResponse(response)
+		if fence == InvalidFence {
+			f.sm.releaseSession(f.groupID, sid)
+			return InvalidFence, nil
+		}
+		f.setLockedSessionID(tid, sid)
+		return fence, nil
+	}
+}
+
+// Unlock releases the lock once.
+// Returns an error which wraps hzerrors.ErrIllegalMonitorState if the lock is not held by the owner identified by the context.
+func (f *FencedLock) Unlock(ctx context.Context) error {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	tid, err := f.sm.threadID(ctx, f.groupID, iproxy.ExtractLockID(ctx))
+	if err != nil {
+		return err
+	}
+	sid := f.sm.sessionID(f.groupID)
+	if err := f.verifyLockedSessionID(tid, sid, false); err != nil {
+		return err
+	}
+	if sid == noSessionID {
+		f.removeLockedSessionID(tid)
+		return errNotLockOwner(nil)
+	}
+	request := codec.EncodeFencedLockUnlockRequest(f.groupID, f.name, sid, tid, types.NewUUID())
+	response, err := f.invokeOnRandomTarget(ctx, request, nil)
+	if err != nil {
+		if errors.Is(err, hzerrors.ErrSessionExpiredException) {
+			f.sm.invalidateSession(f.groupID, sid)
+			f.removeLockedSessionID(tid)
+			return errLockOwnershipLost(err)
+		}
+		if errors.Is(err, hzerrors.ErrIllegalMonitorState) {
+			f.removeLockedSessionID(tid)
+			return errNotLockOwner(err)
+		}
+		return err
+	}
+	if codec.DecodeFencedLockUnlockResponse(response) {
+		// the lock is still held by the owner because of reentrancy
+		f.setLockedSessionID(tid, sid)
+	} else {
+		f.removeLockedSessionID(tid)
+	}
+	f.sm.releaseSession(f.groupID, sid)
+	return nil
+}
+
+// GetFence returns the fencing token if the lock is held by the owner identified by the context.
+// Returns an error which wraps hzerrors.ErrIllegalMonitorState otherwise.
+func (f *FencedLock) GetFence(ctx context.Context) (int64, error) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	tid, err := f.sm.threadID(ctx, f.groupID, iproxy.ExtractLockID(ctx))
+	if err != nil {
+		return InvalidFence, err
+	}
+	sid := f.sm.sessionID(f.groupID)
+	if err := f.verifyLockedSessionID(tid, sid, false); err != nil {
+		return InvalidFence, err
+	}
+	if sid == noSessionID {
+		f.removeLockedSessionID(tid)
+		return InvalidFence, errNotLockOwner(nil)
+	}
+	request := codec.EncodeFencedLockGetLockOwnershipRequest(f.groupID, f.name)
+	response, err := f.invokeOnRandomTarget(ctx, request, nil)
+	if err != nil {
+		return InvalidFence, err
+	}
+	fence, _, ownerSID, ownerTID := codec.DecodeFencedLockGetLockOwnershipResponse(response)
+	if fence != InvalidFence && ownerSID == sid && ownerTID == tid {
+		f.setLockedSessionID(tid, sid)
+		return fence, nil
+	}
+	if err := f.verifyNoLockedSessionID(tid); err != nil {
+		return InvalidFence, err
+	}
+	return InvalidFence, errNotLockOwner(nil)
+}
+
+// verifyLockedSessionID returns an error if the lock was acquired in a session other than the given one.
+// The lock ownership is lost in that case, since the session the lock was acquired in has expired.
+func (f *FencedLock) verifyLockedSessionID(tid, sid int64, releaseSession bool) error {
+	f.mu.Lock()
+	lockedSID, ok := f.lockedSessionIDs[tid]
+	if ok && lockedSID != sid {
+		delete(f.lockedSessionIDs, tid)
+	}
+	f.mu.Unlock()
+	if !ok || lockedSID == sid {
+		return nil
+	}
+	if releaseSession {
+		f.sm.releaseSession(f.groupID, sid)
+	}
+	return errLockOwnershipLost(nil)
+}
+
+// verifyNoLockedSessionID returns an error if the lock was held by the given thread ID, since its session has expired.
+func (f *FencedLock) verifyNoLockedSessionID(tid int64) error {
+	f.mu.Lock()
+	_, ok := f.lockedSessionIDs[tid]
+	delete(f.lockedSessionIDs, tid)
+	f.mu.Unlock()
+	if ok {
+		return errLockOwnershipLost(nil)
+	}
+	return nil
+}
+
+func (f *FencedLock) setLockedSessionID(tid, sid int64) {
+	f.mu.Lock()
+	f.lockedSessionIDs[tid] = sid
+	f.mu.Unlock()
+}
+
+func (f *FencedLock) removeLockedSessionID(tid int64) {
+	f.mu.Lock()
+	delete(f.lockedSessionIDs, tid)
+	f.mu.Unlock()
+}
+
+func errNotLockOwner(wrapped error) error {
+	return ihzerrors.NewClientError("lock is not held by the lock context", wrapped, hzerrors.ErrIllegalMonitorState)
+}
+
+func errLockOwnershipLost(wrapped error) error {
+	return ihzerrors.NewClientError("lock ownership is lost, the session of the lock has expired", wrapped, hzerrors.ErrLockOwnershipLostException)
+}
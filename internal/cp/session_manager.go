@@ -0,0 +1,281 @@
+/*
+ * Copyright (c) 2008-2023, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/hazelcast/hazelcast-go-client/hzerrors"
+	"github.com/hazelcast/hazelcast-go-client/internal/cp/types"
+	"github.com/hazelcast/hazelcast-go-client/internal/logger"
+	"github.com/hazelcast/hazelcast-go-client/internal/proto/codec"
+)
+
+// noSessionID is used when there is no session on a CP group.
+const noSessionID = int64(-1)
+
+// session is a CP session of this client on a CP group.
+type session struct {
+	createdAt    time.Time
+	id           int64
+	ttl          time.Duration
+	acquireCount int32
+}
+
+func (s *session) acquire() {
+	atomic.AddInt32(&s.acquireCount, 1)
+}
+
+func (s *session) release() {
+	atomic.AddInt32(&s.acquireCount, -1)
+}
+
+func (s *session) inUse() bool {
+	return atomic.LoadInt32(&s.acquireCount) > 0
+}
+
+// valid returns true if the session is in use or its TTL has not passed since it was created.
+func (s *session) valid(now time.Time) bool {
+	return s.inUse() || now.Before(s.createdAt.Add(s.ttl))
+}
+
+// threadKey identifies a lock owner on a CP group.
+type threadKey struct {
+	groupID types.RaftGroupID
+	lockID  int64
+}
+
+/*
+sessionManager creates and keeps alive the CP sessions of session aware data structures, such as FencedLock.
+A session is created on a CP group when it is first acquired and is kept alive by heartbeats while it is in use.
+Sessions are closed when the client shuts down.
+*/
+type sessionManager struct {
+	// p is used only to send the session requests.
+	p         *proxy
+	lg        *logger.LogAdaptor
+	sessions  map[types.RaftGroupID]*session
+	threadIDs map[threadKey]int64
+	// creationMus makes sure only one session is created on a CP group at a time, without holding mu during the request.
+	creationMus map[types.RaftGroupID]*sync.Mutex
+	doneCh      chan struct{}
+	mu          *sync.Mutex
+	clientName  string
+	running     bool
+	closed      bool
+}
+
+func newSessionManager(p *proxy, lg *logger.LogAdaptor, clientName string) *sessionManager {
+	return &sessionManager{
+		p:           p,
+		lg:          lg,
+		sessions:    map[types.RaftGroupID]*session{},
+		threadIDs:   map[threadKey]int64{},
+		creationMus: map[types.RaftGroupID]*sync.Mutex{},
+		doneCh:      make(chan struct{}),
+		mu:          &sync.Mutex{},
+		clientName:  clientName,
+	}
+}
+
+// acquireSession returns the ID of the session on the given CP group, creating the session if necessary.
+// The acquired session must be released with releaseSession once it is not used.
+func (sm *sessionManager) acquireSession(ctx context.Context, gid types.RaftGroupID) (int64, error) {
+	if id, ok := sm.tryAcquireSession(gid); ok {
+		return id, nil
+	}
+	cmu := sm.creationMu(gid)
+	cmu.Lock()
+	defer cmu.Unlock()
+	// the session may have been created while waiting for the creation mutex.
+	if id, ok := sm.tryAcquireSession(gid); ok {
+		return id, nil
+	}
+	s, heartbeat, err := sm.createSession(ctx, gid)
+	if err != nil {
+		return noSessionID, err
+	}
+	sm.mu.Lock()
+	defer sm.mu.Unlock()
+	s.acquire()
+	sm.sessions[gid] = s
+	if !sm.running && !sm.closed {
+		sm.running = true
+		go sm.sendHeartbeats(heartbeat)
+	}
+	return s.id, nil
+}
+
+// tryAcquireSession acquires the session on the given CP group and returns its ID if the session exists and it is valid.
+func (sm *sessionManager) tryAcquireSession(gid types.RaftGroupID) (int64, bool) {
+	sm.mu.Lock()
+	defer sm.mu.Unlock()
+	s, ok := sm.sessions[gid]
+	if !ok || !s.valid(time.Now()) {
+		return noSessionID, false
+	}
+	s.acquire()
+	return s.id, true
+}
+
+func (sm *sessionManager) creationMu(gid types.RaftGroupID) *sync.Mutex {
+	sm.mu.Lock()
+	defer sm.mu.Unlock()
+	cmu, ok := sm.creationMus[gid]
+	if !ok {
+		cmu = &sync.Mutex{}
+		sm.creationMus[gid] = cmu
+	}
+	return cmu
+}
+
+// releaseSession releases a session acquired with acquireSession.
+func (sm *sessionManager) releaseSession(gid types.RaftGroupID, sessionID int64) {
+	sm.mu.Lock()
+	defer sm.mu.Unlock()
+	if s, ok := sm.sessions[gid]; ok && s.id == sessionID {
+		s.release()
+	}
+}
+
+// invalidateSession removes the session if it is still the session on the given CP group.
+func (sm *sessionManager) invalidateSession(gid types.RaftGroupID, sessionID int64) {
+	sm.mu.Lock()
+	defer sm.mu.Unlock()
+	if s, ok := sm.sessions[gid]; ok && s.id == sessionID {
+		delete(sm.sessions, gid)
+	}
+}
+
+// sessionID returns the ID of the current session on the given CP group, or noSessionID if there is none.
+func (sm *sessionManager) sessionID(gid types.RaftGroupID) int64 {
+	sm.mu.Lock()
+	defer sm.mu.Unlock()
+	if s, ok := sm.sessions[gid]; ok {
+		return s.id
+	}
+	return noSessionID
+}
+
+// threadID returns the ID which identifies the given lock ID on the given CP group.
+// The ID is generated by the CP group once and cached.
+func (sm *sessionManager) threadID(ctx context.Context, gid types.RaftGroupID, lockID int64) (int64, error) {
+	key := threadKey{groupID: gid, lockID: lockID}
+	sm.mu.Lock()
+	tid, ok := sm.threadIDs[key]
+	sm.mu.Unlock()
+	if ok {
+		return tid, nil
+	}
+	request := codec.EncodeCPSessionGenerateThreadIdRequest(gid)
+	response, err := sm.p.invokeOnRandomTarget(ctx, request, nil)
+	if err != nil {
+		return 0, err
+	}
+	tid = codec.DecodeCPSessionGenerateThreadIdResponse(response)
+	sm.mu.Lock()
+	defer sm.mu.Unlock()
+	// another goroutine may have generated a thread ID for the same key in the meantime, the first one is kept.
+	if existing, ok := sm.threadIDs[key]; ok {
+		return existing, nil
+	}
+	sm.threadIDs[key] = tid
+	return tid, nil
+}
+
+// createSession creates a session on the given CP group and returns it along with the heartbeat interval.
+// It must be called without holding mu.
+func (sm *sessionManager) createSession(ctx context.Context, gid types.RaftGroupID) (*session, time.Duration, error) {
+	request := codec.EncodeCPSessionCreateSessionRequest(gid, sm.clientName)
+	response, err := sm.p.invokeOnRandomTarget(ctx, request, nil)
+	if err != nil {
+		return nil, 0, err
+	}
+	id, ttl, heartbeat := codec.DecodeCPSessionCreateSessionResponse(response)
+	s := &session{
+		id:        id,
+		ttl:       time.Duration(ttl) * time.Millisecond,
+		createdAt: time.Now(),
+	}
+	return s, time.Duration(heartbeat) * time.Millisecond, nil
+}
+
+func (sm *sessionManager) sendHeartbeats(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-sm.doneCh:
+			return
+		case <-ticker.C:
+			sm.heartbeat(interval)
+		}
+	}
+}
+
+// heartbeat extends the sessions which are in use.
+func (sm *sessionManager) heartbeat(timeout time.Duration) {
+	sm.mu.Lock()
+	sessions := make(map[types.RaftGroupID]int64, len(sm.sessions))
+	for gid, s := range sm.sessions {
+		if s.inUse() {
+			sessions[gid] = s.id
+		}
+	}
+	sm.mu.Unlock()
+	for gid, id := range sessions {
+		ctx, cancel := context.WithTimeout(context.Background(), timeout)
+		request := codec.EncodeCPSessionHeartbeatSessionRequest(gid, id)
+		_, err := sm.p.invokeOnRandomTarget(ctx, request, nil)
+		cancel()
+		if err == nil {
+			continue
+		}
+		if errors.Is(err, hzerrors.ErrSessionExpiredException) || errors.Is(err, hzerrors.ErrCPGroupDestroyedException) {
+			sm.invalidateSession(gid, id)
+		}
+		sm.lg.Debug(func() string {
+			return fmt.Sprintf("cp.sessionManager: heartbeat session %d: %s", id, err.Error())
+		})
+	}
+}
+
+// shutdown stops the heartbeats and closes all sessions.
+func (sm *sessionManager) shutdown(ctx context.Context) {
+	sm.mu.Lock()
+	if !sm.closed {
+		close(sm.doneCh)
+		sm.closed = true
+	}
+	sessions := sm.sessions
+	sm.sessions = map[types.RaftGroupID]*session{}
+	sm.threadIDs = map[threadKey]int64{}
+	sm.mu.Unlock()
+	for gid, s := range sessions {
+		request := codec.EncodeCPSessionCloseSessionRequest(gid, s.id)
+		if _, err := sm.p.invokeOnRandomTarget(ctx, request, nil); err != nil {
+			sm.lg.Debug(func() string {
+				return fmt.Sprintf("cp.sessionManager: closing session %d: %s", s.id, err.Error())
+			})
+		}
+	}
+}
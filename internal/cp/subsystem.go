@@ -34,8 +34,9 @@ Besides network partitions, CP Subsystem withstands server and client failures.
 Data structures in CP Subsystem run in CP groups.
 Each CP group elects its own Raft leader and runs the Raft consensus algorithm independently.
 The CP data structures differ from the other Hazelcast data structures in two aspects.
-First, an internal commit is performed on the METADATA CP group every time you fetch a proxy from this interface.
-Hence, callers should cache returned proxy objects.
+First, an internal commit is performed on the METADATA CP group the first time you fetch a proxy from this interface.
+The proxies are cached by the client, so fetching a proxy with the same name again returns the same proxy without a commit.
+Use GetAtomicLongs in order to create many AtomicLong proxies with a single commit per CP group.
 Second, if you call "destroy()" on a CP data structure proxy, that data structure is terminated on the underlying CP group and cannot be reinitialized until the CP group is force-destroyed.
 For this reason, please make sure that you are completely done with a CP data structure before destroying its proxy.
 */
@@ -43,22 +44,52 @@ type Subsystem struct {
 	proxyFactory *proxyFactory
 }
 
-func NewSubsystem(ss *iserialization.Service, cif *cluster.ConnectionInvocationFactory, is *invocation.Service, l *logger.LogAdaptor) Subsystem {
+func NewSubsystem(ss *iserialization.Service, cif *cluster.ConnectionInvocationFactory, is *invocation.Service, l *logger.LogAdaptor, clientName string) Subsystem {
 	return Subsystem{
-		proxyFactory: newProxyFactory(ss, cif, is, l),
+		proxyFactory: newProxyFactory(ss, cif, is, l, clientName),
 	}
 }
 
+// CloseSessions stops the session heartbeats and closes the CP sessions of the subsystem.
+// It must be called before the client shuts down.
+func CloseSessions(ctx context.Context, c Subsystem) {
+	c.proxyFactory.sm.shutdown(ctx)
+}
+
 // GetAtomicLong returns the distributed AtomicLong instance with given name.
+// It returns an error wrapping hzerrors.ErrIllegalArgument if the name is not valid, such as an empty name.
 func (c Subsystem) GetAtomicLong(ctx context.Context, name string) (*AtomicLong, error) {
 	return c.proxyFactory.getAtomicLong(ctx, name)
 }
 
+/*
+GetAtomicLongs returns the distributed AtomicLong instances with the given names, in the same order.
+Unlike calling GetAtomicLong for each name, the METADATA CP group is committed to once for each distinct CP group of the names, instead of once for each name.
+Prefer this function when initializing many counters.
+Names of proxies which were fetched before are not committed to again.
+For example:
+
+	counters, err := client.CPSubsystem().GetAtomicLongs(ctx, "c1@counters", "c2@counters", "c3@counters")
+*/
+func (c Subsystem) GetAtomicLongs(ctx context.Context, names ...string) ([]*AtomicLong, error) {
+	return c.proxyFactory.getAtomicLongs(ctx, names)
+}
+
 // GetAtomicRef returns the distributed AtomicRef instance with given name.
 func (c Subsystem) GetAtomicRef(ctx context.Context, name string) (*AtomicRef, error) {
 	return c.proxyFactory.getAtomicRef(ctx, name)
 }
 
+// GetCountDownLatch returns the distributed CountDownLatch instance with given name.
+func (c Subsystem) GetCountDownLatch(ctx context.Context, name string) (*CountDownLatch, error) {
+	return c.proxyFactory.getCountDownLatch(ctx, name)
+}
+
+// GetLock returns the distributed FencedLock instance with given name.
+func (c Subsystem) GetLock(ctx context.Context, name string) (*FencedLock, error) {
+	return c.proxyFactory.getLock(ctx, name)
+}
+
 // GetMap returns the distributed CPMap instance with given name.
 func (c Subsystem) GetMap(ctx context.Context, name string) (*Map, error) {
 	return c.proxyFactory.getMap(ctx, name)
@@ -19,6 +19,7 @@ package event
 import (
 	"context"
 	"fmt"
+	"runtime/debug"
 	"sync"
 	"sync/atomic"
 
@@ -75,12 +76,17 @@ func (s *DispatchService) Stop(ctx context.Context) error {
 	doneCh := make(chan struct{})
 	go func() {
 		s.subscriptionsMu.RLock()
-		defer s.subscriptionsMu.RUnlock()
+		var subs []*subscription
 		for _, eventSubscriptions := range s.subscriptions {
 			for _, sbs := range eventSubscriptions {
-				sbs.Stop()
+				subs = append(subs, sbs)
 			}
 		}
+		s.subscriptionsMu.RUnlock()
+		// stopping a subscription waits for its handler, so it is done without holding the lock.
+		for _, sbs := range subs {
+			sbs.Stop()
+		}
 		close(doneCh)
 	}()
 	select {
@@ -106,7 +112,7 @@ func (s *DispatchService) Subscribe(eventName string, subscriptionID int64, hand
 		return fmt.Sprintf("event.DispatchService.Subscribe: %s, %d, %v", eventName, subscriptionID, handler)
 	})
 
-	sbs := NewSubscription(handler)
+	sbs := NewSubscription(handler, s.logger)
 
 	handlers, ok := s.subscriptions[eventName]
 	if !ok {
@@ -127,20 +133,17 @@ func (s *DispatchService) Unsubscribe(eventName string, subscriptionID int64) {
 	if atomic.LoadInt32(&s.state) == stopped {
 		return
 	}
-	s.subscriptionsMu.RLock()
 	s.logger.Trace(func() string {
 		return fmt.Sprintf("event.DispatchService.Unsubscribe: %s, %d", eventName, subscriptionID)
 	})
-	if subs, ok := s.subscriptions[eventName]; ok {
-		if sub, exists := subs[subscriptionID]; exists {
-			sub.Stop()
-		}
-	}
-	s.subscriptionsMu.RUnlock()
-
 	s.subscriptionsMu.Lock()
+	sub, exists := s.subscriptions[eventName][subscriptionID]
 	delete(s.subscriptions[eventName], subscriptionID)
 	s.subscriptionsMu.Unlock()
+	// stopping the subscription waits for its handler, which must not block other subscriptions.
+	if exists {
+		sub.Stop()
+	}
 }
 
 // Publish an event. Events with the subscription are guaranteed to be run on the same order.
@@ -151,22 +154,26 @@ func (s *DispatchService) Publish(event Event) bool {
 		return false
 	}
 
-	s.subscriptionsMu.RLock()
-	defer s.subscriptionsMu.RUnlock()
 	s.logger.Trace(func() string {
 		return fmt.Sprintf("event.DispatchService.Publish: %s", event.EventName())
 	})
-	if subs, found := s.subscriptions[event.EventName()]; found {
-		for _, sub := range subs {
-			if ok := sub.Publish(event); !ok {
-				return false
-			}
+	s.subscriptionsMu.RLock()
+	subs := make([]*subscription, 0, len(s.subscriptions[event.EventName()]))
+	for _, sub := range s.subscriptions[event.EventName()] {
+		subs = append(subs, sub)
+	}
+	s.subscriptionsMu.RUnlock()
+	// publishing blocks if the queue of a subscription is full, so it is done without holding the lock.
+	for _, sub := range subs {
+		if ok := sub.Publish(event); !ok {
+			return false
 		}
 	}
 	return true
 }
 
 type subscription struct {
+	logger   ilogger.LogAdaptor
 	handler  Handler
 	orderCh  chan Event
 	mu       *sync.RWMutex
@@ -174,8 +181,9 @@ type subscription struct {
 	closed   bool
 }
 
-func NewSubscription(handler Handler) *subscription {
+func NewSubscription(handler Handler, logger ilogger.LogAdaptor) *subscription {
 	sbs := subscription{
+		logger:   logger,
 		handler:  handler,
 		orderCh:  make(chan Event, 1024),
 		closedWg: &sync.WaitGroup{},
@@ -186,13 +194,24 @@ func NewSubscription(handler Handler) *subscription {
 
 	go func() {
 		for event := range sbs.orderCh {
-			sbs.handler(event)
+			sbs.handle(event)
 		}
 		sbs.closedWg.Done()
 	}()
 	return &sbs
 }
 
+// handle runs the handler with the given event.
+// A panic in the handler is logged and recovered, so it does not affect the following events or other subscriptions.
+func (s *subscription) handle(event Event) {
+	defer func() {
+		if rec := recover(); rec != nil {
+			s.logger.Errorf("event.subscription.handle: recovered from panic in the handler of %s: %v\n%s", event.EventName(), rec, debug.Stack())
+		}
+	}()
+	s.handler(event)
+}
+
 // Stop ends the subscription.
 // It makes sure that any Publish will return false and all successful publishes will end before returning.
 // Stop must not be called inside its own handler, which can cause a deadlock.
@@ -188,3 +188,48 @@ func TestDispatchServiceCloseRespectsContext(t *testing.T) {
 	assert.NotNil(t, err)
 	wg.Done()
 }
+
+func TestDispatchServiceRecoversFromHandlerPanic(t *testing.T) {
+	service := event.NewDispatchService(logger.LogAdaptor{Logger: logger.New()})
+	defer service.Stop(context.Background())
+	var panickingCount, otherCount int32
+	service.Subscribe("sample.event", 100, func(event event.Event) {
+		atomic.AddInt32(&panickingCount, 1)
+		panic("handler failure")
+	})
+	service.Subscribe("sample.event", 101, func(event event.Event) {
+		atomic.AddInt32(&otherCount, 1)
+	})
+	service.Publish(sampleEvent{value: 1})
+	service.Publish(sampleEvent{value: 2})
+	it.Eventually(t, func() bool {
+		// both events reach both handlers, including the one which panics
+		return atomic.LoadInt32(&panickingCount) == 2 && atomic.LoadInt32(&otherCount) == 2
+	})
+}
+
+func TestDispatchService_UnsubscribeWillNotBlockUnrelatedSubscriptions(t *testing.T) {
+	wg := &sync.WaitGroup{}
+	wg.Add(1)
+	defer wg.Done()
+	service := event.NewDispatchService(logger.LogAdaptor{Logger: logger.New()})
+	handling := make(chan struct{})
+	service.Subscribe("sample.event", 1, func(event event.Event) {
+		close(handling)
+		//Wait blocking until test finishes.
+		wg.Wait()
+	})
+	service.Publish(sampleEvent{1})
+	<-handling
+	// unsubscribing waits for the blocked handler.
+	go service.Unsubscribe("sample.event", 1)
+	diffNameWg := &sync.WaitGroup{}
+	diffNameWg.Add(1)
+	go func() {
+		service.Subscribe("different.event", 2, func(event event.Event) {
+			diffNameWg.Done()
+		})
+		service.Publish(differentEvent{1})
+	}()
+	it.WaitEventually(t, diffNameWg)
+}
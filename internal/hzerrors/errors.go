@@ -25,20 +25,10 @@ import (
 )
 
 // StackTraceElement contains stacktrace information for server side exception.
-type StackTraceElement struct {
-	// ClassName is the fully qualified name of the class containing the execution point represented by the stack trace element.
-	ClassName string
-	// MethodName is the name of the method containing the execution point represented by this stack trace element.
-	MethodName string
-	// FileName returns the name of the file containing the execution point represented by the stack trace element,
-	FileName string
-	// LineNumber returns the line number of the source line containing the execution point represented by this stack trace element,
-	// or a negative number if this information is unavailable
-	// A value of -2 indicates that the method containing the execution point is a native method.
-	LineNumber int32
-}
+type StackTraceElement = hzerrors.StackTraceElement
 
 type ServerError struct {
+	public       error
 	errorHolders []ErrorHolder
 }
 
@@ -52,6 +42,16 @@ func (e ServerError) ErrorCode() int32 {
 	return e.errorHolders[0].ErrorCode
 }
 
+// ClassName returns the class name of the exception thrown on the member.
+func (e ServerError) ClassName() string {
+	return e.errorHolders[0].ClassName
+}
+
+// Message returns the message of the exception thrown on the member.
+func (e ServerError) Message() string {
+	return e.errorHolders[0].Message
+}
+
 func (e ServerError) Error() string {
 	return fmt.Sprintf("server error: %s: %s", e.lastErrorHolder().ClassName, e.lastErrorHolder().Message)
 }
@@ -67,6 +67,17 @@ func (e ServerError) String() string {
 	return fmt.Sprintf("server error:\n%s", sb.String())
 }
 
+// SetErr sets the sentinel error for the error code of the exception.
+// It creates the public error returned from Unwrap, which has the type corresponding to the exception class.
+func (e *ServerError) SetErr(err error) {
+	e.public = newPublicServerError(e.errorHolders, err)
+}
+
+// Unwrap returns the public error for the exception, see SetErr.
+func (e *ServerError) Unwrap() error {
+	return e.public
+}
+
 func (e ServerError) lastErrorHolder() ErrorHolder {
 	return e.errorHolders[len(e.errorHolders)-1]
 }
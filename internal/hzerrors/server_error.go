@@ -0,0 +1,77 @@
+/*
+ * Copyright (c) 2008-2023, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package hzerrors
+
+import (
+	"strings"
+
+	"github.com/hazelcast/hazelcast-go-client/hzerrors"
+)
+
+// serverErrorTypes maps the simple class names of well-known exceptions to their error types.
+// Simple class names are used, since the packages of some exceptions are different between member versions.
+var serverErrorTypes = map[string]func(se hzerrors.ServerError) error{
+	"TargetNotMemberException": func(se hzerrors.ServerError) error {
+		return &hzerrors.TargetNotMemberError{ServerError: se}
+	},
+	"HazelcastInstanceNotActiveException": func(se hzerrors.ServerError) error {
+		return &hzerrors.InstanceNotActiveError{ServerError: se}
+	},
+	"QueryException": func(se hzerrors.ServerError) error {
+		return &hzerrors.QueryError{ServerError: se}
+	},
+	"AuthenticationException": func(se hzerrors.ServerError) error {
+		return &hzerrors.AuthenticationError{ServerError: se}
+	},
+	"OperationTimeoutException": func(se hzerrors.ServerError) error {
+		return &hzerrors.OperationTimeoutError{ServerError: se}
+	},
+}
+
+// newPublicServerError creates the public error for the given error holders.
+// The first error holder is the exception, the rest are its causes.
+// The error has the type corresponding to the exception class, or *hzerrors.ServerError if the class is not well-known.
+func newPublicServerError(holders []ErrorHolder, err error) error {
+	if len(holders) == 0 {
+		return err
+	}
+	se := makePublicServerError(holders[0])
+	se.Err = err
+	last := &se
+	for _, h := range holders[1:] {
+		cause := makePublicServerError(h)
+		last.Cause = &cause
+		last = &cause
+	}
+	if f, ok := serverErrorTypes[simpleClassName(se.ClassName)]; ok {
+		return f(se)
+	}
+	return &se
+}
+
+func makePublicServerError(h ErrorHolder) hzerrors.ServerError {
+	return hzerrors.ServerError{
+		ClassName:  h.ClassName,
+		Message:    h.Message,
+		StackTrace: h.StackTraceElements,
+		ErrorCode:  h.ErrorCode,
+	}
+}
+
+func simpleClassName(className string) string {
+	return className[strings.LastIndex(className, ".")+1:]
+}
@@ -102,7 +102,9 @@ func (i *Impl) GetWithContext(ctx context.Context) (*proto.ClientMessage, error)
 		if ok {
 			return i.unwrapResponse(response)
 		}
-		return nil, cb.WrapNonRetryableError(ErrResponseChannelClosed)
+		// the response channel is closed only when the invocation service is stopped
+		err := ihzerrors.NewClientError("client is shutting down", ErrResponseChannelClosed, hzerrors.ErrClientNotActive)
+		return nil, cb.WrapNonRetryableError(err)
 	case <-ctx.Done():
 		err := ctx.Err()
 		if err != nil && !i.CanRetry(err) {
@@ -19,6 +19,7 @@ package invocation
 import (
 	"context"
 	"fmt"
+	"runtime/debug"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -28,6 +29,7 @@ import (
 	"github.com/hazelcast/hazelcast-go-client/internal/event"
 	"github.com/hazelcast/hazelcast-go-client/internal/logger"
 	"github.com/hazelcast/hazelcast-go-client/internal/proto"
+	"github.com/hazelcast/hazelcast-go-client/internal/proto/codec"
 )
 
 var (
@@ -35,11 +37,36 @@ var (
 	stateSubID   = event.NextSubscriptionID()
 )
 
+// blockingMessageTypes are the request types which may wait on the member longer than the invocation timeout, e.g., until a lock is released.
+// Invocations of these requests are not swept, they are completed by the response or when their connection is lost.
+var blockingMessageTypes = map[int32]struct{}{
+	codec.CountDownLatchAwaitCodecRequestMessageType: {},
+	codec.FencedLockLockCodecRequestMessageType:      {},
+	codec.FencedLockTryLockCodecRequestMessageType:   {},
+	codec.MapLockCodecRequestMessageType:             {},
+	codec.MapTryLockCodecRequestMessageType:          {},
+	codec.MapTryPutCodecRequestMessageType:           {},
+	codec.MapTryRemoveCodecRequestMessageType:        {},
+	codec.MultiMapLockCodecRequestMessageType:        {},
+	codec.MultiMapTryLockCodecRequestMessageType:     {},
+	codec.QueueOfferCodecRequestMessageType:          {},
+	codec.QueuePollCodecRequestMessageType:           {},
+	codec.QueuePutCodecRequestMessageType:            {},
+	codec.QueueTakeCodecRequestMessageType:           {},
+	codec.RingbufferReadManyCodecRequestMessageType:  {},
+	codec.RingbufferReadOneCodecRequestMessageType:   {},
+	codec.SqlExecuteCodecRequestMessageType:          {},
+	codec.SqlFetchCodecRequestMessageType:            {},
+}
+
 type Handler interface {
 	Invoke(invocation Invocation) (groupID int64, err error)
 }
 
 type Service struct {
+	// retries is the total number of invocation retries, accessed atomically.
+	// It is the first field to guarantee 64-bit alignment on 32-bit platforms.
+	retries         int64
 	handler         Handler
 	requestCh       chan Invocation
 	responseCh      chan *proto.ClientMessage
@@ -51,13 +78,19 @@ type Service struct {
 	// removeCh carries correlationIDs to be removed
 	removeCh chan int64
 	executor *stripeExecutor
-	logger   logger.LogAdaptor
-	stateMu  *sync.RWMutex
-	running  bool
-	paused   int32
+	// sampler is nil if invocation sampling is disabled
+	sampler *Sampler
+	// sentAt holds the send time of invocations when sampling is enabled
+	sentAt  map[int64]time.Time
+	logger  logger.LogAdaptor
+	stateMu *sync.RWMutex
+	// sweepInterval is the period of removing invocations whose deadline has passed, zero disables sweeping
+	sweepInterval time.Duration
+	running       bool
+	paused        int32
 }
 
-func NewService(handler Handler, ed *event.DispatchService, lg logger.LogAdaptor) *Service {
+func NewService(handler Handler, ed *event.DispatchService, lg logger.LogAdaptor, sweepInterval time.Duration) *Service {
 	s := &Service{
 		requestCh:       make(chan Invocation),
 		urgentRequestCh: make(chan Invocation),
@@ -72,6 +105,7 @@ func NewService(handler Handler, ed *event.DispatchService, lg logger.LogAdaptor
 		stateMu:         &sync.RWMutex{},
 		running:         true,
 		executor:        newStripeExecutor(),
+		sweepInterval:   sweepInterval,
 	}
 	s.eventDispatcher.Subscribe(EventGroupLost, serviceSubID, func(event event.Event) {
 		go func() {
@@ -103,6 +137,15 @@ func (s *Service) SetHandler(handler Handler) {
 	s.handler = handler
 }
 
+// SetSampler sets the sampler which selects the completed invocations to log.
+// It must be called before sending invocations.
+func (s *Service) SetSampler(sampler *Sampler) {
+	s.sampler = sampler
+	if sampler != nil {
+		s.sentAt = map[int64]time.Time{}
+	}
+}
+
 func (s *Service) SendRequest(ctx context.Context, inv Invocation) error {
 	if atomic.LoadInt32(&s.paused) == 1 {
 		err := fmt.Errorf("non-urgent invocations are paused: %w", hzerrors.ErrRetryableIO)
@@ -150,6 +193,16 @@ func (s *Service) Remove(correlationID int64) error {
 	}
 }
 
+// RecordRetry increments the total number of invocation retries.
+func (s *Service) RecordRetry() {
+	atomic.AddInt64(&s.retries, 1)
+}
+
+// Retries returns the total number of invocation retries since the service was created.
+func (s *Service) Retries() int64 {
+	return atomic.LoadInt64(&s.retries)
+}
+
 // Pause stops invoking non-urgent invocations.
 func (s *Service) Pause(paused bool) {
 	var p int32
@@ -174,6 +227,13 @@ func (s *Service) Pause(paused bool) {
 }
 
 func (s *Service) processIncoming() {
+	// receiving from a nil channel blocks forever, so sweeping is disabled if the interval is zero
+	var sweepCh <-chan time.Time
+	if s.sweepInterval > 0 {
+		ticker := time.NewTicker(s.sweepInterval)
+		defer ticker.Stop()
+		sweepCh = ticker.C
+	}
 loop:
 	for {
 		select {
@@ -187,6 +247,8 @@ loop:
 			s.removeCorrelationID(id)
 		case e := <-s.groupLostCh:
 			s.handleGroupLost(e)
+		case now := <-sweepCh:
+			s.sweepExpired(now)
 		case <-s.doneCh:
 			break loop
 		}
@@ -225,6 +287,12 @@ func (s *Service) handleClientMessage(msg *proto.ClientMessage) {
 			})
 		} else if inv.EventHandler() != nil {
 			handler := func() {
+				// a panic in the handler must not stop the event worker, which is shared by other listeners.
+				defer func() {
+					if rec := recover(); rec != nil {
+						s.logger.Errorf("invocation.Service: recovered from panic in the event handler of correlation ID %d: %v\n%s", correlationID, rec, debug.Stack())
+					}
+				}()
 				inv.EventHandler()(msg)
 			}
 			partitionID := msg.PartitionID()
@@ -236,7 +304,7 @@ func (s *Service) handleClientMessage(msg *proto.ClientMessage) {
 		}
 		return
 	}
-	if inv := s.unregisterInvocation(correlationID); inv != nil {
+	if inv := s.unregisterInvocation(correlationID, nil); inv != nil {
 		inv.Complete(msg)
 	} else {
 		s.logger.Trace(func() string {
@@ -247,10 +315,13 @@ func (s *Service) handleClientMessage(msg *proto.ClientMessage) {
 
 func (s *Service) removeCorrelationID(id int64) {
 	delete(s.invocations, id)
+	if s.sentAt != nil {
+		delete(s.sentAt, id)
+	}
 }
 
 func (s *Service) handleError(correlationID int64, invocationErr error) {
-	if inv := s.unregisterInvocation(correlationID); inv != nil {
+	if inv := s.unregisterInvocation(correlationID, invocationErr); inv != nil {
 		s.logger.Trace(func() string {
 			return fmt.Sprintf("error invoking %d: %s", correlationID, invocationErr)
 		})
@@ -272,11 +343,17 @@ func (s *Service) registerInvocation(invocation Invocation) {
 	}
 	message.SetPartitionId(invocation.PartitionID())
 	s.invocations[message.CorrelationID()] = invocation
+	if s.sampler != nil && invocation.EventHandler() == nil {
+		s.sentAt[message.CorrelationID()] = time.Now()
+	}
 }
 
-func (s *Service) unregisterInvocation(correlationID int64) Invocation {
+func (s *Service) unregisterInvocation(correlationID int64, err error) Invocation {
 	if invocation, ok := s.invocations[correlationID]; ok {
 		if invocation.EventHandler() == nil {
+			if sentAt, ok := s.sentAt[correlationID]; ok {
+				s.sampler.log(invocation, time.Since(sentAt), err)
+			}
 			// invocations with event handlers are removed with RemoveListener functions
 			s.removeCorrelationID(correlationID)
 		}
@@ -285,6 +362,22 @@ func (s *Service) unregisterInvocation(correlationID int64) Invocation {
 	return nil
 }
 
+// sweepExpired removes the invocations which did not receive a response before their deadline and completes them with a timeout error.
+// Invocations with event handlers are skipped, since they are removed with RemoveListener functions.
+// Invocations of blocking operations are skipped too, since they may legitimately wait for longer than the invocation timeout.
+func (s *Service) sweepExpired(now time.Time) {
+	for corrID, inv := range s.invocations {
+		if inv.EventHandler() != nil || !now.After(inv.Deadline()) {
+			continue
+		}
+		if _, ok := blockingMessageTypes[inv.Request().Type()]; ok {
+			continue
+		}
+		err := fmt.Errorf("no response received for correlation ID %d before the deadline: %w", corrID, hzerrors.ErrOperationTimeout)
+		s.handleError(corrID, err)
+	}
+}
+
 func (s *Service) handleGroupLost(e *GroupLostEvent) {
 	s.stateMu.RLock()
 	defer s.stateMu.RUnlock()
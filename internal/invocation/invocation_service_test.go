@@ -0,0 +1,149 @@
+/*
+ * Copyright (c) 2008-2023, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package invocation_test
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hazelcast/hazelcast-go-client/hzerrors"
+	"github.com/hazelcast/hazelcast-go-client/internal/event"
+	"github.com/hazelcast/hazelcast-go-client/internal/invocation"
+	ilogger "github.com/hazelcast/hazelcast-go-client/internal/logger"
+	"github.com/hazelcast/hazelcast-go-client/internal/proto"
+	"github.com/hazelcast/hazelcast-go-client/internal/proto/codec"
+)
+
+func TestService_SweepsExpiredInvocations(t *testing.T) {
+	lg := &recordingLogger{}
+	la := ilogger.LogAdaptor{Logger: lg}
+	ed := event.NewDispatchService(la)
+	defer ed.Stop(context.Background())
+	// completingHandler never writes a response, so the response of the request is dropped.
+	svc := invocation.NewService(completingHandler{}, ed, la, 10*time.Millisecond)
+	defer svc.Stop()
+	msg := proto.NewClientMessage(proto.NewFrame(make([]byte, 64)))
+	msg.SetCorrelationID(1)
+	inv := invocation.NewImpl(msg, 0, "", time.Now().Add(50*time.Millisecond), false)
+	require.NoError(t, svc.SendRequest(context.Background(), inv))
+	// no context deadline, the invocation must be completed by the sweeper.
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	_, err := inv.GetWithContext(ctx)
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, hzerrors.ErrOperationTimeout), err.Error())
+	assert.False(t, inv.CanRetry(err))
+	// the pending entry was removed, so a late response is not matched to an invocation.
+	resp := proto.NewClientMessage(proto.NewFrame(make([]byte, 64)))
+	resp.SetCorrelationID(msg.CorrelationID())
+	require.NoError(t, svc.WriteResponse(resp))
+	// the service handles messages in order, sending another request ensures the late response was processed.
+	next := proto.NewClientMessage(proto.NewFrame(make([]byte, 64)))
+	next.SetCorrelationID(2)
+	require.NoError(t, svc.SendRequest(context.Background(), invocation.NewImpl(next, 0, "", time.Now().Add(time.Minute), false)))
+	assert.Equal(t, 1, lg.count("no invocation found with the correlation ID: 1"))
+}
+
+func TestService_DoesNotSweepInvocationsBeforeDeadline(t *testing.T) {
+	la := ilogger.LogAdaptor{Logger: &recordingLogger{}}
+	ed := event.NewDispatchService(la)
+	defer ed.Stop(context.Background())
+	svc := invocation.NewService(completingHandler{}, ed, la, 10*time.Millisecond)
+	defer svc.Stop()
+	msg := proto.NewClientMessage(proto.NewFrame(make([]byte, 64)))
+	msg.SetCorrelationID(1)
+	inv := invocation.NewImpl(msg, 0, "", time.Now().Add(time.Minute), false)
+	require.NoError(t, svc.SendRequest(context.Background(), inv))
+	time.Sleep(50 * time.Millisecond)
+	assert.False(t, inv.Completed())
+}
+
+func TestService_DoesNotSweepBlockingInvocations(t *testing.T) {
+	// these requests wait on the member, e.g., until an item is available or a query produces a page, which may take longer than the invocation timeout.
+	testCases := []struct {
+		name        string
+		messageType int32
+	}{
+		{name: "Queue.Take", messageType: codec.QueueTakeCodecRequestMessageType},
+		{name: "Ringbuffer.ReadOne", messageType: codec.RingbufferReadOneCodecRequestMessageType},
+		{name: "SQL.Execute", messageType: codec.SqlExecuteCodecRequestMessageType},
+		{name: "SQL.Fetch", messageType: codec.SqlFetchCodecRequestMessageType},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			la := ilogger.LogAdaptor{Logger: &recordingLogger{}}
+			ed := event.NewDispatchService(la)
+			defer ed.Stop(context.Background())
+			svc := invocation.NewService(completingHandler{}, ed, la, 10*time.Millisecond)
+			defer svc.Stop()
+			msg := proto.NewClientMessage(proto.NewFrame(make([]byte, 64)))
+			msg.SetCorrelationID(1)
+			msg.SetMessageType(tc.messageType)
+			// the deadline corresponds to the invocation timeout, the context of the caller allows waiting longer.
+			inv := invocation.NewImpl(msg, 0, "", time.Now().Add(10*time.Millisecond), false)
+			require.NoError(t, svc.SendRequest(context.Background(), inv))
+			time.Sleep(100 * time.Millisecond)
+			assert.False(t, inv.Completed())
+			// the invocation is still completed by its response.
+			resp := proto.NewClientMessage(proto.NewFrame(make([]byte, 64)))
+			resp.SetCorrelationID(1)
+			require.NoError(t, svc.WriteResponse(resp))
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+			_, err := inv.GetWithContext(ctx)
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestService_RecoversFromEventHandlerPanic(t *testing.T) {
+	lg := &recordingLogger{}
+	la := ilogger.LogAdaptor{Logger: lg}
+	ed := event.NewDispatchService(la)
+	defer ed.Stop(context.Background())
+	svc := invocation.NewService(completingHandler{}, ed, la, 0)
+	defer svc.Stop()
+	msg := proto.NewClientMessage(proto.NewFrame(make([]byte, 64)))
+	msg.SetCorrelationID(1)
+	inv := invocation.NewImpl(msg, 0, "", time.Now().Add(time.Minute), false)
+	var calls int32
+	handled := make(chan struct{})
+	inv.SetEventHandler(func(msg *proto.ClientMessage) {
+		if atomic.AddInt32(&calls, 1) == 1 {
+			panic("handler failure")
+		}
+		close(handled)
+	})
+	require.NoError(t, svc.SendRequest(context.Background(), inv))
+	for i := 0; i < 2; i++ {
+		ev := proto.NewClientMessage(proto.NewFrameWith(make([]byte, 64), proto.UnfragmentedMessage|proto.IsEventFlag))
+		ev.SetCorrelationID(1)
+		require.NoError(t, svc.WriteResponse(ev))
+	}
+	select {
+	case <-handled:
+	case <-time.After(5 * time.Second):
+		t.Fatal("the event after the panic was not handled")
+	}
+	assert.Equal(t, 1, lg.count("recovered from panic in the event handler of correlation ID 1: handler failure"))
+}
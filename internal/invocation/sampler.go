@@ -0,0 +1,74 @@
+/*
+ * Copyright (c) 2008-2023, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package invocation
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hazelcast/hazelcast-go-client/internal/logger"
+)
+
+// Sampler decides which completed invocations are logged.
+// It is used only in the invocation service goroutine, so it is not safe for concurrent use.
+type Sampler struct {
+	lg         logger.LogAdaptor
+	every      int64
+	slowerThan time.Duration
+	count      int64
+}
+
+// NewSampler creates a sampler which selects one in every N invocations and the invocations which took at least slowerThan.
+// Zero values disable the corresponding sampling mode.
+func NewSampler(lg logger.LogAdaptor, every int, slowerThan time.Duration) *Sampler {
+	return &Sampler{
+		lg:         lg,
+		every:      int64(every),
+		slowerThan: slowerThan,
+	}
+}
+
+// Sample returns true if an invocation which took the given duration should be logged.
+func (s *Sampler) Sample(took time.Duration) bool {
+	selected := false
+	if s.every > 0 {
+		s.count++
+		if s.count >= s.every {
+			s.count = 0
+			selected = true
+		}
+	}
+	if s.slowerThan > 0 && took >= s.slowerThan {
+		selected = true
+	}
+	return selected
+}
+
+func (s *Sampler) log(inv Invocation, took time.Duration, err error) {
+	if !s.Sample(took) {
+		return
+	}
+	s.lg.Info(func() string {
+		req := inv.Request()
+		if err != nil {
+			return fmt.Sprintf("invocation.Sampler: correlationID: %d, message type: %d, partition: %d, took: %v, error: %s",
+				req.CorrelationID(), req.Type(), inv.PartitionID(), took, err.Error())
+		}
+		return fmt.Sprintf("invocation.Sampler: correlationID: %d, message type: %d, partition: %d, took: %v",
+			req.CorrelationID(), req.Type(), inv.PartitionID(), took)
+	})
+}
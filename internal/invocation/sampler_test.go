@@ -0,0 +1,115 @@
+/*
+ * Copyright (c) 2008-2023, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package invocation_test
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hazelcast/hazelcast-go-client/internal/event"
+	"github.com/hazelcast/hazelcast-go-client/internal/invocation"
+	ilogger "github.com/hazelcast/hazelcast-go-client/internal/logger"
+	"github.com/hazelcast/hazelcast-go-client/internal/proto"
+	"github.com/hazelcast/hazelcast-go-client/logger"
+)
+
+func TestSampler_Every(t *testing.T) {
+	s := invocation.NewSampler(ilogger.LogAdaptor{Logger: ilogger.New()}, 4, 0)
+	var sampled int
+	for i := 0; i < 100; i++ {
+		if s.Sample(time.Millisecond) {
+			sampled++
+		}
+	}
+	assert.Equal(t, 25, sampled)
+}
+
+func TestSampler_SlowerThan(t *testing.T) {
+	s := invocation.NewSampler(ilogger.LogAdaptor{Logger: ilogger.New()}, 0, 100*time.Millisecond)
+	assert.False(t, s.Sample(0))
+	assert.False(t, s.Sample(99*time.Millisecond))
+	assert.True(t, s.Sample(100*time.Millisecond))
+	assert.True(t, s.Sample(time.Second))
+}
+
+func TestSampler_EveryAndSlowerThan(t *testing.T) {
+	s := invocation.NewSampler(ilogger.LogAdaptor{Logger: ilogger.New()}, 3, time.Second)
+	durations := []time.Duration{0, 2 * time.Second, 0, 0, 0, 0}
+	var sampled []bool
+	for _, d := range durations {
+		sampled = append(sampled, s.Sample(d))
+	}
+	assert.Equal(t, []bool{false, true, true, false, false, true}, sampled)
+}
+
+func TestService_LogsSampledInvocations(t *testing.T) {
+	lg := &recordingLogger{}
+	la := ilogger.LogAdaptor{Logger: lg}
+	ed := event.NewDispatchService(la)
+	defer ed.Stop(context.Background())
+	svc := invocation.NewService(completingHandler{}, ed, la, 0)
+	defer svc.Stop()
+	svc.SetSampler(invocation.NewSampler(la, 2, 0))
+	ctx := context.Background()
+	for i := 0; i < 10; i++ {
+		msg := proto.NewClientMessage(proto.NewFrame(make([]byte, 64)))
+		msg.SetCorrelationID(int64(i + 1))
+		inv := invocation.NewImpl(msg, 0, "", time.Now().Add(10*time.Second), false)
+		require.NoError(t, svc.SendRequest(ctx, inv))
+		resp := proto.NewClientMessage(proto.NewFrame(make([]byte, 64)))
+		resp.SetCorrelationID(msg.CorrelationID())
+		require.NoError(t, svc.WriteResponse(resp))
+		_, err := inv.Get()
+		require.NoError(t, err)
+	}
+	assert.Equal(t, 5, lg.count("invocation.Sampler"))
+}
+
+type completingHandler struct{}
+
+func (completingHandler) Invoke(inv invocation.Invocation) (int64, error) {
+	return 1, nil
+}
+
+type recordingLogger struct {
+	msgs []string
+	mu   sync.Mutex
+}
+
+func (r *recordingLogger) Log(weight logger.Weight, f func() string) {
+	r.mu.Lock()
+	r.msgs = append(r.msgs, f())
+	r.mu.Unlock()
+}
+
+func (r *recordingLogger) count(substr string) int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var n int
+	for _, m := range r.msgs {
+		if strings.Contains(m, substr) {
+			n++
+		}
+	}
+	return n
+}
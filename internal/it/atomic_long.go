@@ -21,6 +21,7 @@ import (
 	"testing"
 
 	hz "github.com/hazelcast/hazelcast-go-client"
+	"github.com/hazelcast/hazelcast-go-client/logger"
 )
 
 func AtomicLongTester(t *testing.T, f func(t *testing.T, a *hz.AtomicLong)) {
@@ -76,3 +77,32 @@ func GetClientAtomicLongWithConfig(name string, config *hz.Config) (*hz.Client,
 	}
 	return client, al
 }
+
+// CPSubsystemTester runs f with the CP Subsystem of a smart client connected to a CP enabled cluster.
+func CPSubsystemTester(t *testing.T, f func(t *testing.T, cp hz.CPSubsystem)) {
+	withCPSubsystem(t, func(cp hz.CPSubsystem) {
+		f(t, cp)
+	})
+}
+
+// CPSubsystemBenchmarker runs f with the CP Subsystem of a smart client connected to a CP enabled cluster.
+func CPSubsystemBenchmarker(b *testing.B, f func(b *testing.B, cp hz.CPSubsystem)) {
+	withCPSubsystem(b, func(cp hz.CPSubsystem) {
+		b.ResetTimer()
+		f(b, cp)
+	})
+}
+
+func withCPSubsystem(t testLogger, f func(cp hz.CPSubsystem)) {
+	ensureRemoteController(true)
+	cls := cpEnabledTestCluster.Launch(t)
+	config := cls.DefaultConfig()
+	config.Logger.Level = logger.WarnLevel
+	client := getDefaultClient(&config)
+	defer func() {
+		if err := client.Shutdown(context.Background()); err != nil {
+			t.Logf("Test warning, client not shutdown: %s", err.Error())
+		}
+	}()
+	f(client.CPSubsystem())
+}
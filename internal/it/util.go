@@ -57,6 +57,7 @@ const (
 const (
 	DefaultClusterName   = "integration-test"
 	RingbufferCapacity   = 10
+	RingbufferTTLSeconds = 300
 	ClusterNameCPEnabled = "integration-test-cp"
 )
 
@@ -444,8 +445,12 @@ func xmlConfig(clusterName string, port int) string {
 			<ringbuffer name="test*">
         			<capacity>%d</capacity>
     		</ringbuffer>
+			<ringbuffer name="test-ttl*">
+				<capacity>%d</capacity>
+				<time-to-live-seconds>%d</time-to-live-seconds>
+			</ringbuffer>
         </hazelcast>
-	`, clusterName, port, RingbufferCapacity)
+	`, clusterName, port, RingbufferCapacity, RingbufferCapacity, RingbufferTTLSeconds)
 }
 
 func xmlSSLConfig(clusterName string, port int) string {
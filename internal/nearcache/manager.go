@@ -17,6 +17,7 @@
 package nearcache
 
 import (
+	"sort"
 	"sync"
 	"sync/atomic"
 
@@ -63,11 +64,15 @@ func (m *Manager) Stop() {
 	if atomic.CompareAndSwapInt32(&m.state, 0, 1) {
 		close(m.doneCh)
 		m.nearCachesMu.Lock()
-		for _, nc := range m.nearCaches {
-			nc.Destroy()
-		}
+		ncs := m.nearCaches
 		m.nearCaches = map[string]*NearCache{}
 		m.nearCachesMu.Unlock()
+		// storing the keys writes to disk, so it is done without holding the lock.
+		for _, nc := range ncs {
+			// the keys are stored on shutdown, so that the next client can preload them.
+			nc.StoreKeys()
+			nc.Destroy()
+		}
 	}
 }
 
@@ -81,7 +86,7 @@ func (m *Manager) GetOrCreateNearCache(name string, cfg nearcache.Config) *NearC
 	m.nearCachesMu.Lock()
 	nc, ok = m.nearCaches[name]
 	if !ok {
-		nc = NewNearCache(&cfg, m.ss, m.lg)
+		nc = NewNearCache(name, &cfg, m.ss, m.lg)
 		m.nearCaches[name] = nc
 	}
 	m.nearCachesMu.Unlock()
@@ -102,6 +107,18 @@ func (m *Manager) GetNearCacheStats() []proto.Pair {
 	return nameStats
 }
 
+// NearCacheNames returns the names of the Near Caches in ascending order.
+func (m *Manager) NearCacheNames() []string {
+	m.nearCachesMu.RLock()
+	names := make([]string, 0, len(m.nearCaches))
+	for name := range m.nearCaches {
+		names = append(names, name)
+	}
+	m.nearCachesMu.RUnlock()
+	sort.Strings(names)
+	return names
+}
+
 func (m *Manager) DestroyNearCache(name string) {
 	m.nearCachesMu.Lock()
 	defer m.nearCachesMu.Unlock()
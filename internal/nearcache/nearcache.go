@@ -18,6 +18,7 @@ package nearcache
 
 import (
 	"os"
+	"sync"
 	"sync/atomic"
 	"time"
 
@@ -36,21 +37,24 @@ const (
 const (
 	// see: com.hazelcast.internal.nearcache.NearCache#DEFAULT_EXPIRATION_TASK_INITIAL_DELAY_SECONDS
 	defaultExpirationTaskInitialDelay = 5 * time.Second
-	// see: com.hazelcast.internal.nearcache.NearCache#DEFAULT_EXPIRATION_TASK_PERIOD_SECONDS
-	defaultExpirationTaskPeriod   = 5 * time.Second
-	EnvExpirationTaskInitialDelay = "TESTONLY_NC_EXPIRATION_INITIAL_DELAY"
-	EnvExpirationTaskPeriod       = "TESTONLY_NC_EXPIRATION_TASK_PERIOD"
+	EnvExpirationTaskInitialDelay     = "TESTONLY_NC_EXPIRATION_INITIAL_DELAY"
+	EnvExpirationTaskPeriod           = "TESTONLY_NC_EXPIRATION_TASK_PERIOD"
+	EnvStoreInitialDelay              = "TESTONLY_NC_STORE_INITIAL_DELAY"
+	EnvStoreInterval                  = "TESTONLY_NC_STORE_INTERVAL"
 )
 
 type NearCache struct {
-	store  *RecordStore
-	cfg    *nearcache.Config
-	lg     ilogger.LogAdaptor
-	doneCh chan struct{}
-	state  int32
+	store     *RecordStore
+	cfg       *nearcache.Config
+	preloader *preloader
+	// storeMu serializes storing the keys and prevents storing them after the Near Cache is destroyed.
+	storeMu *sync.Mutex
+	lg      ilogger.LogAdaptor
+	doneCh  chan struct{}
+	state   int32
 }
 
-func NewNearCache(cfg *nearcache.Config, ss *serialization.Service, lg ilogger.LogAdaptor) *NearCache {
+func NewNearCache(name string, cfg *nearcache.Config, ss *serialization.Service, lg ilogger.LogAdaptor) *NearCache {
 	var rc nearCacheRecordValueConverter
 	var se nearCacheStorageEstimator
 	if cfg.InMemoryFormat == nearcache.InMemoryFormatBinary {
@@ -63,16 +67,27 @@ func NewNearCache(cfg *nearcache.Config, ss *serialization.Service, lg ilogger.L
 		se = adapter
 	}
 	nc := &NearCache{
-		cfg:    cfg,
-		store:  NewRecordStore(cfg, ss, rc, se),
-		lg:     lg,
-		doneCh: make(chan struct{}),
-	}
-	if cfg.TimeToLiveSeconds > 0 || cfg.MaxIdleSeconds > 0 {
-		delay := nc.parseDurationOrDefault(EnvExpirationTaskInitialDelay, defaultExpirationTaskInitialDelay)
-		period := nc.parseDurationOrDefault(EnvExpirationTaskPeriod, defaultExpirationTaskPeriod)
+		cfg:     cfg,
+		store:   NewRecordStore(cfg, ss, rc, se),
+		storeMu: &sync.Mutex{},
+		lg:      lg,
+		doneCh:  make(chan struct{}),
+	}
+	if cfg.TimeToLiveSeconds > 0 || cfg.NilTimeToLiveSeconds > 0 || cfg.MaxIdleSeconds > 0 {
+		period := nc.parseDurationOrDefault(EnvExpirationTaskPeriod, time.Duration(cfg.ExpirationTaskPeriodSeconds)*time.Second)
+		delay := defaultExpirationTaskInitialDelay
+		if period < delay {
+			delay = period
+		}
+		delay = nc.parseDurationOrDefault(EnvExpirationTaskInitialDelay, delay)
 		go nc.startExpirationTask(delay, period)
 	}
+	if cfg.Preloader.Enabled && cfg.Preloader.StoreIntervalSeconds > 0 {
+		nc.preloader = newPreloader(cfg.Preloader.Directory, name)
+		delay := nc.parseDurationOrDefault(EnvStoreInitialDelay, time.Duration(cfg.Preloader.StoreInitialDelaySeconds)*time.Second)
+		interval := nc.parseDurationOrDefault(EnvStoreInterval, time.Duration(cfg.Preloader.StoreIntervalSeconds)*time.Second)
+		go nc.startStoreTask(delay, interval)
+	}
 	return nc
 }
 
@@ -85,17 +100,65 @@ func (nc *NearCache) Clear() {
 }
 
 func (nc *NearCache) Destroy() {
-	if atomic.CompareAndSwapInt32(&nc.state, 0, 1) {
+	nc.storeMu.Lock()
+	destroyed := atomic.CompareAndSwapInt32(&nc.state, 0, 1)
+	nc.storeMu.Unlock()
+	if destroyed {
 		close(nc.doneCh)
 		nc.store.Destroy()
 	}
 }
 
+// StoreKeys writes the key set of the Near Cache to the preloader file if the preloader is enabled.
+// Failures are logged and recorded in the Near Cache statistics.
+// port of: com.hazelcast.internal.nearcache.impl.preloader.NearCachePreloader#storeKeys
+func (nc *NearCache) StoreKeys() {
+	if nc.preloader == nil {
+		return
+	}
+	nc.storeMu.Lock()
+	defer nc.storeMu.Unlock()
+	if atomic.LoadInt32(&nc.state) != 0 {
+		return
+	}
+	start := time.Now()
+	keys, err := nc.store.KeyDatas()
+	if err != nil {
+		nc.storeKeysFailed(err)
+		return
+	}
+	n, err := nc.preloader.storeKeys(keys)
+	if err != nil {
+		nc.storeKeysFailed(err)
+		return
+	}
+	nc.store.addPersistence(start, time.Since(start), n, int64(len(keys)))
+}
+
+// PreloadKeys returns the keys stored by the preloader.
+// Returns no keys if the preloader is not enabled or no keys were stored before.
+func (nc *NearCache) PreloadKeys() ([]serialization.Data, error) {
+	if nc.preloader == nil {
+		return nil, nil
+	}
+	return nc.preloader.loadKeys()
+}
+
+func (nc *NearCache) storeKeysFailed(err error) {
+	nc.lg.Warnf("nearcache.NearCache.StoreKeys: %s: %s", nc.preloader.path, err.Error())
+	nc.store.addPersistenceFailure(err)
+}
+
 func (nc *NearCache) Get(key interface{}) (interface{}, bool, error) {
 	nc.checkKeyFormat(key)
 	return nc.store.Get(key)
 }
 
+// Dump returns the metadata of the entries in the Near Cache.
+func (nc *NearCache) Dump() ([]nearcache.RecordInfo, error) {
+	return nc.store.Dump()
+}
+
 func (nc *NearCache) GetRecord(key interface{}) (*Record, bool) {
 	// this function is exported only for tests.
 	// do not use outside of tests.
@@ -108,6 +171,12 @@ func (nc *NearCache) Invalidate(key interface{}) {
 	nc.store.Invalidate(key)
 }
 
+// RemoveStaleRecords removes the records which are detected as stale using the invalidation metadata.
+// Returns the number of removed records.
+func (nc *NearCache) RemoveStaleRecords() int {
+	return nc.store.RemoveStaleRecords()
+}
+
 func (nc NearCache) Size() int {
 	return nc.store.Size()
 }
@@ -139,6 +208,13 @@ func (nc *NearCache) TryPublishReserved(key, value interface{}, reservationID in
 	return value, nil
 }
 
+// TryPublishReservedUpdate caches the value written by this client for the key reserved with UpdateSemanticWriteUpdate.
+// Unlike TryPublishReserved, it does not return the cached value.
+func (nc *NearCache) TryPublishReservedUpdate(key, value interface{}, reservationID int64) error {
+	_, err := nc.store.TryPublishReserved(key, value, reservationID, false)
+	return err
+}
+
 func (nc *NearCache) checkKeyFormat(key interface{}) {
 	_, ok := key.(serialization.Data)
 	if nc.cfg.SerializeKeys {
@@ -151,7 +227,11 @@ func (nc *NearCache) checkKeyFormat(key interface{}) {
 }
 
 func (nc *NearCache) startExpirationTask(delay, timeout time.Duration) {
-	time.Sleep(delay)
+	select {
+	case <-nc.doneCh:
+		return
+	case <-time.After(delay):
+	}
 	timer := time.NewTicker(timeout)
 	defer timer.Stop()
 	for {
@@ -167,6 +247,28 @@ func (nc *NearCache) startExpirationTask(delay, timeout time.Duration) {
 	}
 }
 
+func (nc *NearCache) startStoreTask(delay, interval time.Duration) {
+	select {
+	case <-nc.doneCh:
+		return
+	case <-time.After(delay):
+	}
+	nc.StoreKeys()
+	timer := time.NewTicker(interval)
+	defer timer.Stop()
+	for {
+		select {
+		case <-nc.doneCh:
+			return
+		case <-timer.C:
+			nc.lg.Debug(func() string {
+				return "running near cache store task"
+			})
+			nc.StoreKeys()
+		}
+	}
+}
+
 func (nc *NearCache) parseDurationOrDefault(envName string, d time.Duration) time.Duration {
 	str := os.Getenv(envName)
 	if str == "" {
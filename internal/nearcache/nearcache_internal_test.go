@@ -18,12 +18,21 @@ package nearcache
 
 import (
 	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	pubcluster "github.com/hazelcast/hazelcast-go-client/cluster"
+	ilogger "github.com/hazelcast/hazelcast-go-client/internal/logger"
+	"github.com/hazelcast/hazelcast-go-client/internal/proto"
 	iserialization "github.com/hazelcast/hazelcast-go-client/internal/serialization"
 	"github.com/hazelcast/hazelcast-go-client/nearcache"
 	"github.com/hazelcast/hazelcast-go-client/serialization"
@@ -218,3 +227,1077 @@ func makeRecords(count int) map[interface{}]*Record {
 	}
 	return res
 }
+
+func TestRecordStore_TryPublishReservedFailure(t *testing.T) {
+	sc := &serialization.Config{}
+	ss, err := iserialization.NewService(sc, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ncc := &nearcache.Config{}
+	dsa := &nearCacheDataStoreAdapter{ss: ss}
+	rs := NewRecordStore(ncc, ss, dsa, dsa)
+	rid, err := rs.TryReserveForUpdate("key", nil, UpdateSemanticReadUpdate)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// channels cannot be serialized, so storing the value fails.
+	_, err = rs.TryPublishReserved("key", make(chan int), rid, true)
+	assert.Error(t, err)
+	// the reserved record is removed, so the key can be reserved and published again.
+	_, ok := rs.GetRecord("key")
+	assert.False(t, ok)
+	stats := rs.Stats()
+	assert.Equal(t, int64(1), stats.StoreFailures)
+	assert.Equal(t, int64(0), stats.OwnedEntryCount)
+	assert.Equal(t, int64(0), stats.OwnedEntryMemoryCost)
+	rid, err = rs.TryReserveForUpdate("key", nil, UpdateSemanticReadUpdate)
+	if err != nil {
+		t.Fatal(err)
+	}
+	value, err := rs.TryPublishReserved("key", "value", rid, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "value", value)
+	assert.Equal(t, int64(1), rs.Stats().OwnedEntryCount)
+}
+
+func TestNearCache_InMemoryFormat(t *testing.T) {
+	ss, err := iserialization.NewService(&serialization.Config{}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	valueData, err := ss.ToData("value")
+	if err != nil {
+		t.Fatal(err)
+	}
+	testCases := []struct {
+		name       string
+		format     nearcache.InMemoryFormat
+		storedType interface{}
+		hasCost    bool
+	}{
+		{name: "binary", format: nearcache.InMemoryFormatBinary, storedType: iserialization.Data{}, hasCost: true},
+		{name: "object", format: nearcache.InMemoryFormatObject, storedType: ""},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ncc := nearcache.Config{Name: "test", InMemoryFormat: tc.format}
+			if err := ncc.Validate(); err != nil {
+				t.Fatal(err)
+			}
+			nc := NewNearCache("test", &ncc, ss, ilogger.LogAdaptor{Logger: ilogger.New()})
+			defer nc.Destroy()
+			rid, err := nc.TryReserveForUpdate("key", nil, UpdateSemanticReadUpdate)
+			if err != nil {
+				t.Fatal(err)
+			}
+			// the value is received from the member in the serialized form.
+			if _, err := nc.TryPublishReserved("key", valueData, rid); err != nil {
+				t.Fatal(err)
+			}
+			rec, ok := nc.GetRecord("key")
+			if !ok {
+				t.Fatal("record not found")
+			}
+			assert.IsType(t, tc.storedType, rec.Value())
+			for i := 0; i < 2; i++ {
+				v, ok, err := nc.Get("key")
+				if err != nil {
+					t.Fatal(err)
+				}
+				assert.True(t, ok)
+				assert.Equal(t, "value", v)
+			}
+			st := nc.Stats()
+			assert.Equal(t, int64(2), st.Hits)
+			assert.Equal(t, int64(1), st.OwnedEntryCount)
+			// the memory cost of deserialized values cannot be estimated.
+			assert.Equal(t, tc.hasCost, st.OwnedEntryMemoryCost > 0)
+			nc.Invalidate("key")
+			assert.Equal(t, int64(0), nc.Stats().OwnedEntryMemoryCost)
+		})
+	}
+}
+
+func TestRecordStore_InvalidationStats(t *testing.T) {
+	sc := &serialization.Config{}
+	ss, err := iserialization.NewService(sc, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ncc := &nearcache.Config{}
+	vsa := &nearCacheValueStoreAdapter{ss: ss}
+	rs := NewRecordStore(ncc, ss, vsa, vsa)
+	rid, err := rs.TryReserveForUpdate("key", nil, UpdateSemanticReadUpdate)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := rs.TryPublishReserved("key", "value", rid, true); err != nil {
+		t.Fatal(err)
+	}
+	// invalidating a cached key
+	rs.Invalidate("key")
+	stats := rs.Stats()
+	assert.Equal(t, int64(1), stats.InvalidationRequests)
+	assert.Equal(t, int64(1), stats.Invalidations)
+	assert.Equal(t, int64(0), stats.OwnedEntryCount)
+	// invalidating a key which is not cached
+	rs.Invalidate("key")
+	stats = rs.Stats()
+	assert.Equal(t, int64(2), stats.InvalidationRequests)
+	assert.Equal(t, int64(1), stats.Invalidations)
+}
+
+func TestRecordStore_Dump(t *testing.T) {
+	sc := &serialization.Config{}
+	ss, err := iserialization.NewService(sc, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ncc := &nearcache.Config{}
+	vsa := &nearCacheValueStoreAdapter{ss: ss}
+	rs := NewRecordStore(ncc, ss, vsa, vsa)
+	keyData, err := ss.ToData("k3")
+	if err != nil {
+		t.Fatal(err)
+	}
+	start := time.Now().Truncate(time.Second)
+	for _, key := range []interface{}{"k1", "k2", keyData} {
+		rid, err := rs.TryReserveForUpdate(key, nil, UpdateSemanticReadUpdate)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := rs.TryPublishReserved(key, "value", rid, true); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// the reserved record without a value is not dumped.
+	if _, err := rs.TryReserveForUpdate("k4", nil, UpdateSemanticReadUpdate); err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := rs.Get("k1"); err != nil {
+		t.Fatal(err)
+	}
+	infos, err := rs.Dump()
+	if err != nil {
+		t.Fatal(err)
+	}
+	byKey := map[interface{}]nearcache.RecordInfo{}
+	for _, info := range infos {
+		byKey[info.Key] = info
+	}
+	assert.Len(t, byKey, 3)
+	for _, key := range []string{"k1", "k2", "k3"} {
+		info, ok := byKey[key]
+		if !assert.True(t, ok, key) {
+			continue
+		}
+		assert.False(t, info.CreationTime.Before(start))
+		assert.False(t, info.CreationTime.After(time.Now()))
+		assert.True(t, info.ExpirationTime.IsZero())
+	}
+	assert.False(t, byKey["k1"].LastAccessTime.IsZero())
+	assert.Equal(t, int32(1), byKey["k1"].Hits)
+	assert.True(t, byKey["k2"].LastAccessTime.IsZero())
+	assert.Equal(t, int32(0), byKey["k2"].Hits)
+}
+
+func TestRecordStore_NilTimeToLive(t *testing.T) {
+	sc := &serialization.Config{}
+	ss, err := iserialization.NewService(sc, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ncc := &nearcache.Config{TimeToLiveSeconds: 60, NilTimeToLiveSeconds: 1}
+	if err := ncc.Validate(); err != nil {
+		t.Fatal(err)
+	}
+	vsa := &nearCacheValueStoreAdapter{ss: ss}
+	rs := NewRecordStore(ncc, ss, vsa, vsa)
+	publish := func(key, value interface{}, ups UpdateSemantic) {
+		rid, err := rs.TryReserveForUpdate(key, nil, ups)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := rs.TryPublishReserved(key, value, rid, false); err != nil {
+			t.Fatal(err)
+		}
+	}
+	publish("missing", nil, UpdateSemanticReadUpdate)
+	publish("present", "value", UpdateSemanticReadUpdate)
+	value, found, err := rs.Get("missing")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.True(t, found)
+	assert.Nil(t, value)
+	// record times have the resolution of a second
+	time.Sleep(2100 * time.Millisecond)
+	_, found, err = rs.Get("missing")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the missing key must be fetched again
+	assert.False(t, found)
+	value, found, err = rs.Get("present")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.True(t, found)
+	assert.Equal(t, "value", value)
+	// a key cached as nil gets the time to live of a present value once it has a value
+	publish("appeared", nil, UpdateSemanticReadUpdate)
+	publish("appeared", "value", UpdateSemanticWriteUpdate)
+	rec, ok := rs.GetRecord("appeared")
+	if !assert.True(t, ok) {
+		t.FailNow()
+	}
+	assert.False(t, rec.CachedAsNil())
+	assert.Greater(t, rec.ExpirationTime(), time.Now().Add(30*time.Second).UnixMilli())
+}
+
+func TestRecordStore_MaxIdle(t *testing.T) {
+	sc := &serialization.Config{}
+	ss, err := iserialization.NewService(sc, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// record times have the resolution of a second, so an access may be recorded up to a second earlier than it happens.
+	ncc := &nearcache.Config{MaxIdleSeconds: 2}
+	if err := ncc.Validate(); err != nil {
+		t.Fatal(err)
+	}
+	vsa := &nearCacheValueStoreAdapter{ss: ss}
+	rs := NewRecordStore(ncc, ss, vsa, vsa)
+	for _, key := range []string{"idle", "accessed"} {
+		rid, err := rs.TryReserveForUpdate(key, nil, UpdateSemanticReadUpdate)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := rs.TryPublishReserved(key, "value", rid, false); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// the accessed key survives longer than the max idle time, since it is read periodically.
+	for i := 0; i < 11; i++ {
+		time.Sleep(300 * time.Millisecond)
+		_, found, err := rs.Get("accessed")
+		if err != nil {
+			t.Fatal(err)
+		}
+		require.True(t, found, "accessed key expired")
+	}
+	_, found, err := rs.Get("idle")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.False(t, found)
+	_, ok := rs.GetRecord("idle")
+	assert.False(t, ok)
+	stats := rs.Stats()
+	assert.Equal(t, int64(1), stats.Expirations)
+	assert.Equal(t, int64(1), stats.Misses)
+	assert.Equal(t, int64(11), stats.Hits)
+	assert.Equal(t, int64(1), stats.OwnedEntryCount)
+}
+
+func TestRecordStore_TimeToLive(t *testing.T) {
+	sc := &serialization.Config{}
+	ss, err := iserialization.NewService(sc, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ncc := &nearcache.Config{TimeToLiveSeconds: 2}
+	if err := ncc.Validate(); err != nil {
+		t.Fatal(err)
+	}
+	vsa := &nearCacheValueStoreAdapter{ss: ss}
+	rs := NewRecordStore(ncc, ss, vsa, vsa)
+	clock := newTestClock(rs)
+	publish := func(value interface{}) {
+		rid, err := rs.TryReserveForUpdate("key", nil, UpdateSemanticReadUpdate)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := rs.TryPublishReserved("key", value, rid, false); err != nil {
+			t.Fatal(err)
+		}
+	}
+	publish("value")
+	// unlike max idle, reading the key does not extend its life.
+	for i := 0; i < 3; i++ {
+		clock.advance(500 * time.Millisecond)
+		_, found, err := rs.Get("key")
+		if err != nil {
+			t.Fatal(err)
+		}
+		require.True(t, found, "key expired before its time to live")
+	}
+	clock.advance(500 * time.Millisecond)
+	_, found, err := rs.Get("key")
+	if err != nil {
+		t.Fatal(err)
+	}
+	require.False(t, found, "key did not expire")
+	_, ok := rs.GetRecord("key")
+	assert.False(t, ok)
+	stats := rs.Stats()
+	assert.Equal(t, int64(1), stats.Expirations)
+	assert.Equal(t, int64(1), stats.Misses)
+	assert.Equal(t, int64(0), stats.OwnedEntryCount)
+	// the value fetched from the cluster is cached again.
+	publish("remote")
+	value, found, err := rs.Get("key")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.True(t, found)
+	assert.Equal(t, "remote", value)
+}
+
+func TestRecordStore_TimeToLiveAndMaxIdle(t *testing.T) {
+	sc := &serialization.Config{}
+	ss, err := iserialization.NewService(sc, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ncc := &nearcache.Config{TimeToLiveSeconds: 4, MaxIdleSeconds: 2}
+	if err := ncc.Validate(); err != nil {
+		t.Fatal(err)
+	}
+	vsa := &nearCacheValueStoreAdapter{ss: ss}
+	rs := NewRecordStore(ncc, ss, vsa, vsa)
+	clock := newTestClock(rs)
+	for _, key := range []string{"idle", "accessed"} {
+		rid, err := rs.TryReserveForUpdate(key, nil, UpdateSemanticReadUpdate)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := rs.TryPublishReserved(key, "value", rid, false); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// the accessed key does not hit the max idle limit, but hits the time to live limit.
+	for i := 0; i < 7; i++ {
+		clock.advance(500 * time.Millisecond)
+		_, found, err := rs.Get("accessed")
+		if err != nil {
+			t.Fatal(err)
+		}
+		require.True(t, found, "accessed key expired before its time to live")
+	}
+	// the idle key hits the max idle limit before its time to live.
+	_, found, err := rs.Get("idle")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.False(t, found)
+	clock.advance(500 * time.Millisecond)
+	_, found, err = rs.Get("accessed")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.False(t, found)
+	stats := rs.Stats()
+	assert.Equal(t, int64(2), stats.Expirations)
+	assert.Equal(t, int64(0), stats.OwnedEntryCount)
+}
+
+// testClock replaces the clock of a record store, so expiration can be tested without waiting.
+type testClock struct {
+	nowMS int64
+}
+
+// newTestClock sets the clock of the given record store to a time which is a whole second after the base time.
+// Record times have the resolution of a second, so the expiration times are exact.
+func newTestClock(rs *RecordStore) *testClock {
+	c := &testClock{nowMS: zeroOutMs(time.Now().UnixMilli())}
+	rs.nowMillis = func() int64 {
+		return c.nowMS
+	}
+	return c
+}
+
+func (c *testClock) advance(d time.Duration) {
+	c.nowMS += d.Milliseconds()
+}
+
+func TestRecordStore_MaxEntryCount(t *testing.T) {
+	sc := &serialization.Config{}
+	ss, err := iserialization.NewService(sc, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	const maxEntryCount = 5
+	ncc := &nearcache.Config{MaxEntryCount: maxEntryCount}
+	if err := ncc.Validate(); err != nil {
+		t.Fatal(err)
+	}
+	vsa := &nearCacheValueStoreAdapter{ss: ss}
+	rs := NewRecordStore(ncc, ss, vsa, vsa)
+	for i := 0; i < maxEntryCount*2; i++ {
+		key := fmt.Sprintf("k%d", i)
+		rid, err := rs.TryReserveForUpdate(key, nil, UpdateSemanticReadUpdate)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if i < maxEntryCount {
+			assert.NotEqual(t, RecordNotReserved, rid, key)
+		} else {
+			// the Near Cache is full, new keys are not cached.
+			assert.Equal(t, RecordNotReserved, rid, key)
+		}
+		if _, err := rs.TryPublishReserved(key, "value", rid, true); err != nil {
+			t.Fatal(err)
+		}
+		assert.LessOrEqual(t, rs.Size(), maxEntryCount)
+	}
+	assert.Equal(t, maxEntryCount, rs.Size())
+	// existing keys can still be updated.
+	rid, err := rs.TryReserveForUpdate("k0", nil, UpdateSemanticWriteUpdate)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.NotEqual(t, RecordNotReserved, rid)
+	value, err := rs.TryPublishReserved("k0", "new-value", rid, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "new-value", value)
+	assert.Equal(t, maxEntryCount, rs.Size())
+	// a new key is cached once there is room for it.
+	rs.Invalidate("k1")
+	rid, err = rs.TryReserveForUpdate("k9", nil, UpdateSemanticReadUpdate)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.NotEqual(t, RecordNotReserved, rid)
+}
+
+func TestNearCache_ReadYourWrites(t *testing.T) {
+	// a reader reserves the key before fetching the value, and publishes the fetched value with the reservation ID.
+	// a write invalidates the key after it completes, which removes the reserved record.
+	// so a value fetched before the write cannot be published after it, and a read which follows the write is never stale.
+	ss, err := iserialization.NewService(&serialization.Config{}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ncc := nearcache.Config{Name: "test"}
+	if err := ncc.Validate(); err != nil {
+		t.Fatal(err)
+	}
+	nc := NewNearCache("test", &ncc, ss, ilogger.LogAdaptor{Logger: ilogger.New()})
+	defer nc.Destroy()
+	keyData, err := ss.ToData("key")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// remote holds the value in the cluster.
+	var remote int64
+	get := func() int64 {
+		if v, ok, err := nc.Get("key"); err != nil {
+			panic(err)
+		} else if ok {
+			return v.(int64)
+		}
+		rid, err := nc.TryReserveForUpdate("key", keyData, UpdateSemanticReadUpdate)
+		if err != nil {
+			panic(err)
+		}
+		v := atomic.LoadInt64(&remote)
+		if rid == RecordNotReserved {
+			return v
+		}
+		cached, err := nc.TryPublishReserved("key", v, rid)
+		if err != nil {
+			panic(err)
+		}
+		return cached.(int64)
+	}
+	var stop int32
+	wg := &sync.WaitGroup{}
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for atomic.LoadInt32(&stop) == 0 {
+				get()
+			}
+		}()
+	}
+	for i := int64(1); i <= 20_000; i++ {
+		atomic.StoreInt64(&remote, i)
+		nc.Invalidate("key")
+		if v := get(); v < i {
+			atomic.StoreInt32(&stop, 1)
+			wg.Wait()
+			t.Fatalf("stale read after write %d: %d", i, v)
+		}
+	}
+	atomic.StoreInt32(&stop, 1)
+	wg.Wait()
+}
+
+func TestNearCache_ExpirationTaskReclaimsIdleEntries(t *testing.T) {
+	ss, err := iserialization.NewService(&serialization.Config{}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ncc := nearcache.Config{Name: "test", MaxIdleSeconds: 1, ExpirationTaskPeriodSeconds: 1}
+	if err := ncc.Validate(); err != nil {
+		t.Fatal(err)
+	}
+	nc := NewNearCache("test", &ncc, ss, ilogger.LogAdaptor{Logger: ilogger.New()})
+	defer nc.Destroy()
+	for i := 0; i < 10; i++ {
+		key := fmt.Sprintf("key-%d", i)
+		keyData, err := ss.ToData(key)
+		if err != nil {
+			t.Fatal(err)
+		}
+		rid, err := nc.TryReserveForUpdate(key, keyData, UpdateSemanticReadUpdate)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := nc.TryPublishReserved(key, int64(i), rid); err != nil {
+			t.Fatal(err)
+		}
+	}
+	assert.Equal(t, 10, nc.Size())
+	// the entries are never read, so only the expiration task can remove them.
+	assert.Eventually(t, func() bool {
+		return nc.Size() == 0
+	}, 10*time.Second, 100*time.Millisecond)
+	stats := nc.Stats()
+	assert.Equal(t, int64(10), stats.Expirations)
+	assert.Equal(t, int64(0), stats.OwnedEntryCount)
+}
+
+func TestRepairingHandler_SeededInvalidationMetadata(t *testing.T) {
+	ss, err := iserialization.NewService(&serialization.Config{}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ncc := nearcache.Config{Name: "test"}
+	if err := ncc.Validate(); err != nil {
+		t.Fatal(err)
+	}
+	lg := ilogger.LogAdaptor{Logger: ilogger.New()}
+	nc := NewNearCache("test", &ncc, ss, lg)
+	defer nc.Destroy()
+	const partitionCount = 2
+	h := NewRepairingHandler("test", nc, partitionCount, ss, nil, lg, types.NewUUID())
+	rt := &ReparingTask{
+		handlers:       &sync.Map{},
+		lg:             lg,
+		partitionCount: partitionCount,
+	}
+	rt.handlers.Store(h.Name(), h)
+	// seed the metadata the way it is done when the invalidation listener is registered.
+	partitionUUID := types.NewUUID()
+	handlers := map[string]*RepairingHandler{h.Name(): h}
+	df := InvalidationMetaDataFetcher{lg: lg}
+	df.initUUIDs([]proto.Pair{
+		proto.NewPair(int32(0), partitionUUID),
+		proto.NewPair(int32(1), partitionUUID),
+	}, handlers)
+	df.initSequence([]proto.Pair{
+		proto.NewPair(h.Name(), []proto.Pair{
+			proto.NewPair(int32(0), int64(10)),
+			proto.NewPair(int32(1), int64(20)),
+		}),
+	}, handlers)
+	// an invalidation which follows the seeded sequence is accepted without a miss.
+	h.CheckOrRepairUUID(0, partitionUUID)
+	h.CheckOrRepairSequence(0, 11, false)
+	md0 := h.GetMetaDataContainer(0)
+	assert.Equal(t, int64(11), md0.Sequence())
+	assert.Equal(t, int64(0), md0.MissedSequenceCount())
+	// an invalidation which skips sequences is counted as missed.
+	h.CheckOrRepairUUID(1, partitionUUID)
+	h.CheckOrRepairSequence(1, 25, false)
+	md1 := h.GetMetaDataContainer(1)
+	assert.Equal(t, int64(25), md1.Sequence())
+	assert.Equal(t, int64(4), md1.MissedSequenceCount())
+	// the repairing task marks the entries of the partition with the missed sequences as stale.
+	rt.fixSequenceGaps()
+	assert.Equal(t, int64(0), md0.StaleSequence())
+	assert.Equal(t, int64(25), md1.StaleSequence())
+	assert.Equal(t, int64(0), md1.MissedSequenceCount())
+	sr := NewStaleReadDetector(h, nil)
+	rec := NewRecord("value", time.Now().UnixMilli(), RecordStoreTimeNotSet)
+	rec.SetUUID(partitionUUID)
+	rec.SetPartitionID(1)
+	rec.SetInvalidationSequence(20)
+	assert.True(t, sr.IsStaleRead(rec))
+	rec.SetPartitionID(0)
+	rec.SetInvalidationSequence(11)
+	assert.False(t, sr.IsStaleRead(rec))
+}
+
+func TestReparingTask_RemoveStaleRecords(t *testing.T) {
+	ss, err := iserialization.NewService(&serialization.Config{}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ncc := nearcache.Config{Name: "test"}
+	if err := ncc.Validate(); err != nil {
+		t.Fatal(err)
+	}
+	lg := ilogger.LogAdaptor{Logger: ilogger.New()}
+	nc := NewNearCache("test", &ncc, ss, lg)
+	defer nc.Destroy()
+	const partitionCount = 2
+	h := NewRepairingHandler("test", nc, partitionCount, ss, nil, lg, types.NewUUID())
+	sr := NewStaleReadDetector(h, nil)
+	nc.store.staleReadDetector = &sr
+	rt := &ReparingTask{
+		handlers:       &sync.Map{},
+		lg:             lg,
+		partitionCount: partitionCount,
+	}
+	rt.handlers.Store(h.Name(), h)
+	partitionUUID := types.NewUUID()
+	handlers := map[string]*RepairingHandler{h.Name(): h}
+	df := InvalidationMetaDataFetcher{lg: lg}
+	df.initUUIDs([]proto.Pair{
+		proto.NewPair(int32(0), partitionUUID),
+		proto.NewPair(int32(1), partitionUUID),
+	}, handlers)
+	df.initSequence([]proto.Pair{
+		proto.NewPair(h.Name(), []proto.Pair{
+			proto.NewPair(int32(0), int64(10)),
+			proto.NewPair(int32(1), int64(10)),
+		}),
+	}, handlers)
+	// cache a record in each partition, with the current invalidation sequence.
+	valueData, err := ss.ToData("value")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, key := range []string{"k0", "k1"} {
+		rec := NewRecord(valueData, time.Now().UnixMilli(), RecordStoreTimeNotSet)
+		rec.SetReservationID(RecordReadPermitted)
+		rec.SetUUID(partitionUUID)
+		rec.SetPartitionID(int32(i))
+		rec.SetInvalidationSequence(10)
+		nc.store.records[key] = rec
+	}
+	// nothing is stale before the metadata changes.
+	assert.Equal(t, 0, rt.removeStaleRecords(h))
+	assert.Equal(t, 2, nc.Size())
+	// the invalidation with sequence 11 for partition 1 is dropped, the member reports the latest sequence.
+	df.repairSequences([]proto.Pair{
+		proto.NewPair(h.Name(), []proto.Pair{
+			proto.NewPair(int32(0), int64(10)),
+			proto.NewPair(int32(1), int64(11)),
+		}),
+	}, handlers)
+	// the max tolerated miss count is not exceeded, so the periodic run would not mark the record stale yet.
+	rt.maxToleratedMissCount = 10
+	rt.fixSequenceGaps()
+	assert.Equal(t, 2, nc.Size())
+	// reconciling removes the stale record immediately.
+	assert.Equal(t, 1, rt.removeStaleRecords(h))
+	_, ok := nc.store.records["k1"]
+	assert.False(t, ok)
+	_, ok = nc.store.records["k0"]
+	assert.True(t, ok)
+}
+
+func TestReparingTask_StopsOnDone(t *testing.T) {
+	lg := ilogger.LogAdaptor{Logger: ilogger.New()}
+	doneCh := make(chan struct{})
+	rt := &ReparingTask{
+		handlers:       &sync.Map{},
+		lg:             lg,
+		partitionCount: 2,
+		doneCh:         doneCh,
+	}
+	stoppedCh := make(chan struct{})
+	go func() {
+		rt.start()
+		close(stoppedCh)
+	}()
+	// let the task run at least once more after the initial run.
+	time.Sleep(1500 * time.Millisecond)
+	close(doneCh)
+	select {
+	case <-stoppedCh:
+	case <-time.After(5 * time.Second):
+		t.Fatalf("the repairing task did not stop")
+	}
+}
+
+func TestNearCache_StoreKeysThenPreload(t *testing.T) {
+	testCases := []struct {
+		name          string
+		serializeKeys bool
+	}{
+		{name: "original keys", serializeKeys: false},
+		{name: "serialized keys", serializeKeys: true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ss, err := iserialization.NewService(&serialization.Config{}, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			dir := filepath.Join(t.TempDir(), "preloader")
+			ncc := nearcache.Config{
+				Name:          "test",
+				SerializeKeys: tc.serializeKeys,
+				Preloader:     nearcache.PreloaderConfig{Enabled: true, Directory: dir},
+			}
+			if err := ncc.Validate(); err != nil {
+				t.Fatal(err)
+			}
+			lg := ilogger.LogAdaptor{Logger: ilogger.New()}
+			nc := NewNearCache("my:map", &ncc, ss, lg)
+			var target []string
+			for i := 0; i < 10; i++ {
+				key := fmt.Sprintf("key-%d", i)
+				keyData, err := ss.ToData(key)
+				if err != nil {
+					t.Fatal(err)
+				}
+				var ncKey interface{} = key
+				if tc.serializeKeys {
+					ncKey = keyData
+				}
+				rid, err := nc.TryReserveForUpdate(ncKey, keyData, UpdateSemanticReadUpdate)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if _, err := nc.TryPublishReserved(ncKey, int64(i), rid); err != nil {
+					t.Fatal(err)
+				}
+				target = append(target, key)
+			}
+			nc.StoreKeys()
+			nc.Destroy()
+			b, err := os.ReadFile(filepath.Join(dir, "nearCache-my_map.store"))
+			if err != nil {
+				t.Fatal(err)
+			}
+			// magic bytes and file format
+			assert.Equal(t, []byte{0xEA, 0x3C, 0xAC, 0x4E, 0, 0, 0, 0}, b[:8])
+			stats := nc.Stats()
+			assert.Equal(t, int64(1), stats.PersistenceCount)
+			assert.Equal(t, int64(10), stats.LastPersistenceKeyCount)
+			assert.Equal(t, int64(len(b)), stats.LastPersistenceWrittenBytes)
+			assert.False(t, stats.LastPersistenceTime.IsZero())
+			assert.Equal(t, "", stats.LastPersistenceFailure)
+			// the keys are not stored after the Near Cache is destroyed
+			nc.StoreKeys()
+			assert.Equal(t, int64(1), nc.Stats().PersistenceCount)
+			nc = NewNearCache("my:map", &ncc, ss, lg)
+			defer nc.Destroy()
+			keyDatas, err := nc.PreloadKeys()
+			if err != nil {
+				t.Fatal(err)
+			}
+			var keys []string
+			for _, kd := range keyDatas {
+				key, err := ss.ToObject(kd)
+				if err != nil {
+					t.Fatal(err)
+				}
+				keys = append(keys, key.(string))
+			}
+			assert.ElementsMatch(t, target, keys)
+		})
+	}
+}
+
+func TestNearCache_PreloadKeysWithoutStoredKeys(t *testing.T) {
+	ss, err := iserialization.NewService(&serialization.Config{}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ncc := nearcache.Config{
+		Name:      "test",
+		Preloader: nearcache.PreloaderConfig{Enabled: true, Directory: t.TempDir()},
+	}
+	if err := ncc.Validate(); err != nil {
+		t.Fatal(err)
+	}
+	nc := NewNearCache("test", &ncc, ss, ilogger.LogAdaptor{Logger: ilogger.New()})
+	defer nc.Destroy()
+	keyDatas, err := nc.PreloadKeys()
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Len(t, keyDatas, 0)
+}
+
+func TestNearCache_StoreKeysToUnwritableDirectory(t *testing.T) {
+	ss, err := iserialization.NewService(&serialization.Config{}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// a directory cannot be created under a regular file, even by root.
+	file := filepath.Join(t.TempDir(), "file")
+	if err := os.WriteFile(file, nil, 0o644); err != nil {
+		t.Fatal(err)
+	}
+	ncc := nearcache.Config{
+		Name:      "test",
+		Preloader: nearcache.PreloaderConfig{Enabled: true, Directory: filepath.Join(file, "preloader")},
+	}
+	if err := ncc.Validate(); err != nil {
+		t.Fatal(err)
+	}
+	nc := NewNearCache("test", &ncc, ss, ilogger.LogAdaptor{Logger: ilogger.New()})
+	defer nc.Destroy()
+	keyData, err := ss.ToData("key")
+	if err != nil {
+		t.Fatal(err)
+	}
+	rid, err := nc.TryReserveForUpdate("key", keyData, UpdateSemanticReadUpdate)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := nc.TryPublishReserved("key", "value", rid); err != nil {
+		t.Fatal(err)
+	}
+	nc.StoreKeys()
+	stats := nc.Stats()
+	assert.Equal(t, int64(0), stats.PersistenceCount)
+	assert.Equal(t, int64(0), stats.LastPersistenceKeyCount)
+	assert.True(t, stats.LastPersistenceTime.IsZero())
+	assert.NotEqual(t, "", stats.LastPersistenceFailure)
+	// the Near Cache is still usable
+	v, ok, err := nc.Get("key")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.True(t, ok)
+	assert.Equal(t, "value", v)
+}
+
+func TestNearCache_StoreTask(t *testing.T) {
+	t.Setenv(EnvStoreInitialDelay, "10ms")
+	t.Setenv(EnvStoreInterval, "10ms")
+	ss, err := iserialization.NewService(&serialization.Config{}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ncc := nearcache.Config{
+		Name:      "test",
+		Preloader: nearcache.PreloaderConfig{Enabled: true, Directory: t.TempDir()},
+	}
+	if err := ncc.Validate(); err != nil {
+		t.Fatal(err)
+	}
+	nc := NewNearCache("test", &ncc, ss, ilogger.LogAdaptor{Logger: ilogger.New()})
+	defer nc.Destroy()
+	assert.Eventually(t, func() bool {
+		return nc.Stats().PersistenceCount >= 2
+	}, 10*time.Second, 10*time.Millisecond)
+}
+
+func TestNearCache_EvictionVictim(t *testing.T) {
+	const size = 10
+	ss, err := iserialization.NewService(&serialization.Config{}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// toKey returns the key in the form the Near Cache stores it.
+	toKey := func(t *testing.T, serializeKeys bool, key string) (interface{}, iserialization.Data) {
+		keyData, err := ss.ToData(key)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if serializeKeys {
+			return keyData, keyData
+		}
+		return key, keyData
+	}
+	// reversedKeys evicts the greatest key first, the comparator receives serialized keys.
+	reversedKeys := simpleEvictionPolicyComparator{
+		f: func(e1, e2 nearcache.EvictableEntryView) int {
+			k1, err := ss.ToObject(e1.Key().(iserialization.Data))
+			if err != nil {
+				panic(err)
+			}
+			k2, err := ss.ToObject(e2.Key().(iserialization.Data))
+			if err != nil {
+				panic(err)
+			}
+			return strings.Compare(k2.(string), k1.(string))
+		},
+	}
+	testCases := []struct {
+		name          string
+		policy        nearcache.EvictionPolicy
+		cmp           nearcache.EvictionPolicyComparator
+		serializeKeys bool
+		victim        string
+		access        func(t *testing.T, nc *NearCache, key interface{}, victim bool)
+	}{
+		{
+			name:   "LRU",
+			policy: nearcache.EvictionPolicyLRU,
+			victim: "k3",
+			access: func(t *testing.T, nc *NearCache, key interface{}, victim bool) {
+				// record times have the resolution of a second, so all keys are created well before they are accessed.
+				rec, _ := nc.GetRecord(key)
+				rec.SetCreationTime(time.Now().Add(-10 * time.Second).UnixMilli())
+				if !victim {
+					if _, _, err := nc.Get(key); err != nil {
+						t.Fatal(err)
+					}
+				}
+			},
+		},
+		{
+			name:   "LFU",
+			policy: nearcache.EvictionPolicyLFU,
+			victim: "k5",
+			access: func(t *testing.T, nc *NearCache, key interface{}, victim bool) {
+				if victim {
+					return
+				}
+				for i := 0; i < 2; i++ {
+					if _, _, err := nc.Get(key); err != nil {
+						t.Fatal(err)
+					}
+				}
+			},
+		},
+		{
+			name:          "comparator",
+			cmp:           reversedKeys,
+			serializeKeys: true,
+			victim:        "k9",
+			access:        func(t *testing.T, nc *NearCache, key interface{}, victim bool) {},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ncc := nearcache.Config{Name: "test", SerializeKeys: tc.serializeKeys}
+			if tc.cmp != nil {
+				ncc.Eviction.SetComparator(tc.cmp)
+			} else {
+				ncc.Eviction.SetPolicy(tc.policy)
+			}
+			ncc.Eviction.SetSize(size)
+			if err := ncc.Validate(); err != nil {
+				t.Fatal(err)
+			}
+			nc := NewNearCache("test", &ncc, ss, ilogger.LogAdaptor{Logger: ilogger.New()})
+			defer nc.Destroy()
+			put := func(key string) {
+				ncKey, keyData := toKey(t, tc.serializeKeys, key)
+				rid, err := nc.TryReserveForUpdate(ncKey, keyData, UpdateSemanticReadUpdate)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if _, err := nc.TryPublishReserved(ncKey, key, rid); err != nil {
+					t.Fatal(err)
+				}
+			}
+			for i := 0; i < size; i++ {
+				put(fmt.Sprintf("k%d", i))
+			}
+			// all records fit in a single sample, so the victim is deterministic.
+			for i := 0; i < size; i++ {
+				key := fmt.Sprintf("k%d", i)
+				ncKey, _ := toKey(t, tc.serializeKeys, key)
+				tc.access(t, nc, ncKey, key == tc.victim)
+			}
+			assert.Equal(t, int64(0), nc.Stats().Evictions)
+			put("new")
+			assert.Equal(t, size, nc.Size())
+			assert.Equal(t, int64(1), nc.Stats().Evictions)
+			ncKey, _ := toKey(t, tc.serializeKeys, tc.victim)
+			_, ok := nc.GetRecord(ncKey)
+			assert.False(t, ok, "victim was not evicted")
+			ncKey, _ = toKey(t, tc.serializeKeys, "new")
+			_, ok = nc.GetRecord(ncKey)
+			assert.True(t, ok)
+		})
+	}
+}
+
+func TestRecordStore_ReserveForWriteUpdate(t *testing.T) {
+	ss, err := iserialization.NewService(&serialization.Config{}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ncc := &nearcache.Config{SerializeKeys: true}
+	if err := ncc.Validate(); err != nil {
+		t.Fatal(err)
+	}
+	dsa := &nearCacheDataStoreAdapter{ss: ss}
+	rs := NewRecordStore(ncc, ss, dsa, dsa)
+	key, err := ss.ToData("key")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// a key which is not cached yet is reserved and the written value is published.
+	rid, err := rs.TryReserveForUpdate(key, key, UpdateSemanticWriteUpdate)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.NotEqual(t, RecordNotReserved, rid)
+	if _, err := rs.TryPublishReserved(key, "value", rid, false); err != nil {
+		t.Fatal(err)
+	}
+	value, ok, err := rs.Get(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.True(t, ok)
+	assert.Equal(t, "value", value)
+	assert.Equal(t, int64(1), rs.Stats().OwnedEntryCount)
+	// a cached key is reserved again for the next write.
+	rid, err = rs.TryReserveForUpdate(key, key, UpdateSemanticWriteUpdate)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.NotEqual(t, RecordNotReserved, rid)
+	// a concurrent write removes the reserved record instead of reserving it, so neither write publishes a stale value.
+	rid2, err := rs.TryReserveForUpdate(key, key, UpdateSemanticWriteUpdate)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, RecordNotReserved, rid2)
+	_, ok = rs.GetRecord(key)
+	assert.False(t, ok)
+	if _, err := rs.TryPublishReserved(key, "stale", rid, false); err != nil {
+		t.Fatal(err)
+	}
+	_, ok = rs.GetRecord(key)
+	assert.False(t, ok)
+	stats := rs.Stats()
+	assert.Equal(t, int64(0), stats.OwnedEntryCount)
+	assert.Equal(t, int64(0), stats.OwnedEntryMemoryCost)
+}
+
+func TestNearCache_ExpirationTaskStopsOnDestroy(t *testing.T) {
+	t.Setenv(EnvExpirationTaskInitialDelay, "10ms")
+	t.Setenv(EnvExpirationTaskPeriod, "10ms")
+	ss, err := iserialization.NewService(&serialization.Config{}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ncc := nearcache.Config{Name: "test", MaxIdleSeconds: 1}
+	if err := ncc.Validate(); err != nil {
+		t.Fatal(err)
+	}
+	before := runtime.NumGoroutine()
+	nc := NewNearCache("test", &ncc, ss, ilogger.LogAdaptor{Logger: ilogger.New()})
+	nc.Destroy()
+	// not using assert.Eventually, since it runs the condition in another goroutine.
+	deadline := time.Now().Add(10 * time.Second)
+	for runtime.NumGoroutine() > before {
+		if time.Now().After(deadline) {
+			t.Fatalf("expiration task did not stop")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
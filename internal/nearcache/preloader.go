@@ -0,0 +1,133 @@
+/*
+ * Copyright (c) 2008-2023, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package nearcache
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/hazelcast/hazelcast-go-client/internal/serialization"
+)
+
+const (
+	// see: com.hazelcast.internal.nearcache.impl.preloader.NearCachePreloader#MAGIC_BYTES
+	preloaderMagicBytes uint32 = 0xEA3CAC4E
+	// see: com.hazelcast.internal.nearcache.impl.preloader.NearCachePreloader.FileFormat#INTERLEAVED_LENGTH_FIELD
+	preloaderFileFormat uint32 = 0
+	// see: com.hazelcast.internal.nearcache.impl.preloader.NearCachePreloader#LOAD_BATCH_SIZE
+	PreloaderLoadBatchSize = 100
+)
+
+// preloaderFileNameReplacer replaces the characters which are not allowed in file names.
+// see: com.hazelcast.internal.nearcache.impl.preloader.NearCachePreloader#toFileName
+var preloaderFileNameReplacer = strings.NewReplacer(
+	":", "_", "\\", "_", "/", "_", "*", "_", "\"", "_", "?", "_", "|", "_", "<", "_", ">", "_", "'", "_", ",", "_",
+)
+
+// preloader reads and writes the key set of a Near Cache.
+// The file starts with the magic bytes and the file format, followed by the length prefixed keys.
+// All integers are big endian.
+// port of: com.hazelcast.internal.nearcache.impl.preloader.NearCachePreloader
+type preloader struct {
+	dir     string
+	path    string
+	tmpPath string
+}
+
+func newPreloader(dir, name string) *preloader {
+	path := filepath.Join(dir, PreloaderFilename(name))
+	return &preloader{
+		dir:     dir,
+		path:    path,
+		tmpPath: path + "~",
+	}
+}
+
+// PreloaderFilename returns the name of the file which stores the keys of the Near Cache with the given name.
+func PreloaderFilename(name string) string {
+	return fmt.Sprintf("nearCache-%s.store", preloaderFileNameReplacer.Replace(name))
+}
+
+// storeKeys writes the given keys and returns the number of written bytes.
+// The keys are written to a temporary file first, which replaces the previous file only if writing succeeds.
+func (p *preloader) storeKeys(keys []serialization.Data) (int64, error) {
+	if p.dir != "" {
+		if err := os.MkdirAll(p.dir, 0o755); err != nil {
+			return 0, fmt.Errorf("creating Near Cache preloader directory: %w", err)
+		}
+	}
+	size := 8
+	for _, key := range keys {
+		size += 4 + len(key)
+	}
+	b := make([]byte, 8, size)
+	binary.BigEndian.PutUint32(b, preloaderMagicBytes)
+	binary.BigEndian.PutUint32(b[4:], preloaderFileFormat)
+	for _, key := range keys {
+		b = binary.BigEndian.AppendUint32(b, uint32(len(key)))
+		b = append(b, key...)
+	}
+	if err := os.WriteFile(p.tmpPath, b, 0o644); err != nil {
+		return 0, fmt.Errorf("writing Near Cache preloader file: %w", err)
+	}
+	if err := os.Rename(p.tmpPath, p.path); err != nil {
+		if rmErr := os.Remove(p.tmpPath); rmErr != nil {
+			// the error is logged by the caller.
+			return 0, fmt.Errorf("replacing Near Cache preloader file: %w (removing the temporary file: %s)", err, rmErr.Error())
+		}
+		return 0, fmt.Errorf("replacing Near Cache preloader file: %w", err)
+	}
+	return int64(len(b)), nil
+}
+
+// loadKeys reads the stored keys.
+// Returns no keys and no error if there is no stored file.
+func (p *preloader) loadKeys() ([]serialization.Data, error) {
+	b, err := os.ReadFile(p.path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("reading Near Cache preloader file: %w", err)
+	}
+	if len(b) < 8 || binary.BigEndian.Uint32(b) != preloaderMagicBytes {
+		return nil, fmt.Errorf("reading Near Cache preloader file %s: invalid magic bytes", p.path)
+	}
+	if f := binary.BigEndian.Uint32(b[4:]); f != preloaderFileFormat {
+		return nil, fmt.Errorf("reading Near Cache preloader file %s: unknown file format: %d", p.path, f)
+	}
+	var keys []serialization.Data
+	for b = b[8:]; len(b) > 0; {
+		if len(b) < 4 {
+			return nil, fmt.Errorf("reading Near Cache preloader file %s: truncated key length", p.path)
+		}
+		n := binary.BigEndian.Uint32(b)
+		b = b[4:]
+		if uint64(n) > uint64(len(b)) {
+			return nil, fmt.Errorf("reading Near Cache preloader file %s: truncated key", p.path)
+		}
+		key := make(serialization.Data, n)
+		copy(key, b[:n])
+		keys = append(keys, key)
+		b = b[n:]
+	}
+	return keys, nil
+}
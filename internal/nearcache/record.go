@@ -41,6 +41,8 @@ func NewRecord(value interface{}, creationTime, expirationTime int64) *Record {
 	av.Store(&value)
 	rec := &Record{value: av}
 	rec.SetCreationTime(creationTime)
+	// the zero value corresponds to the base time, so the last access time must be explicitly unset.
+	rec.SetLastAccessTime(RecordStoreTimeNotSet)
 	rec.SetExpirationTIme(expirationTime)
 	return rec
 }
@@ -127,6 +129,10 @@ func (r *Record) SetCachedAsNil() {
 	atomic.StoreInt32(&r.cachedAsNil, 1)
 }
 
+func (r *Record) UnsetCachedAsNil() {
+	atomic.StoreInt32(&r.cachedAsNil, 0)
+}
+
 func (r *Record) InvalidationSequence() int64 {
 	return atomic.LoadInt64(&r.invalidationSequence)
 }
@@ -40,19 +40,24 @@ const (
 type DataString string
 
 type RecordStore struct {
-	stats             nearcache.Stats
-	maxIdleMillis     int64
-	reservationID     int64
-	timeToLiveMillis  int64
-	recordsMu         *sync.RWMutex
-	records           map[interface{}]*Record
-	ss                *serialization.Service
-	valueConverter    nearCacheRecordValueConverter
-	estimator         nearCacheStorageEstimator
-	staleReadDetector *StaleReadDetector
-	evictionDisabled  bool
-	maxSize           int
-	cmp               nearcache.EvictionPolicyComparator
+	stats               nearcache.Stats
+	maxIdleMillis       int64
+	reservationID       int64
+	timeToLiveMillis    int64
+	nilTimeToLiveMillis int64
+	recordsMu           *sync.RWMutex
+	persistenceMu       *sync.Mutex
+	records             map[interface{}]*Record
+	ss                  *serialization.Service
+	valueConverter      nearCacheRecordValueConverter
+	estimator           nearCacheStorageEstimator
+	staleReadDetector   *StaleReadDetector
+	evictionDisabled    bool
+	maxSize             int
+	maxEntryCount       int
+	cmp                 nearcache.EvictionPolicyComparator
+	// nowMillis returns the current time in milliseconds, it is replaced in tests.
+	nowMillis func() int64
 }
 
 func NewRecordStore(cfg *nearcache.Config, ss *serialization.Service, rc nearCacheRecordValueConverter, se nearCacheStorageEstimator) *RecordStore {
@@ -60,17 +65,21 @@ func NewRecordStore(cfg *nearcache.Config, ss *serialization.Service, rc nearCac
 		CreationTime: time.Now(),
 	}
 	return &RecordStore{
-		recordsMu:        &sync.RWMutex{},
-		records:          map[interface{}]*Record{},
-		maxIdleMillis:    int64(cfg.MaxIdleSeconds * 1000),
-		ss:               ss,
-		timeToLiveMillis: int64(cfg.TimeToLiveSeconds * 1000),
-		valueConverter:   rc,
-		estimator:        se,
-		stats:            stats,
-		evictionDisabled: cfg.Eviction.Policy() == nearcache.EvictionPolicyNone,
-		maxSize:          cfg.Eviction.Size(),
-		cmp:              getEvictionPolicyComparator(&cfg.Eviction),
+		recordsMu:           &sync.RWMutex{},
+		persistenceMu:       &sync.Mutex{},
+		records:             map[interface{}]*Record{},
+		maxIdleMillis:       int64(cfg.MaxIdleSeconds * 1000),
+		ss:                  ss,
+		timeToLiveMillis:    int64(cfg.TimeToLiveSeconds * 1000),
+		nilTimeToLiveMillis: int64(cfg.NilTimeToLiveSeconds * 1000),
+		valueConverter:      rc,
+		estimator:           se,
+		stats:               stats,
+		evictionDisabled:    cfg.Eviction.Policy() == nearcache.EvictionPolicyNone,
+		maxSize:             cfg.Eviction.Size(),
+		maxEntryCount:       cfg.MaxEntryCount,
+		cmp:                 getEvictionPolicyComparator(&cfg.Eviction),
+		nowMillis:           currentTimeMillis,
 	}
 }
 
@@ -112,10 +121,12 @@ func (rs *RecordStore) Get(key interface{}) (value interface{}, found bool, err
 		rs.incrementMisses()
 		return nil, false, nil
 	}
-	nowMS := time.Now().UnixMilli()
+	nowMS := rs.nowMillis()
 	if rs.recordExpired(rec, nowMS) {
+		// the value is fetched from the cluster, so the read is a miss as well as an expiration.
 		rs.Invalidate(key)
 		rs.onExpire()
+		rs.incrementMisses()
 		return nil, false, nil
 	}
 	// onRecordAccess
@@ -166,6 +177,28 @@ func (rs *RecordStore) invalidate(key interface{}) {
 	rs.incrementInvalidationRequests()
 }
 
+// RemoveStaleRecords removes the records which the stale read detector reports as stale.
+// Returns the number of removed records.
+func (rs *RecordStore) RemoveStaleRecords() int {
+	if rs.staleReadDetector == nil {
+		return 0
+	}
+	rs.recordsMu.Lock()
+	defer rs.recordsMu.Unlock()
+	var removed int
+	for key, rec := range rs.records {
+		// reserved records are not readable yet, the stale check is done when they are read.
+		if rec.ReservationID() != RecordReadPermitted {
+			continue
+		}
+		if rs.staleReadDetector.IsStaleRead(rec) {
+			rs.invalidate(key)
+			removed++
+		}
+	}
+	return removed
+}
+
 func (rs *RecordStore) doEviction() bool {
 	// port of: com.hazelcast.internal.nearcache.impl.store.AbstractNearCacheRecordStore#doEviction
 	// note that the reference implementation never has withoutMaxSizeCheck == true
@@ -178,7 +211,7 @@ func (rs *RecordStore) doEviction() bool {
 
 func (rs *RecordStore) DoExpiration() {
 	// port of: com.hazelcast.internal.nearcache.impl.store.BaseHeapNearCacheRecordStore#doExpiration
-	now := time.Now().UnixMilli()
+	now := rs.nowMillis()
 	rs.recordsMu.Lock()
 	for k, v := range rs.records {
 		if rs.recordExpired(v, now) {
@@ -202,7 +235,7 @@ func (rs *RecordStore) onEvict(key interface{}, rec *Record, expired bool) {
 
 func (rs *RecordStore) tryEvict(candidate evictionCandidate) bool {
 	// port of: com.hazelcast.internal.nearcache.impl.store.HeapNearCacheRecordMap#tryEvict
-	if exists := rs.remove(candidate.Key()); !exists {
+	if exists := rs.remove(candidate.key); !exists {
 		return false
 	}
 	rs.onEvict(candidate.key, candidate.evictable, false)
@@ -251,6 +284,9 @@ func (rs *RecordStore) TryPublishReserved(key, value interface{}, reservationID
 	if ok {
 		rec, err := rs.publishReservedRecord(key, value, existing, reservationID)
 		if err != nil {
+			// the value cannot be stored, remove the reserved record so it does not linger in the Near Cache.
+			rs.removeReservedRecord(key, existing, reservationID)
+			rs.incrementStoreFailures()
 			return nil, err
 		}
 		existing = rec
@@ -267,6 +303,11 @@ func (rs *RecordStore) TryPublishReserved(key, value interface{}, reservationID
 }
 
 func (rs *RecordStore) Stats() nearcache.Stats {
+	rs.persistenceMu.Lock()
+	lastTime := rs.stats.LastPersistenceTime
+	lastDuration := rs.stats.LastPersistenceDuration
+	lastFailure := rs.stats.LastPersistenceFailure
+	rs.persistenceMu.Unlock()
 	return nearcache.Stats{
 		CreationTime:                rs.stats.CreationTime,
 		OwnedEntryCount:             atomic.LoadInt64(&rs.stats.OwnedEntryCount),
@@ -276,13 +317,99 @@ func (rs *RecordStore) Stats() nearcache.Stats {
 		Evictions:                   atomic.LoadInt64(&rs.stats.Evictions),
 		Expirations:                 atomic.LoadInt64(&rs.stats.Expirations),
 		Invalidations:               atomic.LoadInt64(&rs.stats.Invalidations),
+		InvalidationRequests:        atomic.LoadInt64(&rs.stats.InvalidationRequests),
 		PersistenceCount:            atomic.LoadInt64(&rs.stats.PersistenceCount),
 		LastPersistenceWrittenBytes: atomic.LoadInt64(&rs.stats.LastPersistenceWrittenBytes),
 		LastPersistenceKeyCount:     atomic.LoadInt64(&rs.stats.LastPersistenceKeyCount),
-		LastPersistenceTime:         time.Time{},
-		LastPersistenceDuration:     0,
-		LastPersistenceFailure:      "",
+		LastPersistenceTime:         lastTime,
+		LastPersistenceDuration:     lastDuration,
+		LastPersistenceFailure:      lastFailure,
+		StoreFailures:               atomic.LoadInt64(&rs.stats.StoreFailures),
+	}
+}
+
+// Dump returns the metadata of the entries in the store.
+// The entries which are reserved for an update but do not have a value yet are skipped.
+func (rs *RecordStore) Dump() ([]nearcache.RecordInfo, error) {
+	type keyRecord struct {
+		key interface{}
+		rec *Record
+	}
+	rs.recordsMu.RLock()
+	krs := make([]keyRecord, 0, len(rs.records))
+	for k, rec := range rs.records {
+		if rec.ReservationID() != RecordReadPermitted {
+			continue
+		}
+		krs = append(krs, keyRecord{key: rs.unMakeMapKey(k), rec: rec})
 	}
+	rs.recordsMu.RUnlock()
+	infos := make([]nearcache.RecordInfo, len(krs))
+	for i, kr := range krs {
+		key := kr.key
+		if data, ok := key.(serialization.Data); ok {
+			var err error
+			if key, err = rs.ss.ToObject(data); err != nil {
+				return nil, err
+			}
+		}
+		infos[i] = nearcache.RecordInfo{
+			Key:            key,
+			CreationTime:   millisToTime(kr.rec.CreationTime()),
+			LastAccessTime: millisToTime(kr.rec.LastAccessTime()),
+			ExpirationTime: millisToTime(kr.rec.ExpirationTime()),
+			Hits:           kr.rec.Hits(),
+			PartitionID:    kr.rec.PartitionID(),
+			CachedAsNil:    kr.rec.CachedAsNil(),
+		}
+	}
+	return infos, nil
+}
+
+// KeyDatas returns the serialized keys of the entries in the store.
+// The entries which are reserved for an update but do not have a value yet are skipped.
+func (rs *RecordStore) KeyDatas() ([]serialization.Data, error) {
+	rs.recordsMu.RLock()
+	keys := make([]interface{}, 0, len(rs.records))
+	for k, rec := range rs.records {
+		if rec.ReservationID() != RecordReadPermitted {
+			continue
+		}
+		keys = append(keys, rs.unMakeMapKey(k))
+	}
+	rs.recordsMu.RUnlock()
+	datas := make([]serialization.Data, len(keys))
+	for i, key := range keys {
+		data, err := rs.ss.ToData(key)
+		if err != nil {
+			return nil, err
+		}
+		datas[i] = data
+	}
+	return datas, nil
+}
+
+func (rs *RecordStore) addPersistence(start time.Time, duration time.Duration, writtenBytes, keyCount int64) {
+	// port of: com.hazelcast.internal.monitor.impl.NearCacheStatsImpl#addPersistence
+	rs.persistenceMu.Lock()
+	rs.stats.LastPersistenceTime = start
+	rs.stats.LastPersistenceDuration = duration
+	rs.stats.LastPersistenceFailure = ""
+	rs.persistenceMu.Unlock()
+	atomic.StoreInt64(&rs.stats.LastPersistenceWrittenBytes, writtenBytes)
+	atomic.StoreInt64(&rs.stats.LastPersistenceKeyCount, keyCount)
+	atomic.AddInt64(&rs.stats.PersistenceCount, 1)
+}
+
+func (rs *RecordStore) addPersistenceFailure(err error) {
+	// port of: com.hazelcast.internal.monitor.impl.NearCacheStatsImpl#addPersistenceFailure
+	rs.persistenceMu.Lock()
+	rs.stats.LastPersistenceTime = time.Time{}
+	rs.stats.LastPersistenceDuration = 0
+	rs.stats.LastPersistenceFailure = err.Error()
+	rs.persistenceMu.Unlock()
+	atomic.StoreInt64(&rs.stats.LastPersistenceWrittenBytes, 0)
+	atomic.StoreInt64(&rs.stats.LastPersistenceKeyCount, 0)
 }
 
 func (rs *RecordStore) InvalidationRequests() int64 {
@@ -326,15 +453,25 @@ func (rs *RecordStore) publishReservedRecord(key, value interface{}, rec *Record
 	if rec.ReservationID() != reservationID {
 		return rec, nil
 	}
+	// converting the value before updating the stats, so they stay consistent if the conversion fails.
+	converted, err := rs.valueConverter.ConvertValue(value)
+	if err != nil {
+		return nil, err
+	}
 	update := rec.Value() != nil || rec.CachedAsNil()
 	if update {
 		rs.decrementOwnedEntryMemoryCost(rs.getTotalStorageMemoryCost(key, rec))
 	}
-	if err := rs.updateRecordValue(rec, value); err != nil {
-		return nil, err
-	}
+	rec.SetValue(converted)
 	if value == nil {
 		rec.SetCachedAsNil()
+		rs.setNilExpirationTime(rec)
+	} else if rec.CachedAsNil() {
+		// the key was cached as not found before, so the record may have the expiration time of a nil record.
+		rec.UnsetCachedAsNil()
+		now := rs.nowMillis()
+		rec.SetCreationTime(now)
+		rec.SetExpirationTIme(rs.expirationTime(now))
 	}
 	rec.SetReservationID(RecordReadPermitted)
 	rs.incrementOwnedEntryMemoryCost(rs.getTotalStorageMemoryCost(key, rec))
@@ -344,6 +481,19 @@ func (rs *RecordStore) publishReservedRecord(key, value interface{}, rec *Record
 	return rec, nil
 }
 
+func (rs *RecordStore) removeReservedRecord(key interface{}, rec *Record, reservationID int64) {
+	// assumes rs.recordsMu is locked.
+	if rec.ReservationID() != reservationID {
+		return
+	}
+	delete(rs.records, key)
+	if rec.Value() != nil || rec.CachedAsNil() {
+		// the record was reserved for a write update after it was published, so it was counted.
+		rs.decrementOwnedEntryCount()
+		rs.decrementOwnedEntryMemoryCost(rs.getTotalStorageMemoryCost(key, rec))
+	}
+}
+
 func (rs *RecordStore) getKeyStorageMemoryCost(key interface{}) int64 {
 	keyData, ok := key.(serialization.Data)
 	if !ok {
@@ -399,6 +549,10 @@ func (rs *RecordStore) incrementInvalidationRequests() {
 	atomic.AddInt64(&rs.stats.InvalidationRequests, 1)
 }
 
+func (rs *RecordStore) incrementStoreFailures() {
+	atomic.AddInt64(&rs.stats.StoreFailures, 1)
+}
+
 func (rs *RecordStore) incrementEvictions() {
 	atomic.AddInt64(&rs.stats.Evictions, 1)
 }
@@ -440,17 +594,8 @@ func (rs *RecordStore) canUpdateStats(rec *Record) bool {
 
 func (rs *RecordStore) TryReserveForUpdate(key interface{}, keyData serialization.Data, ups UpdateSemantic) (int64, error) {
 	// checkAvailable()
-	// if there is no eviction configured we return if the Near Cache is full and it's a new key.
-	// we have to check the key, otherwise we might lose updates on existing keys.
-	if rs.evictionDisabled {
-		rs.recordsMu.RLock()
-		evictionRequired := len(rs.records) >= rs.maxSize
-		_, containsRecordKey := rs.records[key]
-		rs.recordsMu.RUnlock()
-		if evictionRequired && !containsRecordKey {
-			return RecordNotReserved, nil
-		}
-	}
+	// if there is no eviction configured or the hard limit is reached, a new key is not reserved if the Near Cache is full.
+	// that is checked while reserving, see full.
 	rid := rs.nextReservationID()
 	var rec *Record
 	var err error
@@ -460,6 +605,7 @@ func (rs *RecordStore) TryReserveForUpdate(key interface{}, keyData serializatio
 		rec, err = rs.reserveForReadUpdate(key, keyData, rid)
 	}
 	if err != nil {
+		rs.incrementStoreFailures()
 		return 0, err
 	}
 	if rec == nil || rec.ReservationID() != rid {
@@ -468,22 +614,40 @@ func (rs *RecordStore) TryReserveForUpdate(key interface{}, keyData serializatio
 	return rid, nil
 }
 
+// full returns true if a new key must not be added to the Near Cache.
+// Existing keys can still be updated, otherwise updates could be lost.
+// assumes rs.recordsMu is locked.
+func (rs *RecordStore) full() bool {
+	n := len(rs.records)
+	if rs.maxEntryCount > 0 && n >= rs.maxEntryCount {
+		return true
+	}
+	// if there is no eviction configured, the eviction size is the limit.
+	return rs.evictionDisabled && n >= rs.maxSize
+}
+
 func (rs *RecordStore) nextReservationID() int64 {
 	return atomic.AddInt64(&rs.reservationID, 1)
 }
 
 func (rs *RecordStore) reserveForWriteUpdate(key interface{}, keyData serialization.Data, reservationID int64) (*Record, error) {
+	// port of: com.hazelcast.internal.nearcache.impl.store.AbstractNearCacheRecordStore#reserveForWriteUpdate
+	key = rs.makeMapKey(key)
 	rs.recordsMu.Lock()
 	defer rs.recordsMu.Unlock()
 	rec, ok := rs.records[key]
 	if !ok {
+		if rs.full() {
+			return nil, nil
+		}
 		rec, err := rs.newReservationRecord(key, keyData, reservationID)
 		if err != nil {
 			return nil, err
 		}
+		rs.records[key] = rec
 		return rec, nil
 	}
-	if rec.reservationID == RecordReadPermitted {
+	if rec.ReservationID() == RecordReadPermitted {
 		rec.SetReservationID(reservationID)
 		return rec, nil
 	}
@@ -501,6 +665,7 @@ func (rs *RecordStore) reserveForWriteUpdate(key interface{}, keyData serializat
 	// to near-cache if the source UUID of the invalidation
 	// is same with the end's UUID which has near-cache on
 	// it (client or server UUID which has near cache on it).
+	rs.removeReservedRecord(key, rec, rec.ReservationID())
 	return nil, nil
 }
 
@@ -512,6 +677,9 @@ func (rs *RecordStore) reserveForReadUpdate(key interface{}, keyData serializati
 	if ok {
 		return rec, nil
 	}
+	if rs.full() {
+		return nil, nil
+	}
 	rec, err := rs.newReservationRecord(key, keyData, reservationID)
 	if err != nil {
 		return nil, err
@@ -526,12 +694,28 @@ func (rs *RecordStore) createRecord(value interface{}) (*Record, error) {
 	if err != nil {
 		return nil, err
 	}
-	created := time.Now().UnixMilli()
-	expired := RecordStoreTimeNotSet
+	created := rs.nowMillis()
+	return NewRecord(value, created, rs.expirationTime(created)), nil
+}
+
+// expirationTime returns the expiration time of a record created at the given time.
+func (rs *RecordStore) expirationTime(createdMS int64) int64 {
 	if rs.timeToLiveMillis > 0 {
-		expired = created + rs.timeToLiveMillis
+		return createdMS + rs.timeToLiveMillis
 	}
-	return NewRecord(value, created, expired), nil
+	return RecordStoreTimeNotSet
+}
+
+// setNilExpirationTime shortens the expiration time of a record which caches that the key was not found.
+func (rs *RecordStore) setNilExpirationTime(rec *Record) {
+	if rs.nilTimeToLiveMillis <= 0 {
+		return
+	}
+	expired := rs.nowMillis() + rs.nilTimeToLiveMillis
+	if current := rec.ExpirationTime(); current > 0 && current <= expired {
+		return
+	}
+	rec.SetExpirationTIme(expired)
 }
 
 func (rs *RecordStore) newReservationRecord(key interface{}, keyData serialization.Data, rid int64) (*Record, error) {
@@ -568,15 +752,6 @@ func (rs *RecordStore) initInvalidationMetadata(rec *Record, key interface{}, ke
 	return nil
 }
 
-func (rs *RecordStore) updateRecordValue(rec *Record, value interface{}) error {
-	value, err := rs.valueConverter.ConvertValue(value)
-	if err != nil {
-		return err
-	}
-	rec.SetValue(value)
-	return nil
-}
-
 func (rs *RecordStore) onExpire() {
 	// port of: com.hazelcast.internal.nearcache.impl.store.AbstractNearCacheRecordStore#onExpire
 	rs.incrementExpirations()
@@ -607,6 +782,10 @@ type evictionCandidate struct {
 }
 
 func (e evictionCandidate) Key() interface{} {
+	// serialized keys are stored as DataString, but the comparator should not see that internal type.
+	if ds, ok := e.key.(DataString); ok {
+		return serialization.Data(ds)
+	}
 	return e.key
 }
 
@@ -240,6 +240,29 @@ func (rt *ReparingTask) runAntiEntropy(ctx context.Context) {
 	atomic.StoreInt64(&rt.lastAntiEntropyRunNanos, time.Now().UnixNano())
 }
 
+// Reconcile fetches the latest invalidation metadata of the Near Cache with the given name and removes its stale records immediately,
+// instead of waiting for the periodic anti-entropy run.
+// Does nothing if there is no handler registered with the given name.
+// Returns the number of removed records.
+func (rt *ReparingTask) Reconcile(ctx context.Context, name string) (int, error) {
+	v, ok := rt.handlers.Load(name)
+	if !ok {
+		return 0, nil
+	}
+	handler := v.(*RepairingHandler)
+	handlers := map[string]*RepairingHandler{name: handler}
+	if err := rt.invalidationMetaDataFetcher.repairMetadata(ctx, handlers); err != nil {
+		return 0, err
+	}
+	return rt.removeStaleRecords(handler), nil
+}
+
+// removeStaleRecords marks the records with missed invalidations stale regardless of the max tolerated miss count, and removes them.
+func (rt *ReparingTask) removeStaleRecords(handler *RepairingHandler) int {
+	rt.updateLastKnownStaleSequences(handler)
+	return handler.nc.RemoveStaleRecords()
+}
+
 // RepairingHandler is the port of: com.hazelcast.internal.nearcache.impl.invalidation.RepairingHandler
 type RepairingHandler struct {
 	name               string
@@ -544,6 +567,32 @@ func (df InvalidationMetaDataFetcher) fetchMetadata(ctx context.Context, handler
 	}
 }
 
+// repairMetadata is similar to fetchMetadata, but it returns the first error instead of logging it.
+func (df InvalidationMetaDataFetcher) repairMetadata(ctx context.Context, handlers map[string]*RepairingHandler) error {
+	names := make([]string, 0, len(handlers))
+	for _, h := range handlers {
+		names = append(names, h.Name())
+	}
+	mems := filterDataMembers(df.cs.OrderedMembers())
+	invs := make([]invocation.Invocation, 0, len(mems))
+	for _, mem := range mems {
+		inv, err := df.fetchMetaDataOf(ctx, mem, names)
+		if err != nil {
+			return fmt.Errorf("fetching invalidation metadata from member %s: %w", mem.UUID, err)
+		}
+		invs = append(invs, inv)
+	}
+	for _, inv := range invs {
+		npsPairs, psPairs, err := df.extractMemberMetadata(ctx, inv)
+		if err != nil {
+			return fmt.Errorf("extracting invalidation metadata: %w", err)
+		}
+		df.repairUUIDs(psPairs, handlers)
+		df.repairSequences(npsPairs, handlers)
+	}
+	return nil
+}
+
 func (df InvalidationMetaDataFetcher) fetchMembersMetadataFor(ctx context.Context, names []string) map[types.UUID]invocation.Invocation {
 	// port of: com.hazelcast.internal.nearcache.impl.invalidation.InvalidationMetaDataFetcher#fetchMembersMetadataFor
 	mems := filterDataMembers(df.cs.OrderedMembers())
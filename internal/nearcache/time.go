@@ -16,7 +16,10 @@
 
 package nearcache
 
-import "math"
+import (
+	"math"
+	"time"
+)
 
 const (
 	timeUnset = -1
@@ -48,6 +51,20 @@ func RecomputeWithBaseTime(seconds int32) int64 {
 	return EpochTimeMillis + int64(seconds)*1000
 }
 
+// millisToTime converts the given time in milliseconds to time.Time.
+// Returns the zero time if the time is not set or it is the maximum time.
+func millisToTime(ms int64) time.Time {
+	if ms <= 0 || ms == math.MaxInt64 {
+		return time.Time{}
+	}
+	return time.UnixMilli(ms)
+}
+
 func zeroOutMs(ms int64) int64 {
 	return (ms / 1000) * 1000
 }
+
+// currentTimeMillis returns the current time in milliseconds.
+func currentTimeMillis() int64 {
+	return time.Now().UnixMilli()
+}
@@ -614,6 +614,16 @@ func DecodeListMultiFrameForData(frameIterator *proto.ForwardFrameIterator) []is
 	return result
 }
 
+func DecodeListMultiFrameContainsNullableForData(frameIterator *proto.ForwardFrameIterator) []iserialization.Data {
+	result := make([]iserialization.Data, 0)
+	frameIterator.Next()
+	for !CodecUtil.NextFrameIsDataStructureEndFrame(frameIterator) {
+		result = append(result, DecodeNullableForData(frameIterator))
+	}
+	frameIterator.Next()
+	return result
+}
+
 func DecodeListMultiFrameWithListInteger(frameIterator *proto.ForwardFrameIterator) [][]int32 {
 	var result [][]int32
 	DecodeListMultiFrame(frameIterator, func(fi *proto.ForwardFrameIterator) {
@@ -17,6 +17,7 @@
 package codec
 
 import (
+	"database/sql/driver"
 	"encoding/binary"
 	"testing"
 
@@ -26,6 +27,7 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	"github.com/hazelcast/hazelcast-go-client/internal/proto"
+	"github.com/hazelcast/hazelcast-go-client/sql"
 )
 
 func TestCodecUtil_FastForwardToEndFrame(t *testing.T) {
@@ -656,3 +658,76 @@ func TestStringCodec_Encode(t *testing.T) {
 	content := clientMessage.Frames[len(clientMessage.Frames)-1].Content
 	assert.Equal(t, value, string(content))
 }
+
+func TestDecodeSQLColumn_Nulls(t *testing.T) {
+	// mixed INT column with 10 items, the items at 1 and 9 are NULL.
+	mixed := make([]byte, cnHeaderSize+2*proto.ByteSizeInBytes+8*proto.IntSizeInBytes)
+	FixSizedTypesCodec.EncodeByte(mixed, 0, cnFixedSizeTypeMixed)
+	FixSizedTypesCodec.EncodeInt(mixed, 1, 10)
+	pos := cnHeaderSize
+	FixSizedTypesCodec.EncodeByte(mixed, pos, 0xFD)
+	pos++
+	for _, v := range []int32{0, 2, 3, 4, 5, 6, 7} {
+		FixSizedTypesCodec.EncodeInt(mixed, pos, v)
+		pos += proto.IntSizeInBytes
+	}
+	FixSizedTypesCodec.EncodeByte(mixed, pos, 0x01)
+	pos++
+	FixSizedTypesCodec.EncodeInt(mixed, pos, 8)
+	// BIGINT column with only NULL items.
+	allNull := make([]byte, cnHeaderSize)
+	FixSizedTypesCodec.EncodeByte(allNull, 0, cnFixedSizeTypeNull)
+	FixSizedTypesCodec.EncodeInt(allNull, 1, 3)
+	// NULL column
+	nullCol := make([]byte, proto.IntSizeInBytes)
+	FixSizedTypesCodec.EncodeInt(nullCol, 0, 2)
+	msg := proto.NewClientMessageForEncode()
+	msg.AddFrame(proto.NewFrame(mixed))
+	msg.AddFrame(proto.NewFrame(allNull))
+	msg.AddFrame(proto.NewFrame(nullCol))
+	// VARCHAR column, the item at 1 is NULL.
+	msg.AddFrame(proto.NewBeginFrame())
+	EncodeString(msg, "")
+	msg.AddFrame(proto.NullFrame.Copy())
+	EncodeString(msg, "a")
+	msg.AddFrame(proto.NewEndFrame())
+	it := msg.FrameIterator()
+	tcs := []struct {
+		target []driver.Value
+		ct     sql.ColumnType
+	}{
+		{ct: sql.ColumnTypeInt, target: []driver.Value{int32(0), nil, int32(2), int32(3), int32(4), int32(5), int32(6), int32(7), int32(8), nil}},
+		{ct: sql.ColumnTypeBigInt, target: []driver.Value{nil, nil, nil}},
+		{ct: sql.ColumnTypeNull, target: []driver.Value{nil, nil}},
+		{ct: sql.ColumnTypeVarchar, target: []driver.Value{"", nil, "a"}},
+	}
+	for _, tc := range tcs {
+		col, err := DecodeSQLColumn(tc.ct, it)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, tc.target, col)
+	}
+}
+
+func TestPagingPredicateHolderCodec(t *testing.T) {
+	holder := PagingPredicateHolder{
+		AnchorDataListHolder: AnchorDataListHolder{
+			AnchorPageList: []int32{0, 1},
+			AnchorDataList: []proto.Pair{
+				proto.NewPair(iserialization.Data("key-0"), iserialization.Data("value-0")),
+				proto.NewPair(iserialization.Data("key-1"), iserialization.Data("value-1")),
+			},
+		},
+		PredicateData:   iserialization.Data("predicate"),
+		PageSize:        10,
+		Page:            2,
+		IterationTypeId: IterationTypeEntry,
+	}
+	msg := proto.NewClientMessageForEncode()
+	EncodePagingPredicateHolder(msg, holder)
+	EncodeString(msg, "next")
+	it := msg.FrameIterator()
+	assert.Equal(t, holder, DecodePagingPredicateHolder(it))
+	assert.Equal(t, "next", DecodeString(it))
+}
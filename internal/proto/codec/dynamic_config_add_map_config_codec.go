@@ -0,0 +1,90 @@
+/*
+ * Copyright (c) 2008-2023, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package codec
+
+import (
+	"github.com/hazelcast/hazelcast-go-client/internal/proto"
+)
+
+const (
+	// hex: 0x1B0C00
+	DynamicConfigAddMapConfigCodecRequestMessageType = int32(1772544)
+	// hex: 0x1B0C01
+	DynamicConfigAddMapConfigCodecResponseMessageType = int32(1772545)
+
+	DynamicConfigAddMapConfigCodecRequestBackupCountOffset          = proto.PartitionIDOffset + proto.IntSizeInBytes
+	DynamicConfigAddMapConfigCodecRequestAsyncBackupCountOffset     = DynamicConfigAddMapConfigCodecRequestBackupCountOffset + proto.IntSizeInBytes
+	DynamicConfigAddMapConfigCodecRequestTimeToLiveSecondsOffset    = DynamicConfigAddMapConfigCodecRequestAsyncBackupCountOffset + proto.IntSizeInBytes
+	DynamicConfigAddMapConfigCodecRequestMaxIdleSecondsOffset       = DynamicConfigAddMapConfigCodecRequestTimeToLiveSecondsOffset + proto.IntSizeInBytes
+	DynamicConfigAddMapConfigCodecRequestReadBackupDataOffset       = DynamicConfigAddMapConfigCodecRequestMaxIdleSecondsOffset + proto.IntSizeInBytes
+	DynamicConfigAddMapConfigCodecRequestMergeBatchSizeOffset       = DynamicConfigAddMapConfigCodecRequestReadBackupDataOffset + proto.BooleanSizeInBytes
+	DynamicConfigAddMapConfigCodecRequestStatisticsEnabledOffset    = DynamicConfigAddMapConfigCodecRequestMergeBatchSizeOffset + proto.IntSizeInBytes
+	DynamicConfigAddMapConfigCodecRequestMetadataPolicyOffset       = DynamicConfigAddMapConfigCodecRequestStatisticsEnabledOffset + proto.BooleanSizeInBytes
+	DynamicConfigAddMapConfigCodecRequestPerEntryStatsEnabledOffset = DynamicConfigAddMapConfigCodecRequestMetadataPolicyOffset + proto.IntSizeInBytes
+	DynamicConfigAddMapConfigCodecRequestInitialFrameSize           = DynamicConfigAddMapConfigCodecRequestPerEntryStatsEnabledOffset + proto.BooleanSizeInBytes
+)
+
+// Adds a new map configuration to a running cluster.
+// If a map configuration with the given {@code name} already exists, then
+// the new configuration is ignored and the existing one is preserved.
+// The nested configurations which are not supported by the client (eviction, listeners, map store, Near Cache,
+// WAN replication, indexes, attributes, query caches, partitioning strategy, hot restart, event journal and merkle tree)
+// are sent as null, so the member defaults are used for them.
+// perEntryStatsEnabled is sent in the initial frame.
+// The parameters which were added after it in later protocol versions, such as the data persistence and tiered store configs, are not sent, so the member defaults are used for them as well.
+
+func EncodeDynamicConfigAddMapConfigRequest(name string, backupCount int32, asyncBackupCount int32, timeToLiveSeconds int32, maxIdleSeconds int32, readBackupData bool, cacheDeserializedValues string, mergePolicy string, mergeBatchSize int32, inMemoryFormat string, statisticsEnabled bool, metadataPolicy int32, perEntryStatsEnabled bool) *proto.ClientMessage {
+	clientMessage := proto.NewClientMessageForEncode()
+	clientMessage.SetRetryable(false)
+
+	initialFrame := proto.NewFrameWith(make([]byte, DynamicConfigAddMapConfigCodecRequestInitialFrameSize), proto.UnfragmentedMessage)
+	FixSizedTypesCodec.EncodeInt(initialFrame.Content, DynamicConfigAddMapConfigCodecRequestBackupCountOffset, backupCount)
+	FixSizedTypesCodec.EncodeInt(initialFrame.Content, DynamicConfigAddMapConfigCodecRequestAsyncBackupCountOffset, asyncBackupCount)
+	FixSizedTypesCodec.EncodeInt(initialFrame.Content, DynamicConfigAddMapConfigCodecRequestTimeToLiveSecondsOffset, timeToLiveSeconds)
+	FixSizedTypesCodec.EncodeInt(initialFrame.Content, DynamicConfigAddMapConfigCodecRequestMaxIdleSecondsOffset, maxIdleSeconds)
+	FixSizedTypesCodec.EncodeBoolean(initialFrame.Content, DynamicConfigAddMapConfigCodecRequestReadBackupDataOffset, readBackupData)
+	FixSizedTypesCodec.EncodeInt(initialFrame.Content, DynamicConfigAddMapConfigCodecRequestMergeBatchSizeOffset, mergeBatchSize)
+	FixSizedTypesCodec.EncodeBoolean(initialFrame.Content, DynamicConfigAddMapConfigCodecRequestStatisticsEnabledOffset, statisticsEnabled)
+	FixSizedTypesCodec.EncodeInt(initialFrame.Content, DynamicConfigAddMapConfigCodecRequestMetadataPolicyOffset, metadataPolicy)
+	FixSizedTypesCodec.EncodeBoolean(initialFrame.Content, DynamicConfigAddMapConfigCodecRequestPerEntryStatsEnabledOffset, perEntryStatsEnabled)
+	clientMessage.AddFrame(initialFrame)
+	clientMessage.SetMessageType(DynamicConfigAddMapConfigCodecRequestMessageType)
+	clientMessage.SetPartitionId(-1)
+
+	EncodeString(clientMessage, name)
+	// evictionConfig
+	clientMessage.AddFrame(proto.NullFrame.Copy())
+	EncodeString(clientMessage, cacheDeserializedValues)
+	EncodeString(clientMessage, mergePolicy)
+	EncodeString(clientMessage, inMemoryFormat)
+	// listenerConfigs, partitionLostListenerConfigs
+	clientMessage.AddFrame(proto.NullFrame.Copy())
+	clientMessage.AddFrame(proto.NullFrame.Copy())
+	// splitBrainProtectionName
+	clientMessage.AddFrame(proto.NullFrame.Copy())
+	// mapStoreConfig, nearCacheConfig, wanReplicationRef, indexConfigs, attributeConfigs, queryCacheConfigs
+	for i := 0; i < 6; i++ {
+		clientMessage.AddFrame(proto.NullFrame.Copy())
+	}
+	// partitioningStrategyClassName
+	clientMessage.AddFrame(proto.NullFrame.Copy())
+	// partitioningStrategyImplementation, hotRestartConfig, eventJournalConfig, merkleTreeConfig
+	for i := 0; i < 4; i++ {
+		clientMessage.AddFrame(proto.NullFrame.Copy())
+	}
+
+	return clientMessage
+}
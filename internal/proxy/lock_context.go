@@ -33,6 +33,11 @@ func NewLockContext(ctx context.Context) context.Context {
 	return context.WithValue(ctx, lockIDKey{}, lockID(lockIDGen.NextID()))
 }
 
+// WithLockID returns a context which carries the given lock ID.
+func WithLockID(ctx context.Context, lid int64) context.Context {
+	return context.WithValue(ctx, lockIDKey{}, lockID(lid))
+}
+
 // ExtractLockID extracts lock ID from the context.
 // If the lock ID is not found, it returns the default lock ID.
 func ExtractLockID(ctx context.Context) int64 {
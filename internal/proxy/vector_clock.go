@@ -63,17 +63,15 @@ func (vc VectorClock) Size() int {
 // After returns true if this vector clock is causally strictly after the provided vector clock.
 // This means that it the provided clock is neither equal to, greater than or concurrent to this vector clock.
 func (vc VectorClock) After(other VectorClock) bool {
+	atLeastOneBigger := false
 	for id, ts := range other {
-		if localTS, ok := vc[id]; ok {
-			if localTS < ts {
-				break
-			}
-			if localTS > ts {
-				return true
-			}
-			continue
+		localTS, ok := vc[id]
+		if !ok || localTS < ts {
+			return false
+		}
+		if localTS > ts {
+			atLeastOneBigger = true
 		}
-		return false
 	}
-	return len(other) < len(vc)
+	return atLeastOneBigger || len(other) < len(vc)
 }
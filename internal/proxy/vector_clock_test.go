@@ -0,0 +1,51 @@
+/*
+ * Copyright (c) 2008-2023, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package proxy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/hazelcast/hazelcast-go-client/types"
+)
+
+func TestVectorClock_After(t *testing.T) {
+	r1 := types.NewUUID()
+	r2 := types.NewUUID()
+	r3 := types.NewUUID()
+	testCases := []struct {
+		name  string
+		vc    VectorClock
+		other VectorClock
+		after bool
+	}{
+		{name: "empty", vc: VectorClock{}, other: VectorClock{}, after: false},
+		{name: "equal", vc: VectorClock{r1: 1, r2: 2}, other: VectorClock{r1: 1, r2: 2}, after: false},
+		{name: "one bigger", vc: VectorClock{r1: 2, r2: 2}, other: VectorClock{r1: 1, r2: 2}, after: true},
+		{name: "one smaller", vc: VectorClock{r1: 1, r2: 1}, other: VectorClock{r1: 1, r2: 2}, after: false},
+		{name: "concurrent", vc: VectorClock{r1: 2, r2: 1}, other: VectorClock{r1: 1, r2: 2}, after: false},
+		{name: "more replicas", vc: VectorClock{r1: 1, r2: 2, r3: 1}, other: VectorClock{r1: 1, r2: 2}, after: true},
+		{name: "fewer replicas", vc: VectorClock{r1: 5}, other: VectorClock{r1: 1, r2: 2}, after: false},
+		{name: "different replicas", vc: VectorClock{r1: 5, r3: 1}, other: VectorClock{r1: 1, r2: 2}, after: false},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.after, tc.vc.After(tc.other))
+		})
+	}
+}
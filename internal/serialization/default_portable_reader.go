@@ -29,6 +29,7 @@ import (
 type DefaultPortableReader struct {
 	serializer      *PortableSerializer
 	input           serialization.DataInput
+	rawInput        serialization.DataInput
 	classDefinition *serialization.ClassDefinition
 	offset          int32
 	finalPos        int32
@@ -341,10 +342,17 @@ func (pr *DefaultPortableReader) GetRawDataInput() serialization.DataInput {
 		off := pr.offset + int32(len(pr.classDefinition.Fields))*Int32SizeInBytes
 		pr.input.SetPosition(off)
 		pos := pr.input.ReadInt32()
-		pr.input.SetPosition(pos)
 		pr.raw = true
+		if pos == 0 {
+			// the raw data position is not set if GetRawDataOutput was not called during writing.
+			// reading from an empty input fails, instead of returning the bytes of the Portable header.
+			pr.rawInput = NewObjectDataInput(nil, 0, nil, false)
+			return pr.rawInput
+		}
+		pr.input.SetPosition(pos)
+		pr.rawInput = pr.input
 	}
-	return pr.input
+	return pr.rawInput
 }
 
 func (pr *DefaultPortableReader) ReadDate(fieldName string) (t *types.LocalDate) {
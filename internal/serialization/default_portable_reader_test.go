@@ -17,6 +17,7 @@
 package serialization
 
 import (
+	"errors"
 	"fmt"
 	"reflect"
 	"strings"
@@ -24,6 +25,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 
+	"github.com/hazelcast/hazelcast-go-client/hzerrors"
 	"github.com/hazelcast/hazelcast-go-client/serialization"
 )
 
@@ -607,3 +609,115 @@ func TestNewPortableSerializer_RawData(t *testing.T) {
 	}
 	assert.Equal(t, expected, actual)
 }
+
+type mixedPortable struct {
+	nested  *student
+	rawObj  interface{}
+	name    string
+	rawStr  string
+	rawArr  []int32
+	rawLong int64
+	id      int32
+	// skipRaw disables writing the raw data
+	skipRaw bool
+}
+
+func (*mixedPortable) FactoryID() int32 {
+	return 2
+}
+
+func (*mixedPortable) ClassID() int32 {
+	return 10
+}
+
+func (m *mixedPortable) WritePortable(writer serialization.PortableWriter) {
+	writer.WriteInt32("id", m.id)
+	writer.WritePortable("nested", m.nested)
+	writer.WriteString("name", m.name)
+	if m.skipRaw {
+		return
+	}
+	out := writer.GetRawDataOutput()
+	out.WriteInt64(m.rawLong)
+	out.WriteString(m.rawStr)
+	out.WriteObject(m.rawObj)
+	out.WriteInt32Array(m.rawArr)
+}
+
+func (m *mixedPortable) ReadPortable(reader serialization.PortableReader) {
+	// named fields are read in a different order than they were written.
+	m.name = reader.ReadString("name")
+	m.id = reader.ReadInt32("id")
+	if v := reader.ReadPortable("nested"); v != nil {
+		m.nested = v.(*student)
+	}
+	in := reader.GetRawDataInput()
+	m.rawLong = in.ReadInt64()
+	m.rawStr = in.ReadString()
+	m.rawObj = in.ReadObject()
+	m.rawArr = in.ReadInt32Array()
+}
+
+type mixedPortableFactory struct{}
+
+func (mixedPortableFactory) Create(classID int32) serialization.Portable {
+	switch classID {
+	case 1:
+		return &student{}
+	case 10:
+		return &mixedPortable{}
+	}
+	return nil
+}
+
+func (mixedPortableFactory) FactoryID() int32 {
+	return 2
+}
+
+func TestPortableSerializer_NamedAndRawData(t *testing.T) {
+	config := &serialization.Config{}
+	config.SetPortableFactories(&mixedPortableFactory{})
+	service, err := NewService(config, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := &mixedPortable{
+		id:      1,
+		name:    "named",
+		nested:  &student{id: 2, age: 3, name: "nested"},
+		rawLong: 42,
+		rawStr:  "raw",
+		rawObj:  &student{id: 4, age: 5, name: "raw nested"},
+		rawArr:  []int32{6, 7, 8},
+	}
+	// the mixed portable is also written as a nested field, so reading it must end at the correct position.
+	values := []interface{}{expected, []interface{}{expected, "after"}}
+	for _, value := range values {
+		data, err := service.ToData(value)
+		if err != nil {
+			t.Fatal(err)
+		}
+		actual, err := service.ToObject(data)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, value, actual)
+	}
+}
+
+func TestPortableSerializer_ReadRawDataWhenNotWritten(t *testing.T) {
+	config := &serialization.Config{}
+	config.SetPortableFactories(&mixedPortableFactory{})
+	service, err := NewService(config, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := service.ToData(&mixedPortable{id: 1, name: "named", nested: &student{}, skipRaw: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = service.ToObject(data)
+	if !errors.Is(err, hzerrors.ErrEOF) {
+		t.Fatalf("expected EOF error, but got: %v", err)
+	}
+}
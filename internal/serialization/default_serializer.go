@@ -22,6 +22,7 @@ import (
 	"fmt"
 	"math/big"
 	"reflect"
+	"sort"
 	"time"
 
 	ihzerrors "github.com/hazelcast/hazelcast-go-client/internal/hzerrors"
@@ -503,6 +504,7 @@ func (JavaArrayListSerializer) Read(input serialization.DataInput) interface{} {
 
 func (JavaArrayListSerializer) Write(o serialization.DataOutput, i interface{}) {
 	v := i.([]interface{})
+	defer enterContainer(o, v)()
 	length := len(v)
 	o.WriteInt32(int32(length))
 	for j := 0; j < length; j++ {
@@ -510,6 +512,108 @@ func (JavaArrayListSerializer) Write(o serialization.DataOutput, i interface{})
 	}
 }
 
+type JavaHashMapSerializer struct{}
+
+func (JavaHashMapSerializer) ID() int32 {
+	return TypeJavaHashMap
+}
+
+// Read returns a map[string]interface{} if all keys are strings, and a map[interface{}]interface{} otherwise.
+func (JavaHashMapSerializer) Read(input serialization.DataInput) interface{} {
+	count := int(input.ReadInt32())
+	keys := make([]interface{}, count)
+	values := make([]interface{}, count)
+	stringKeys := true
+	for i := 0; i < count; i++ {
+		keys[i] = input.ReadObject()
+		values[i] = input.ReadObject()
+		if _, ok := keys[i].(string); !ok {
+			stringKeys = false
+		}
+	}
+	if stringKeys {
+		res := make(map[string]interface{}, count)
+		for i, k := range keys {
+			res[k.(string)] = values[i]
+		}
+		return res
+	}
+	res := make(map[interface{}]interface{}, count)
+	for i, k := range keys {
+		res[k] = values[i]
+	}
+	return res
+}
+
+// Write writes the entries of a map[string]interface{} ordered by key, so equal maps are serialized the same.
+// The keys of a map[interface{}]interface{} may have different types, so its entries are ordered by the type and the formatted value of the key.
+func (JavaHashMapSerializer) Write(o serialization.DataOutput, i interface{}) {
+	defer enterContainer(o, i)()
+	switch v := i.(type) {
+	case map[string]interface{}:
+		keys := make([]string, 0, len(v))
+		for k := range v {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		o.WriteInt32(int32(len(v)))
+		for _, k := range keys {
+			o.WriteObject(k)
+			o.WriteObject(v[k])
+		}
+	case map[interface{}]interface{}:
+		keys := make([]orderedKey, 0, len(v))
+		for k := range v {
+			keys = append(keys, orderedKey{key: k, order: fmt.Sprintf("%T:%v", k, k)})
+		}
+		sort.Slice(keys, func(i, j int) bool {
+			return keys[i].order < keys[j].order
+		})
+		o.WriteInt32(int32(len(v)))
+		for _, k := range keys {
+			o.WriteObject(k.key)
+			o.WriteObject(v[k.key])
+		}
+	}
+}
+
+// orderedKey is a key of a map[interface{}]interface{} with the string it is ordered by.
+type orderedKey struct {
+	key   interface{}
+	order string
+}
+
+// containerKey identifies a slice or map.
+// The length is included, so that a slice and its prefix sharing the same array are not mixed up.
+type containerKey struct {
+	ptr uintptr
+	len int
+}
+
+type containerTracker interface {
+	enterContainer(k containerKey) bool
+	leaveContainer(k containerKey)
+}
+
+// enterContainer panics if the given slice or map is already being written to o, since it references itself.
+// It returns the function which must be called after the container is written.
+func enterContainer(o serialization.DataOutput, container interface{}) func() {
+	ct, ok := o.(containerTracker)
+	if !ok {
+		return func() {}
+	}
+	v := reflect.ValueOf(container)
+	if v.Len() == 0 {
+		// an empty container cannot reference itself.
+		return func() {}
+	}
+	k := containerKey{ptr: v.Pointer(), len: v.Len()}
+	if !ct.enterContainer(k) {
+		panic(ihzerrors.NewSerializationError(fmt.Sprintf("cyclic reference in %T", container), nil))
+	}
+	return func() { ct.leaveContainer(k) }
+}
+
 type JavaLocalDateSerializer struct{}
 
 func (JavaLocalDateSerializer) ID() int32 {
@@ -112,3 +112,67 @@ func TestIdentifiedDataSerializableSerializer_NoInstanceCreated(t *testing.T) {
 		t.Fatalf("should fail as HazelcastSerializationError")
 	}
 }
+
+const departmentClassID = int32(1)
+const departmentFactoryID = int32(5)
+
+type department struct {
+	name string
+}
+
+func (*department) FactoryID() int32 {
+	return departmentFactoryID
+}
+
+func (*department) ClassID() int32 {
+	return departmentClassID
+}
+
+func (d *department) ReadData(input serialization.DataInput) {
+	d.name = input.ReadString()
+}
+
+func (d *department) WriteData(output serialization.DataOutput) {
+	output.WriteString(d.name)
+}
+
+type departmentFactory struct{}
+
+func (departmentFactory) Create(classID int32) serialization.IdentifiedDataSerializable {
+	if classID == departmentClassID {
+		return &department{}
+	}
+	return nil
+}
+
+func (departmentFactory) FactoryID() int32 {
+	return departmentFactoryID
+}
+
+func TestIdentifiedDataSerializableSerializer_MultipleFactories(t *testing.T) {
+	c := &serialization.Config{}
+	c.AddIdentifiedDataSerializableFactory(&factory{})
+	c.AddIdentifiedDataSerializableFactory(&departmentFactory{})
+	if err := c.Validate(); err != nil {
+		t.Fatal(err)
+	}
+	service, err := iserialization.NewService(c, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	values := []interface{}{
+		&employee{age: 38, name: "Jack"},
+		&department{name: "Engineering"},
+	}
+	for _, v := range values {
+		data, err := service.ToData(v)
+		if err != nil {
+			t.Fatal(err)
+		}
+		ret, err := service.ToObject(data)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, v, ret)
+	}
+}
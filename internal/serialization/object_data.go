@@ -43,10 +43,12 @@ const (
 )
 
 type ObjectDataOutput struct {
-	bo       binary.ByteOrder
-	service  *Service
-	buffer   []byte
-	position int32
+	bo      binary.ByteOrder
+	service *Service
+	// containers holds the slices and maps which are being written, in order to detect cycles.
+	containers map[containerKey]struct{}
+	buffer     []byte
+	position   int32
 }
 
 func NewObjectDataOutput(length int, service *Service, bigEndian bool) *ObjectDataOutput {
@@ -183,6 +185,23 @@ func (o *ObjectDataOutput) WriteObject(object interface{}) {
 	o.service.WriteObject(o, object)
 }
 
+// enterContainer records the slice or map with the given key as being written.
+// It returns false if the container is already being written, i.e., it references itself.
+func (o *ObjectDataOutput) enterContainer(p containerKey) bool {
+	if o.containers == nil {
+		o.containers = map[containerKey]struct{}{}
+	}
+	if _, ok := o.containers[p]; ok {
+		return false
+	}
+	o.containers[p] = struct{}{}
+	return true
+}
+
+func (o *ObjectDataOutput) leaveContainer(p containerKey) {
+	delete(o.containers, p)
+}
+
 func (o *ObjectDataOutput) WriteInt8Array(signedByteArray []int8) {
 	if signedByteArray == nil {
 		o.WriteInt32(nilArrayLength)
@@ -513,12 +532,17 @@ func (i *ObjectDataInput) ReadObject() interface{} {
 	return i.service.ReadObject(i)
 }
 
+// ReadByteArray reads a byte array.
+// The returned slice is a copy, so modifying it does not affect the underlying buffer.
+// Java byte arrays are read as their two's complement representation, e.g., -1 is read as 255.
 func (i *ObjectDataInput) ReadByteArray() []byte {
 	length := i.readInt32()
 	if length == nilArrayLength {
 		return nil
 	}
-	arr := i.buffer[i.position : i.position+length]
+	i.AssertAvailable(int(length))
+	arr := make([]byte, length)
+	copy(arr, i.buffer[i.position:i.position+length])
 	i.position += length
 	return arr
 }
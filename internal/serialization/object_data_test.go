@@ -299,6 +299,44 @@ func TestObjectDataInput_ReadByteArray(t *testing.T) {
 	}
 }
 
+func TestObjectDataInput_ReadByteArrayAllValues(t *testing.T) {
+	array := make([]byte, 256)
+	for i := range array {
+		array[i] = byte(i)
+	}
+	o := NewObjectDataOutput(0, nil, false)
+	o.WriteByteArray(array)
+	for i := 0; i < 256; i++ {
+		o.WriteByte(byte(i))
+	}
+	i := NewObjectDataInput(o.buffer, 0, nil, false)
+	assert.Equal(t, array, i.ReadByteArray())
+	for j := 0; j < 256; j++ {
+		assert.Equal(t, byte(j), i.ReadByte())
+	}
+}
+
+func TestObjectDataInput_ReadByteArrayDoesNotShareBuffer(t *testing.T) {
+	o := NewObjectDataOutput(0, nil, false)
+	o.WriteByteArray([]byte{1, 2, 3})
+	buf := o.ToBuffer()
+	i := NewObjectDataInput(buf, 0, nil, false)
+	arr := i.ReadByteArray()
+	arr[0] = 255
+	i = NewObjectDataInput(buf, 0, nil, false)
+	assert.Equal(t, []byte{1, 2, 3}, i.ReadByteArray())
+}
+
+func TestObjectDataInput_ReadByteArrayTruncated(t *testing.T) {
+	o := NewObjectDataOutput(0, nil, false)
+	o.WriteByteArray([]byte{1, 2, 3})
+	buf := o.ToBuffer()
+	i := NewObjectDataInput(buf[:len(buf)-1], 0, nil, false)
+	assert.Panics(t, func() {
+		i.ReadByteArray()
+	})
+}
+
 func TestObjectDataInput_ReadBoolArray(t *testing.T) {
 	var array = []bool{true, false, true, true, false, false, false, true}
 	o := NewObjectDataOutput(0, nil, false)
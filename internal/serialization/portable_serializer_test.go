@@ -180,6 +180,59 @@ func TestPortableSerializerDuplicateFactory(t *testing.T) {
 	}
 }
 
+type courseFactory struct{}
+
+func (courseFactory) Create(classID int32) serialization.Portable {
+	if classID == 1 {
+		return &course{}
+	}
+	return nil
+}
+
+func (courseFactory) FactoryID() int32 {
+	return 3
+}
+
+type course struct {
+	title string
+}
+
+func (*course) FactoryID() int32 {
+	return 3
+}
+
+func (*course) ClassID() int32 {
+	return 1
+}
+
+func (c *course) WritePortable(writer serialization.PortableWriter) {
+	writer.WriteString("title", c.title)
+}
+
+func (c *course) ReadPortable(reader serialization.PortableReader) {
+	c.title = reader.ReadString("title")
+}
+
+func TestPortableSerializer_MultipleFactories(t *testing.T) {
+	config := &serialization.Config{}
+	config.AddPortableFactory(&portableFactory1{})
+	config.AddPortableFactory(&courseFactory{})
+	require.NoError(t, config.Validate())
+	service, err := NewService(config, nil)
+	require.NoError(t, err)
+	values := []interface{}{
+		&student{id: 10, age: 22, name: "Furkan Şenharputlu"},
+		&course{title: "Distributed Systems"},
+	}
+	for _, v := range values {
+		data, err := service.ToData(v)
+		require.NoError(t, err)
+		ret, err := service.ToObject(data)
+		require.NoError(t, err)
+		assert.Equal(t, v, ret)
+	}
+}
+
 func TestPortableSerializer_NoInstanceCreated(t *testing.T) {
 	config := &serialization.Config{}
 	config.SetPortableFactories(&portableFactory1{})
@@ -62,8 +62,13 @@ func (s *SchemaService) Get(ctx context.Context, schemaId int64) (schema *Schema
 	schema, ok = s.schemaMap[schemaId]
 	s.mu.RUnlock()
 	if !ok {
-		rch := make(chan *Schema)
-		s.ch <- SchemaMsg{ID: schemaId, ResponseCh: rch}
+		// the response channel is buffered, so the fetcher does not block if the context is done before the schema is received
+		rch := make(chan *Schema, 1)
+		select {
+		case s.ch <- SchemaMsg{ID: schemaId, ResponseCh: rch}:
+		case <-ctx.Done():
+			return nil, false
+		}
 		select {
 		case schema = <-rch:
 			ok = schema != nil
@@ -0,0 +1,61 @@
+/*
+ * Copyright (c) 2008-2023, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package serialization_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/hazelcast/hazelcast-go-client/internal/serialization"
+	pubserialization "github.com/hazelcast/hazelcast-go-client/serialization"
+)
+
+func TestSchemaService_GetFetchesUnknownSchema(t *testing.T) {
+	ch := make(chan serialization.SchemaMsg)
+	ss, err := serialization.NewSchemaService(pubserialization.CompactConfig{}, ch)
+	if err != nil {
+		t.Fatal(err)
+	}
+	schema := serialization.NewSchema("foo", nil)
+	go func() {
+		msg := <-ch
+		assert.Equal(t, schema.ID(), msg.ID)
+		msg.ResponseCh <- schema
+	}()
+	s, ok := ss.Get(context.Background(), schema.ID())
+	assert.True(t, ok)
+	assert.Equal(t, schema, s)
+	// the fetched schema is cached, so it is not fetched again
+	s, ok = ss.Get(context.Background(), schema.ID())
+	assert.True(t, ok)
+	assert.Equal(t, schema, s)
+}
+
+func TestSchemaService_GetReturnsWhenContextIsDone(t *testing.T) {
+	// nothing receives from the channel, so fetching the schema never starts
+	ss, err := serialization.NewSchemaService(pubserialization.CompactConfig{}, make(chan serialization.SchemaMsg))
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, ok := ss.Get(ctx, 42)
+	assert.False(t, ok)
+}
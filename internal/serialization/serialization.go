@@ -177,10 +177,24 @@ func (s *Service) FindSerializerFor(obj interface{}) (pubserialization.Serialize
 	if serializer := s.lookUpGlobalSerializer(); serializer != (pubserialization.Serializer)(nil) {
 		return serializer, nil
 	}
+	// maps were serialized with the gob serializer before java.util.HashMap was supported.
+	// so the HashMap serializer is used only in place of the gob serializer, custom and global serializers still take precedence.
+	switch obj.(type) {
+	case map[string]interface{}, map[interface{}]interface{}:
+		return javaHashMapSerializer, nil
+	}
 	// keeping the error in the result for future behavior change
 	return gobSerializer, nil
 }
 
+// HasSerializer returns true if obj is serialized with a built-in, compact, identified data serializable, portable, custom or global serializer.
+// It returns false if obj can be serialized only with the gob serializer fallback.
+func (s *Service) HasSerializer(obj interface{}) bool {
+	return s.LookUpDefaultSerializer(obj) != (pubserialization.Serializer)(nil) ||
+		s.lookUpCustomSerializer(obj) != (pubserialization.Serializer)(nil) ||
+		s.lookUpGlobalSerializer() != (pubserialization.Serializer)(nil)
+}
+
 func (s *Service) LookUpDefaultSerializer(obj interface{}) pubserialization.Serializer {
 	serializer := s.lookupBuiltinSerializer(obj)
 	if serializer != (pubserialization.Serializer)(nil) {
@@ -388,6 +402,7 @@ var javaClassSerializer = &JavaClassSerializer{}
 var javaArraySerializer = &JavaArraySerializer{}
 var javaArrayListSerializer = &JavaArrayListSerializer{}
 var javaLinkedListSerializer = &JavaLinkedListSerializer{}
+var javaHashMapSerializer = &JavaHashMapSerializer{}
 var javaLocalDateSerializer = &JavaLocalDateSerializer{}
 var javaLocalTimeSerializer = &JavaLocalTimeSerializer{}
 var javaLocalDateTimeSerializer = &JavaLocalDateTimeSerializer{}
@@ -423,6 +438,7 @@ func init() {
 		TypeJavaArray:          javaArraySerializer,
 		TypeJavaArrayList:      javaArrayListSerializer,
 		TypeJavaLinkedList:     javaLinkedListSerializer,
+		TypeJavaHashMap:        javaHashMapSerializer,
 		TypeJavaLocalDate:      javaLocalDateSerializer,
 		TypeJavaLocalTime:      javaLocalTimeSerializer,
 		TypeJavaLocalDateTime:  javaLocalDateTimeSerializer,
@@ -46,6 +46,7 @@ const (
 	TypeJavaArray          = -28
 	TypeJavaArrayList      = -29
 	TypeJavaLinkedList     = -30
+	TypeJavaHashMap        = -32
 	TypeJavaLocalDate      = -51
 	TypeJavaLocalTime      = -52
 	TypeJavaLocalDateTime  = -53
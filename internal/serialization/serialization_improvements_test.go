@@ -63,6 +63,45 @@ func TestSerializationImprovements_4_UUID(t *testing.T) {
 	assert.Equal(t, target, value)
 }
 
+func TestSerializationImprovements_4_UUIDRoundTrip(t *testing.T) {
+	config := &serialization.Config{}
+	config.SetGlobalSerializer(&PanicingGlobalSerializer{})
+	ss := mustSerializationService(iserialization.NewService(config, nil))
+	targets := []types.UUID{
+		{},
+		types.NewUUIDWith(0, 1),
+		types.NewUUIDWith(math.MaxUint64, 0),
+	}
+	for i := 0; i < 100; i++ {
+		targets = append(targets, types.NewUUID())
+	}
+	for _, target := range targets {
+		data := mustData(ss.ToData(target))
+		assert.Equal(t, int32(iserialization.TypeUUID), data.Type())
+		// 8 bytes of header and 16 bytes of payload.
+		assert.Equal(t, 24, len(data))
+		value := it.MustValue(ss.ToObject(data))
+		assert.Equal(t, target, value)
+	}
+}
+
+func TestSerializationImprovements_4_UUIDJavaInterop(t *testing.T) {
+	ss := mustSerializationService(iserialization.NewService(&serialization.Config{}, nil))
+	// the member serializes UUID.fromString("12345678-9abc-def0-fedc-ba9876543210") as:
+	javaData := []byte{
+		0x00, 0x00, 0x00, 0x00, // partition hash
+		0xff, 0xff, 0xff, 0xeb, // type ID: -21
+		0x12, 0x34, 0x56, 0x78, 0x9a, 0xbc, 0xde, 0xf0, // most significant bits
+		0xfe, 0xdc, 0xba, 0x98, 0x76, 0x54, 0x32, 0x10, // least significant bits
+	}
+	target := types.NewUUIDWith(0x123456789abcdef0, 0xfedcba9876543210)
+	assert.Equal(t, "12345678-9abc-def0-fedc-ba9876543210", target.String())
+	data := mustData(ss.ToData(target))
+	assert.Equal(t, javaData, data.ToByteArray())
+	value := it.MustValue(ss.ToObject(iserialization.Data(javaData)))
+	assert.Equal(t, target, value)
+}
+
 func TestSerializationImprovements_JavaDate(t *testing.T) {
 	config := &serialization.Config{}
 	config.SetGlobalSerializer(&PanicingGlobalSerializer{})
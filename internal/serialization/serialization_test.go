@@ -19,6 +19,7 @@ package serialization_test
 import (
 	"bytes"
 	"encoding/gob"
+	"fmt"
 	"math/big"
 	"reflect"
 	"testing"
@@ -328,6 +329,138 @@ func TestUndefinedDataDeserialization(t *testing.T) {
 	require.Errorf(t, err, "err should not be nil")
 }
 
+func TestSerializationService_ByteValuesRoundTrip(t *testing.T) {
+	service := mustSerializationService(iserialization.NewService(&serialization.Config{}, nil))
+	array := make([]byte, 256)
+	for i := range array {
+		array[i] = byte(i)
+		data := mustData(service.ToData(byte(i)))
+		assert.Equal(t, byte(i), it.MustValue(service.ToObject(data)))
+		// int8 values are serialized as Java bytes, which are read back as byte
+		data = mustData(service.ToData(int8(i)))
+		assert.Equal(t, byte(i), it.MustValue(service.ToObject(data)))
+	}
+	data := mustData(service.ToData(array))
+	assert.Equal(t, array, it.MustValue(service.ToObject(data)))
+}
+
+func TestSerializationService_NestedCollectionsRoundTrip(t *testing.T) {
+	service := mustSerializationService(iserialization.NewService(&serialization.Config{}, nil))
+	testCases := []struct {
+		name  string
+		value interface{}
+	}{
+		{name: "empty string map", value: map[string]interface{}{}},
+		{name: "string map", value: map[string]interface{}{
+			"bool":   true,
+			"int64":  int64(42),
+			"string": "foo",
+			"nil":    nil,
+			"slice":  []interface{}{int32(1), "two", []interface{}{3.0}},
+			"map":    map[string]interface{}{"inner": []string{"a", "b"}},
+		}},
+		{name: "interface map", value: map[interface{}]interface{}{
+			int32(1): "one",
+			"two":    map[string]interface{}{"n": int64(2)},
+		}},
+		{name: "slice", value: []interface{}{
+			map[string]interface{}{"k": int16(1)},
+			[]interface{}{},
+			types.NewUUIDWith(1, 2),
+		}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			data := mustData(service.ToData(tc.value))
+			assert.Equal(t, tc.value, it.MustValue(service.ToObject(data)))
+		})
+	}
+}
+
+func TestSerializationService_NestedCollectionsStableOrder(t *testing.T) {
+	service := mustSerializationService(iserialization.NewService(&serialization.Config{}, nil))
+	m := map[string]interface{}{}
+	for i := 0; i < 100; i++ {
+		m[fmt.Sprintf("key-%d", i)] = int64(i)
+	}
+	data := mustData(service.ToData(m))
+	for i := 0; i < 10; i++ {
+		assert.Equal(t, data, mustData(service.ToData(m)))
+	}
+}
+
+func TestSerializationService_NestedCollectionsInterfaceMapStableOrder(t *testing.T) {
+	service := mustSerializationService(iserialization.NewService(&serialization.Config{}, nil))
+	m := map[interface{}]interface{}{}
+	for i := 0; i < 50; i++ {
+		m[int32(i)] = int64(i)
+		m[fmt.Sprintf("key-%d", i)] = int64(i)
+	}
+	data := mustData(service.ToData(m))
+	for i := 0; i < 10; i++ {
+		assert.Equal(t, data, mustData(service.ToData(m)))
+	}
+}
+
+func TestSerializationService_NestedCollectionsWithGlobalSerializer(t *testing.T) {
+	// maps used the gob serializer before java.util.HashMap was supported, so a global serializer takes precedence.
+	config := &serialization.Config{}
+	config.SetGlobalSerializer(&GlobalSerializer{})
+	service := mustSerializationService(iserialization.NewService(config, nil))
+	for _, v := range []interface{}{map[string]interface{}{"k": "v"}, map[interface{}]interface{}{"k": "v"}} {
+		data := mustData(service.ToData(v))
+		assert.Equal(t, int32(123), data.Type())
+	}
+	// slices are serialized as java.util.ArrayList regardless of the global serializer.
+	data := mustData(service.ToData([]interface{}{"v"}))
+	assert.Equal(t, int32(iserialization.TypeJavaArrayList), data.Type())
+}
+
+func TestSerializationService_NestedCollectionsErrors(t *testing.T) {
+	service := mustSerializationService(iserialization.NewService(&serialization.Config{}, nil))
+	selfSlice := []interface{}{"a", nil}
+	selfSlice[1] = selfSlice
+	selfMap := map[string]interface{}{}
+	selfMap["self"] = []interface{}{selfMap}
+	testCases := []struct {
+		name  string
+		value interface{}
+	}{
+		{name: "self-referencing slice", value: selfSlice},
+		{name: "self-referencing map", value: selfMap},
+		{name: "unsupported leaf", value: map[string]interface{}{"ch": []interface{}{make(chan int)}}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := service.ToData(tc.value)
+			assert.Error(t, err)
+		})
+	}
+	// the same slice may occur more than once, as long as it does not reference itself.
+	shared := []interface{}{"shared"}
+	v := []interface{}{shared, shared}
+	data := mustData(service.ToData(v))
+	assert.Equal(t, v, it.MustValue(service.ToObject(data)))
+}
+
+func TestSerializationService_JavaByteArray(t *testing.T) {
+	// Serialized form of new byte[]{-128, -1, 0, 1, 127} produced by the Java member:
+	// partition hash, type ID (-12, byte array), length, and the bytes in two's complement.
+	javaData := iserialization.Data{
+		0, 0, 0, 0,
+		0xff, 0xff, 0xff, 0xf4,
+		0, 0, 0, 5,
+		0x80, 0xff, 0x00, 0x01, 0x7f,
+	}
+	service := mustSerializationService(iserialization.NewService(&serialization.Config{}, nil))
+	assert.Equal(t, []byte{128, 255, 0, 1, 127}, it.MustValue(service.ToObject(javaData)))
+	assert.Equal(t, javaData, mustData(service.ToData([]byte{128, 255, 0, 1, 127})))
+	// Java byte -1 is read as 255, or as -1 when converted to int8.
+	javaByte := iserialization.Data{0, 0, 0, 0, 0xff, 0xff, 0xff, 0xfd, 0xff}
+	assert.Equal(t, byte(255), it.MustValue(service.ToObject(javaByte)))
+	assert.Equal(t, javaByte, mustData(service.ToData(int8(-1))))
+}
+
 func mustData(value interface{}, err error) iserialization.Data {
 	if err != nil {
 		panic(err)
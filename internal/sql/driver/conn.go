@@ -40,6 +40,10 @@ func (c *Conn) CheckNamedValue(v *driver.NamedValue) error {
 	if check.Nil(v.Value) {
 		return ihzerrors.NewIllegalArgumentError("nil arg is not allowed", nil)
 	}
+	if err := checkParamType(c.ic.SerializationService, CoerceParam(v.Value)); err != nil {
+		msg := fmt.Sprintf("unsupported type %T for the argument at position %d", v.Value, v.Ordinal)
+		return ihzerrors.NewIllegalArgumentError(msg, err)
+	}
 	return nil
 }
 
@@ -18,20 +18,29 @@ package driver_test
 
 import (
 	"context"
+	"database/sql"
 	"database/sql/driver"
 	"errors"
+	"fmt"
+	"io"
 	"math"
 	"math/big"
+	"reflect"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
 
 	"github.com/hazelcast/hazelcast-go-client/cluster"
+	"github.com/hazelcast/hazelcast-go-client/hzerrors"
+	"github.com/hazelcast/hazelcast-go-client/internal/client"
 	"github.com/hazelcast/hazelcast-go-client/internal/it/skip"
+	iserialization "github.com/hazelcast/hazelcast-go-client/internal/serialization"
 	idriver "github.com/hazelcast/hazelcast-go-client/internal/sql/driver"
+	itype "github.com/hazelcast/hazelcast-go-client/internal/sql/types"
 	"github.com/hazelcast/hazelcast-go-client/logger"
 	"github.com/hazelcast/hazelcast-go-client/serialization"
+	pubsql "github.com/hazelcast/hazelcast-go-client/sql"
 	pubdriver "github.com/hazelcast/hazelcast-go-client/sql/driver"
 	"github.com/hazelcast/hazelcast-go-client/types"
 )
@@ -49,6 +58,72 @@ func TestArgNotNil(t *testing.T) {
 	assert.Equal(t, "nil arg is not allowed: illegal argument error", err.Error())
 }
 
+func TestCheckNamedValue(t *testing.T) {
+	type registered struct{ Value int64 }
+	type unregistered struct{ Value int64 }
+	now := time.Now()
+	ld := types.LocalDate(now)
+	dec := types.NewDecimal(big.NewInt(1234), 2)
+	sc := &serialization.Config{}
+	if err := sc.SetCustomSerializer(reflect.TypeOf(registered{}), &registeredSerializer{}); err != nil {
+		t.Fatal(err)
+	}
+	ss, err := iserialization.NewService(sc, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	conn := idriver.NewConnWithClient(&client.Client{SerializationService: ss})
+	supported := []interface{}{
+		types.LocalDate(now), &ld, types.LocalTime(now), types.LocalDateTime(now), types.OffsetDateTime(now),
+		dec, &dec, big.NewInt(42), now, uint16(42), int64(42), "foo", registered{Value: 42},
+	}
+	for _, v := range supported {
+		t.Run(fmt.Sprintf("%T", v), func(t *testing.T) {
+			assert.NoError(t, conn.CheckNamedValue(&driver.NamedValue{Ordinal: 1, Value: v}))
+		})
+	}
+	unsupported := []interface{}{uint32(42), uint64(42), uint(42), complex(1, 2), make(chan int), func() {}, unregistered{Value: 42}}
+	for _, v := range unsupported {
+		t.Run(fmt.Sprintf("%T", v), func(t *testing.T) {
+			err := conn.CheckNamedValue(&driver.NamedValue{Ordinal: 3, Value: v})
+			if !assert.Error(t, err) {
+				return
+			}
+			assert.True(t, errors.Is(err, hzerrors.ErrIllegalArgument))
+			assert.Contains(t, err.Error(), fmt.Sprintf("unsupported type %T for the argument at position 3", v))
+		})
+	}
+}
+
+func TestSQLService_RejectsUnsupportedParams(t *testing.T) {
+	ss, err := iserialization.NewService(&serialization.Config{}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the parameters are checked before a connection is required, so the service does not need one.
+	svc := idriver.NewSQLService(nil, ss, nil, nil)
+	_, err = svc.ExecuteSQL(context.Background(), "INSERT INTO m VALUES(?, ?)", []driver.Value{int64(42), uint32(42)})
+	if !assert.Error(t, err) {
+		return
+	}
+	assert.True(t, errors.Is(err, hzerrors.ErrIllegalArgument))
+	assert.Contains(t, err.Error(), "unsupported type uint32 for the argument at position 2")
+}
+
+type registeredSerializer struct{}
+
+func (s registeredSerializer) ID() int32 {
+	return 1000
+}
+
+func (s registeredSerializer) Read(input serialization.DataInput) interface{} {
+	return input.ReadInt64()
+}
+
+func (s registeredSerializer) Write(output serialization.DataOutput, object interface{}) {
+	output.WriteInt64(reflect.ValueOf(object).Field(0).Int())
+}
+
 func TestParseDSN(t *testing.T) {
 	testCases := []struct {
 		Err           error
@@ -453,3 +528,129 @@ func TestExtractSchema(t *testing.T) {
 		})
 	}
 }
+
+func TestCoerceParam(t *testing.T) {
+	now := time.Now()
+	bi := big.NewInt(1234)
+	dec := types.NewDecimal(big.NewInt(5678), 2)
+	var nilBigInt *big.Int
+	var nilTime *time.Time
+	var nilString *string
+	testCases := []struct {
+		name   string
+		value  interface{}
+		target interface{}
+	}{
+		{name: "nil", value: nil, target: nil},
+		{name: "nil *big.Int", value: nilBigInt, target: nil},
+		{name: "nil *time.Time", value: nilTime, target: nil},
+		{name: "nil *string", value: nilString, target: nil},
+		{name: "int", value: 42, target: int64(42)},
+		{name: "uint8", value: uint8(42), target: int16(42)},
+		{name: "uint16", value: uint16(42), target: int32(42)},
+		{name: "uint32", value: uint32(42), target: uint32(42)},
+		{name: "time.Time", value: now, target: types.OffsetDateTime(now)},
+		{name: "*time.Time", value: &now, target: types.OffsetDateTime(now)},
+		{name: "*big.Int", value: bi, target: types.NewDecimal(bi, 0)},
+		{name: "*types.Decimal", value: &dec, target: dec},
+		{name: "types.Decimal", value: dec, target: dec},
+		{name: "int32", value: int32(42), target: int32(42)},
+		{name: "string", value: "foo", target: "foo"},
+		{name: "types.LocalDate", value: types.LocalDate(now), target: types.LocalDate(now)},
+		{name: "*types.LocalDate", value: (*types.LocalDate)(&now), target: types.LocalDate(now)},
+		{name: "*types.LocalTime", value: (*types.LocalTime)(&now), target: types.LocalTime(now)},
+		{name: "*types.LocalDateTime", value: (*types.LocalDateTime)(&now), target: types.LocalDateTime(now)},
+		{name: "*types.OffsetDateTime", value: (*types.OffsetDateTime)(&now), target: types.OffsetDateTime(now)},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.target, idriver.CoerceParam(tc.value))
+		})
+	}
+}
+
+func TestQueryResult_ColumnTypes(t *testing.T) {
+	columns := []pubsql.ColumnMetadata{
+		itype.ColumnMetadata{ColumnName: "__key", ColumnType: pubsql.ColumnTypeBigInt},
+		itype.ColumnMetadata{ColumnName: "name", ColumnType: pubsql.ColumnTypeVarchar, IsNullable: true},
+		itype.ColumnMetadata{ColumnName: "price", ColumnType: pubsql.ColumnTypeDecimal, IsNullable: true},
+		itype.ColumnMetadata{ColumnName: "created", ColumnType: pubsql.ColumnTypeTimestampWithTimeZone},
+		itype.ColumnMetadata{ColumnName: "doc", ColumnType: pubsql.ColumnTypeJSON, IsNullable: true},
+		itype.ColumnMetadata{ColumnName: "this", ColumnType: pubsql.ColumnTypeObject},
+	}
+	qr, err := idriver.NewQueryResult(context.Background(), itype.QueryID{}, itype.NewRowMetadata(columns), itype.Page{Last: true}, nil, nil, 0, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	testCases := []struct {
+		scanType reflect.Type
+		typeName string
+		nullable bool
+	}{
+		{typeName: "BIGINT", scanType: reflect.TypeOf(int64(0))},
+		{typeName: "VARCHAR", scanType: reflect.TypeOf(""), nullable: true},
+		{typeName: "DECIMAL", scanType: reflect.TypeOf(types.Decimal{}), nullable: true},
+		{typeName: "TIMESTAMP WITH TIME ZONE", scanType: reflect.TypeOf(types.OffsetDateTime{})},
+		{typeName: "JSON", scanType: reflect.TypeOf(serialization.JSON{}), nullable: true},
+		{typeName: "OBJECT", scanType: reflect.TypeOf((*interface{})(nil)).Elem()},
+	}
+	for i, tc := range testCases {
+		t.Run(tc.typeName, func(t *testing.T) {
+			assert.Equal(t, tc.typeName, qr.ColumnTypeDatabaseTypeName(i))
+			assert.Equal(t, tc.scanType, qr.ColumnTypeScanType(i))
+			nullable, ok := qr.ColumnTypeNullable(i)
+			assert.True(t, ok)
+			assert.Equal(t, tc.nullable, nullable)
+		})
+	}
+}
+
+func TestQueryResult_NextNullValues(t *testing.T) {
+	date := types.LocalDate(time.Date(2023, 1, 2, 0, 0, 0, 0, time.Local))
+	page := itype.Page{
+		Columns: [][]driver.Value{
+			{int32(0), nil},
+			{float64(0), nil},
+			{"", nil},
+			{date, nil},
+		},
+		Last: true,
+	}
+	qr, err := idriver.NewQueryResult(context.Background(), itype.QueryID{}, itype.RowMetadata{}, page, nil, nil, 0, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	dest := make([]driver.Value, len(page.Columns))
+	// the first row has zero values, which must not be reported as NULL.
+	if err := qr.Next(dest); err != nil {
+		t.Fatal(err)
+	}
+	var i sql.NullInt32
+	var f sql.NullFloat64
+	var s sql.NullString
+	var d sql.NullTime
+	scanRow := func() {
+		t.Helper()
+		for k, sc := range []sql.Scanner{&i, &f, &s, &d} {
+			if err := sc.Scan(dest[k]); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+	scanRow()
+	assert.Equal(t, sql.NullInt32{Int32: 0, Valid: true}, i)
+	assert.Equal(t, sql.NullFloat64{Float64: 0, Valid: true}, f)
+	assert.Equal(t, sql.NullString{String: "", Valid: true}, s)
+	assert.Equal(t, sql.NullTime{Time: time.Time(date), Valid: true}, d)
+	// the second row has only NULL values.
+	if err := qr.Next(dest); err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, []driver.Value{nil, nil, nil, nil}, dest)
+	scanRow()
+	assert.False(t, i.Valid)
+	assert.False(t, f.Valid)
+	assert.False(t, s.Valid)
+	assert.False(t, d.Valid)
+	assert.Equal(t, io.EOF, qr.Next(dest))
+}
@@ -0,0 +1,90 @@
+/*
+ * Copyright (c) 2008-2023, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package driver
+
+import (
+	"errors"
+	"math/big"
+	"reflect"
+	"time"
+
+	"github.com/hazelcast/hazelcast-go-client/internal/check"
+	iserialization "github.com/hazelcast/hazelcast-go-client/internal/serialization"
+	"github.com/hazelcast/hazelcast-go-client/types"
+)
+
+/*
+CoerceParam converts Go native parameter values to the types which correspond to the SQL types the member expects.
+The converted value is serialized with the serialization service.
+
+  - nil and typed nil pointers: NULL
+  - int: int64 (BIGINT)
+  - uint8, uint16: int16, int32 respectively (SMALLINT, INTEGER)
+  - time.Time, *time.Time: types.OffsetDateTime (TIMESTAMP WITH TIME ZONE)
+  - *big.Int: types.Decimal with scale 0 (DECIMAL)
+  - *types.Decimal: types.Decimal (DECIMAL)
+  - *types.LocalDate, *types.LocalTime, *types.LocalDateTime, *types.OffsetDateTime: the corresponding value (DATE, TIME, TIMESTAMP, TIMESTAMP WITH TIME ZONE)
+
+Other values are returned as is.
+*/
+func CoerceParam(v interface{}) interface{} {
+	if check.Nil(v) {
+		return nil
+	}
+	switch vv := v.(type) {
+	case int:
+		return int64(vv)
+	case uint8:
+		return int16(vv)
+	case uint16:
+		return int32(vv)
+	case time.Time:
+		return types.OffsetDateTime(vv)
+	case *time.Time:
+		return types.OffsetDateTime(*vv)
+	case *big.Int:
+		return types.NewDecimal(vv, 0)
+	case *types.Decimal:
+		return *vv
+	case *types.LocalDate:
+		return *vv
+	case *types.LocalTime:
+		return *vv
+	case *types.LocalDateTime:
+		return *vv
+	case *types.OffsetDateTime:
+		return *vv
+	}
+	return v
+}
+
+// checkParamType returns an error if the given parameter has a type which cannot correspond to an SQL type.
+// Only the types which have a serializer other than the gob serializer fallback are allowed.
+// The parameter is assumed to be coerced with CoerceParam.
+func checkParamType(ss *iserialization.Service, v interface{}) error {
+	if v == nil {
+		return nil
+	}
+	switch reflect.TypeOf(v).Kind() {
+	case reflect.Uint, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return errors.New("unsigned integers larger than 16 bits are not supported, use int64 or *big.Int")
+	}
+	if !ss.HasSerializer(v) {
+		return errors.New("there is no serializer for the type")
+	}
+	return nil
+}
@@ -21,11 +21,15 @@ import (
 	"database/sql/driver"
 	"fmt"
 	"io"
+	"reflect"
 	"sync/atomic"
 
 	icluster "github.com/hazelcast/hazelcast-go-client/internal/cluster"
+	ihzerrors "github.com/hazelcast/hazelcast-go-client/internal/hzerrors"
 	itype "github.com/hazelcast/hazelcast-go-client/internal/sql/types"
+	"github.com/hazelcast/hazelcast-go-client/serialization"
 	"github.com/hazelcast/hazelcast-go-client/sql"
+	"github.com/hazelcast/hazelcast-go-client/types"
 )
 
 const (
@@ -33,11 +37,39 @@ const (
 	closed int32 = 1
 )
 
+// columnScanTypes maps column types to the types of the values returned by QueryResult.Next.
+// OBJECT and NULL columns are not included, their values may have any type.
+var columnScanTypes = map[sql.ColumnType]reflect.Type{
+	sql.ColumnTypeVarchar:               reflect.TypeOf(""),
+	sql.ColumnTypeBoolean:               reflect.TypeOf(false),
+	sql.ColumnTypeTinyInt:               reflect.TypeOf(int8(0)),
+	sql.ColumnTypeSmallInt:              reflect.TypeOf(int16(0)),
+	sql.ColumnTypeInt:                   reflect.TypeOf(int32(0)),
+	sql.ColumnTypeBigInt:                reflect.TypeOf(int64(0)),
+	sql.ColumnTypeDecimal:               reflect.TypeOf(types.Decimal{}),
+	sql.ColumnTypeReal:                  reflect.TypeOf(float32(0)),
+	sql.ColumnTypeDouble:                reflect.TypeOf(float64(0)),
+	sql.ColumnTypeDate:                  reflect.TypeOf(types.LocalDate{}),
+	sql.ColumnTypeTime:                  reflect.TypeOf(types.LocalTime{}),
+	sql.ColumnTypeTimestamp:             reflect.TypeOf(types.LocalDateTime{}),
+	sql.ColumnTypeTimestampWithTimeZone: reflect.TypeOf(types.OffsetDateTime{}),
+	sql.ColumnTypeJSON:                  reflect.TypeOf(serialization.JSON(nil)),
+}
+
+var interfaceType = reflect.TypeOf((*interface{})(nil)).Elem()
+
+var (
+	_ driver.RowsColumnTypeDatabaseTypeName = (*QueryResult)(nil)
+	_ driver.RowsColumnTypeScanType         = (*QueryResult)(nil)
+	_ driver.RowsColumnTypeNullable         = (*QueryResult)(nil)
+)
+
 // QueryResult contains the result of a query.
 // Rows are loaded in batches on demand.
 // QueryResult is not concurrency-safe, except for closing it.
 type QueryResult struct {
 	err              error
+	ctx              context.Context
 	page             itype.Page
 	ss               *SQLService
 	conn             *icluster.Connection
@@ -58,6 +90,7 @@ func (r *QueryResult) Metadata() sql.RowMetadata {
 func NewQueryResult(ctx context.Context, qid itype.QueryID, md itype.RowMetadata, page itype.Page, ss *SQLService, conn *icluster.Connection, cbs int32, infiniteRows bool) (*QueryResult, error) {
 	doneCh := make(chan struct{})
 	qr := &QueryResult{
+		ctx:              ctx,
 		queryID:          qid,
 		metadata:         md,
 		page:             page,
@@ -92,6 +125,29 @@ func (r *QueryResult) Columns() []string {
 	return names
 }
 
+// ColumnTypeDatabaseTypeName returns the SQL type name of the column at the given index, such as "VARCHAR" or "TIMESTAMP WITH TIME ZONE".
+// It implements database/sql/driver/RowsColumnTypeDatabaseTypeName interface.
+func (r *QueryResult) ColumnTypeDatabaseTypeName(index int) string {
+	return r.metadata.Columns()[index].Type().String()
+}
+
+// ColumnTypeScanType returns the type of the values of the column at the given index.
+// The values of OBJECT and NULL columns may have any type, so the empty interface type is returned for them.
+// It implements database/sql/driver/RowsColumnTypeScanType interface.
+func (r *QueryResult) ColumnTypeScanType(index int) reflect.Type {
+	if t, ok := columnScanTypes[r.metadata.Columns()[index].Type()]; ok {
+		return t
+	}
+	return interfaceType
+}
+
+// ColumnTypeNullable returns whether the column at the given index may contain NULL values.
+// The nullability is always known, so ok is always true.
+// It implements database/sql/driver/RowsColumnTypeNullable interface.
+func (r *QueryResult) ColumnTypeNullable(index int) (nullable, ok bool) {
+	return r.metadata.Columns()[index].Nullable(), true
+}
+
 func (r *QueryResult) Len() int {
 	return r.metadata.ColumnCount()
 }
@@ -109,9 +165,13 @@ func (r *QueryResult) Close() error {
 
 // Next requests the next batch of rows from the member.
 // If there are no rows left, it returns io.EOF
+// A NULL column value is set as nil in dest, so it can be scanned into pointer types and sql.Null* types.
+// Zero values are never reported as NULL.
 // This method is not concurrency-safe.
 // It implements database/sql/Rows interface.
 // InvocationTimeout field of hazelcast.Config is respected for timeout.
+// Closing the result or canceling the context used to run the query aborts an in-flight fetch and returns a cancellation error.
+// If the connection of the query is lost, the next fetch fails with an error which wraps hzerrors.ErrTargetDisconnected.
 func (r *QueryResult) Next(dest []driver.Value) error {
 	if len(r.page.Columns) == 0 {
 		r.close()
@@ -123,6 +183,12 @@ func (r *QueryResult) Next(dest []driver.Value) error {
 			r.close()
 			return io.EOF
 		}
+		if atomic.LoadInt32(&r.state) == closed {
+			if r.err != nil {
+				return fmt.Errorf("fetching the next page: %w", r.err)
+			}
+			return fmt.Errorf("fetching the next page: %w", r.cancelErr())
+		}
 		ctx, cancel := r.contextWithCancel()
 		defer cancel()
 		if err := r.fetchNextPage(ctx); err != nil {
@@ -145,6 +211,10 @@ func (r *QueryResult) Next(dest []driver.Value) error {
 func (r *QueryResult) closeQuery() error {
 	if atomic.CompareAndSwapInt32(&r.state, open, closed) {
 		close(r.doneCh)
+		if !r.conn.IsAlive() {
+			// the member releases the resources of the query when the connection is closed.
+			return nil
+		}
 		if err := r.ss.closeQuery(context.Background(), r.queryID, r.conn); err != nil {
 			return err
 		}
@@ -159,8 +229,18 @@ func (r *QueryResult) close() {
 }
 
 func (r *QueryResult) fetchNextPage(ctx context.Context) error {
+	if !r.conn.IsAlive() {
+		// fail fast instead of waiting for the invocation on the closed connection to time out.
+		return r.abortConnectionLost(nil)
+	}
 	page, err := r.ss.fetch(ctx, r.queryID, r.conn, r.cursorBufferSize)
 	if err != nil {
+		if ctx.Err() != nil {
+			// the fetch was aborted, since either the result was closed or the query context was canceled.
+			err = r.cancelErr()
+		} else if !r.conn.IsAlive() {
+			return r.abortConnectionLost(err)
+		}
 		return fmt.Errorf("fetching the next page: %w", err)
 	}
 	r.page = page
@@ -169,8 +249,24 @@ func (r *QueryResult) fetchNextPage(ctx context.Context) error {
 	return nil
 }
 
+// abortConnectionLost closes the result after its connection was lost.
+// The error is kept, so that subsequent calls to Next return it.
+func (r *QueryResult) abortConnectionLost(err error) error {
+	r.err = ihzerrors.NewTargetDisconnectedError("connection lost, query aborted", err)
+	r.close()
+	return fmt.Errorf("fetching the next page: %w", r.err)
+}
+
+// cancelErr returns the error of the query context if it was canceled, or context.Canceled if the result was closed.
+func (r *QueryResult) cancelErr() error {
+	if err := r.ctx.Err(); err != nil {
+		return err
+	}
+	return context.Canceled
+}
+
 func (r *QueryResult) contextWithCancel() (context.Context, context.CancelFunc) {
-	ctx, cancel := context.WithCancel(context.Background())
+	ctx, cancel := context.WithCancel(r.ctx)
 	go func() {
 		select {
 		case <-r.doneCh:
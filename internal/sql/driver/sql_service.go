@@ -151,9 +151,14 @@ func (s *SQLService) executeSQL(ctx context.Context, query string, resultType by
 func (s *SQLService) serializeParams(params []driver.Value) ([]iserialization.Data, error) {
 	serParams := make([]iserialization.Data, len(params))
 	for i, param := range params {
-		data, err := s.serializationService.ToData(param)
+		v := CoerceParam(param)
+		if err := checkParamType(s.serializationService, v); err != nil {
+			msg := fmt.Sprintf("unsupported type %T for the argument at position %d", param, i+1)
+			return nil, ihzerrors.NewIllegalArgumentError(msg, err)
+		}
+		data, err := s.serializationService.ToData(v)
 		if err != nil {
-			return nil, err
+			return nil, fmt.Errorf("serializing the argument at position %d of type %T: %w", i+1, param, err)
 		}
 		serParams[i] = data
 	}
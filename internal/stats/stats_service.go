@@ -198,6 +198,7 @@ func (s *Service) addGauges() {
 		newGaugeRuntime(s.logger, p),
 		newGaugeOS(s.logger, p),
 		newGaugeNearCache(serviceNameMap, f),
+		newGaugeInvocations(s.is),
 	}
 }
 
@@ -476,6 +477,24 @@ func (g gaugeNearCache) Update(btStats *binTextStats) {
 	}
 }
 
+type gaugeInvocations struct {
+	is      *invocation.Service
+	retries metricDescriptor
+}
+
+func newGaugeInvocations(is *invocation.Service) gaugeInvocations {
+	return gaugeInvocations{
+		is:      is,
+		retries: makeCountMD("invocations", "retries"),
+	}
+}
+
+func (g gaugeInvocations) Update(bt *binTextStats) {
+	retries := g.is.Retries()
+	bt.mc.AddLong(g.retries, retries)
+	bt.stats = append(bt.stats, makeTextStat(&g.retries, retries))
+}
+
 func makeBytesMD(prefix, metric string) metricDescriptor {
 	return metricDescriptor{
 		Prefix:  prefix,
@@ -535,9 +554,17 @@ func makeTextStat(md *metricDescriptor, value interface{}) stat {
 	return stat{k: md.String(), v: fmt.Sprintf("%v", value)}
 }
 
+// timeToMillis returns the Unix time of t in milliseconds, or 0 if t is not set.
+func timeToMillis(t time.Time) int64 {
+	if t.IsZero() {
+		return 0
+	}
+	return t.UnixMilli()
+}
+
 var ncMSMetrics = map[string]func(s nearcache.Stats) int64{
 	"creationTime":            func(s nearcache.Stats) int64 { return s.CreationTime.UnixMilli() },
-	"lastPersistenceTime":     func(s nearcache.Stats) int64 { return s.LastPersistenceTime.UnixMilli() },
+	"lastPersistenceTime":     func(s nearcache.Stats) int64 { return timeToMillis(s.LastPersistenceTime) },
 	"lastPersistenceDuration": func(s nearcache.Stats) int64 { return s.LastPersistenceDuration.Milliseconds() },
 }
 var ncCountMetrics = map[string]func(s nearcache.Stats) int64{
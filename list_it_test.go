@@ -18,6 +18,7 @@ package hazelcast_test
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"math"
 	"sync/atomic"
@@ -26,6 +27,7 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	hz "github.com/hazelcast/hazelcast-go-client"
+	"github.com/hazelcast/hazelcast-go-client/hzerrors"
 	"github.com/hazelcast/hazelcast-go-client/internal/it"
 	"github.com/hazelcast/hazelcast-go-client/internal/it/skip"
 )
@@ -112,6 +114,21 @@ func TestList_AddAt(t *testing.T) {
 	})
 }
 
+func TestList_AddAtOrdering(t *testing.T) {
+	it.ListTester(t, func(t *testing.T, l *hz.List) {
+		ctx := context.Background()
+		it.MustValue(l.AddAll(ctx, "a", "c"))
+		// insert in the middle, at the head and at the tail.
+		assert.NoError(t, l.AddAt(ctx, 1, "b"))
+		assert.NoError(t, l.AddAt(ctx, 0, "start"))
+		assert.NoError(t, l.AddAt(ctx, 4, "end"))
+		assert.Equal(t, []interface{}{"start", "a", "b", "c", "end"}, it.MustValue(l.GetAll(ctx)))
+		// the index after the tail is out of range.
+		err := l.AddAt(ctx, 6, "out")
+		assert.True(t, errors.Is(err, hzerrors.ErrIndexOutOfBounds), err)
+	})
+}
+
 func TestList_AddAt_Error(t *testing.T) {
 	skip.If(t, "arch ~ 32bit")
 	it.ListTester(t, func(t *testing.T, l *hz.List) {
@@ -453,9 +470,98 @@ func TestList_SubList_Error(t *testing.T) {
 	})
 }
 
+func TestList_SubListBoundaries(t *testing.T) {
+	it.ListTester(t, func(t *testing.T, l *hz.List) {
+		ctx := context.Background()
+		it.MustValue(l.AddAll(ctx, "1", "2", "3"))
+		res, err := l.SubList(ctx, 0, 3)
+		assert.NoError(t, err)
+		assert.Equal(t, []interface{}{"1", "2", "3"}, res)
+		res, err = l.SubList(ctx, 3, 3)
+		assert.NoError(t, err)
+		assert.Len(t, res, 0)
+		res, err = l.SubList(ctx, 0, 0)
+		assert.NoError(t, err)
+		assert.Len(t, res, 0)
+		_, err = l.SubList(ctx, 2, 1)
+		assert.True(t, errors.Is(err, hzerrors.ErrIndexOutOfBounds), err)
+		_, err = l.SubList(ctx, 0, 4)
+		assert.True(t, errors.Is(err, hzerrors.ErrIndexOutOfBounds), err)
+		_, err = l.SubList(ctx, -1, 2)
+		assert.True(t, errors.Is(err, hzerrors.ErrIndexOutOfBounds), err)
+	})
+}
+
+func TestList_IndexOutOfRange(t *testing.T) {
+	it.ListTester(t, func(t *testing.T, l *hz.List) {
+		ctx := context.Background()
+		it.MustValue(l.Add(ctx, "1"))
+		_, err := l.Get(ctx, 1)
+		assert.True(t, errors.Is(err, hzerrors.ErrIndexOutOfBounds), err)
+		_, err = l.Get(ctx, -1)
+		assert.True(t, errors.Is(err, hzerrors.ErrIndexOutOfBounds), err)
+		// negative indexes are still illegal arguments.
+		assert.True(t, errors.Is(err, hzerrors.ErrIllegalArgument), err)
+		_, err = l.Set(ctx, 1, "2")
+		assert.True(t, errors.Is(err, hzerrors.ErrIndexOutOfBounds), err)
+		_, err = l.RemoveAt(ctx, 1)
+		assert.True(t, errors.Is(err, hzerrors.ErrIndexOutOfBounds), err)
+		assert.Equal(t, 1, it.MustValue(l.Size(ctx)))
+	})
+}
+
+func TestList_AddItemListener(t *testing.T) {
+	it.ListTester(t, func(t *testing.T, l *hz.List) {
+		ctx := context.Background()
+		var added int32
+		sid, err := l.AddItemListener(ctx, true, func(event *hz.ListItemNotified) {
+			if event.EventType == hz.ItemAdded {
+				atomic.AddInt32(&added, 1)
+			}
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		it.MustValue(l.Add(ctx, "1"))
+		it.Eventually(t, func() bool { return atomic.LoadInt32(&added) == 1 })
+		if err := l.RemoveItemListener(ctx, sid); err != nil {
+			t.Fatal(err)
+		}
+		it.MustValue(l.Add(ctx, "2"))
+		it.Never(t, func() bool { return atomic.LoadInt32(&added) != 1 })
+	})
+}
+
 func TestList_SetWithoutItem(t *testing.T) {
 	it.ListTester(t, func(t *testing.T, l *hz.List) {
 		_, err := l.Set(context.Background(), 1, "a")
 		assert.Error(t, err)
 	})
 }
+
+func TestList_NilValue(t *testing.T) {
+	it.ListTester(t, func(t *testing.T, l *hz.List) {
+		ctx := context.Background()
+		var nilPtr *string
+		_, err := l.Add(ctx, nil)
+		assert.True(t, errors.Is(err, hzerrors.ErrIllegalArgument))
+		_, err = l.Add(ctx, nilPtr)
+		assert.True(t, errors.Is(err, hzerrors.ErrIllegalArgument))
+		err = l.AddAt(ctx, 0, nilPtr)
+		assert.True(t, errors.Is(err, hzerrors.ErrIllegalArgument))
+		_, err = l.AddAll(ctx, "value", nil)
+		assert.True(t, errors.Is(err, hzerrors.ErrIllegalArgument))
+		_, err = l.Contains(ctx, nil)
+		assert.True(t, errors.Is(err, hzerrors.ErrIllegalArgument))
+		_, err = l.IndexOf(ctx, nilPtr)
+		assert.True(t, errors.Is(err, hzerrors.ErrIllegalArgument))
+		_, err = l.Remove(ctx, nil)
+		assert.True(t, errors.Is(err, hzerrors.ErrIllegalArgument))
+		// the rejected writes do not change the list
+		assert.Equal(t, 0, it.MustValue(l.Size(ctx)))
+		it.MustValue(l.Add(ctx, "value"))
+		_, err = l.Set(ctx, 0, nil)
+		assert.True(t, errors.Is(err, hzerrors.ErrIllegalArgument))
+		assert.Equal(t, "value", it.MustValue(l.Get(ctx, 0)))
+	})
+}
@@ -0,0 +1,209 @@
+/*
+ * Copyright (c) 2008-2023, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package hazelcast
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/hazelcast/hazelcast-go-client/internal/logger"
+	iproxy "github.com/hazelcast/hazelcast-go-client/internal/proxy"
+	iserialization "github.com/hazelcast/hazelcast-go-client/internal/serialization"
+)
+
+// leaseRenewalDivisor determines the renewal interval as a fraction of the lease time.
+const leaseRenewalDivisor = 3
+
+type leaseRenewal struct {
+	cancel context.CancelFunc
+	// done is closed when the renewal goroutine exits.
+	done chan struct{}
+}
+
+// wait blocks until the renewal goroutine exits.
+func (lr *leaseRenewal) wait() {
+	<-lr.done
+}
+
+type heldLockKey struct {
+	mapName string
+	key     string
+	lockID  int64
+}
+
+// lockLeaseRenewer tracks the map locks held by the client whose leases are renewed in the background.
+type lockLeaseRenewer struct {
+	renewals map[heldLockKey]*leaseRenewal
+	mu       *sync.Mutex
+	lg       logger.LogAdaptor
+	stopped  bool
+}
+
+func newLockLeaseRenewer(lg logger.LogAdaptor) *lockLeaseRenewer {
+	return &lockLeaseRenewer{
+		renewals: map[heldLockKey]*leaseRenewal{},
+		mu:       &sync.Mutex{},
+		lg:       lg,
+	}
+}
+
+// Start starts renewing the lease of the given held lock periodically.
+// An existing renewal for the same lock is replaced.
+func (r *lockLeaseRenewer) Start(m *Map, keyData iserialization.Data, lid int64, lease time.Duration) {
+	hk := heldLockKey{mapName: m.name, key: string(keyData), lockID: lid}
+	ctx, cancel := context.WithCancel(context.Background())
+	r.mu.Lock()
+	if r.stopped {
+		r.mu.Unlock()
+		cancel()
+		return
+	}
+	prev := r.stopRenewal(hk)
+	lr := &leaseRenewal{cancel: cancel, done: make(chan struct{})}
+	r.renewals[hk] = lr
+	r.mu.Unlock()
+	if prev != nil {
+		prev.wait()
+	}
+	go r.renew(ctx, lr, m, hk, keyData, lid, lease)
+}
+
+// StopLock stops renewing the lease of the given lock.
+// It returns after an ongoing renewal of the lock completes, so the lock can be released safely afterwards.
+func (r *lockLeaseRenewer) StopLock(mapName string, keyData iserialization.Data, lid int64) {
+	hk := heldLockKey{mapName: mapName, key: string(keyData), lockID: lid}
+	r.mu.Lock()
+	lr := r.stopRenewal(hk)
+	r.mu.Unlock()
+	if lr != nil {
+		lr.wait()
+	}
+}
+
+// StopKey stops renewing the leases of all locks for the given key, regardless of the lock owner.
+// It returns after ongoing renewals of the locks complete.
+func (r *lockLeaseRenewer) StopKey(mapName string, keyData iserialization.Data) {
+	key := string(keyData)
+	r.stopMatching(func(hk heldLockKey) bool {
+		return hk.mapName == mapName && hk.key == key
+	})
+}
+
+// StopMap stops renewing the leases of all locks in the given map.
+// It returns after ongoing renewals of the locks complete.
+func (r *lockLeaseRenewer) StopMap(mapName string) {
+	r.stopMatching(func(hk heldLockKey) bool {
+		return hk.mapName == mapName
+	})
+}
+
+// Stop stops all renewals and waits for ongoing renewals to complete until ctx is done.
+// Renewals cannot be started after Stop is called.
+func (r *lockLeaseRenewer) Stop(ctx context.Context) {
+	r.mu.Lock()
+	r.stopped = true
+	lrs := make([]*leaseRenewal, 0, len(r.renewals))
+	for hk := range r.renewals {
+		lrs = append(lrs, r.stopRenewal(hk))
+	}
+	r.mu.Unlock()
+	for _, lr := range lrs {
+		select {
+		case <-lr.done:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (r *lockLeaseRenewer) stopMatching(match func(hk heldLockKey) bool) {
+	var lrs []*leaseRenewal
+	r.mu.Lock()
+	for hk := range r.renewals {
+		if match(hk) {
+			lrs = append(lrs, r.stopRenewal(hk))
+		}
+	}
+	r.mu.Unlock()
+	for _, lr := range lrs {
+		lr.wait()
+	}
+}
+
+// Renewing returns true if the lease of the given lock is being renewed.
+func (r *lockLeaseRenewer) Renewing(mapName string, keyData iserialization.Data, lid int64) bool {
+	hk := heldLockKey{mapName: mapName, key: string(keyData), lockID: lid}
+	r.mu.Lock()
+	_, ok := r.renewals[hk]
+	r.mu.Unlock()
+	return ok
+}
+
+// stopRenewal cancels the renewal of the given lock and returns it, or returns nil if there is no such renewal.
+// It must be called while holding the mutex.
+// The caller should wait for the returned renewal after releasing the mutex.
+func (r *lockLeaseRenewer) stopRenewal(hk heldLockKey) *leaseRenewal {
+	lr, ok := r.renewals[hk]
+	if !ok {
+		return nil
+	}
+	lr.cancel()
+	delete(r.renewals, hk)
+	return lr
+}
+
+func (r *lockLeaseRenewer) renew(ctx context.Context, lr *leaseRenewal, m *Map, hk heldLockKey, keyData iserialization.Data, lid int64, lease time.Duration) {
+	defer close(lr.done)
+	ticker := time.NewTicker(lease / leaseRenewalDivisor)
+	defer ticker.Stop()
+	// a renewal locks and unlocks the key, it is not cancelled halfway so that it does not leave an extra lock count behind.
+	renewCtx := iproxy.WithLockID(context.Background(), lid)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if ctx.Err() != nil {
+				return
+			}
+			ok, err := m.renewLockLease(renewCtx, keyData, lease)
+			if ctx.Err() != nil {
+				return
+			}
+			if err != nil {
+				// the lock may be lost if the lease expires before the next renewal succeeds.
+				r.lg.Warnf("hazelcast.lockLeaseRenewer.renew: renewing lock lease for map %s: %v", hk.mapName, err)
+				continue
+			}
+			if !ok {
+				r.lg.Warnf("hazelcast.lockLeaseRenewer.renew: lock in map %s is not held by the owner anymore, stopping lease renewal", hk.mapName)
+				r.mu.Lock()
+				// do not stop a renewal which replaced this one.
+				if r.renewals[hk] == lr {
+					r.stopRenewal(hk)
+				}
+				r.mu.Unlock()
+				return
+			}
+			r.lg.Trace(func() string {
+				return fmt.Sprintf("hazelcast.lockLeaseRenewer.renew: renewed lock lease for map %s", hk.mapName)
+			})
+		}
+	}
+}
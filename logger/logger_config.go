@@ -17,7 +17,10 @@
 package logger
 
 import (
+	"encoding/json"
+
 	"github.com/hazelcast/hazelcast-go-client/internal/hzerrors"
+	"github.com/hazelcast/hazelcast-go-client/types"
 )
 
 // Level is the importance of a log message.
@@ -59,11 +62,34 @@ type Config struct {
 	CustomLogger Logger `json:"-"`
 	// Level is the log level for the builtin logger.
 	Level Level `json:",omitempty"`
+	// InvocationSampling configures logging a sample of the invocations at the info level.
+	// It applies to both the builtin and custom loggers.
+	InvocationSampling InvocationSamplingConfig
 }
 
 // Clone returns a copy of the logger configuration.
 func (c Config) Clone() Config {
-	return Config{Level: c.Level, CustomLogger: c.CustomLogger}
+	return Config{
+		Level:              c.Level,
+		CustomLogger:       c.CustomLogger,
+		InvocationSampling: c.InvocationSampling,
+	}
+}
+
+// MarshalJSON marshals the logger configuration to JSON.
+// The invocation sampling configuration is omitted if it is not set.
+func (c Config) MarshalJSON() ([]byte, error) {
+	cfg := configForMarshal{Level: c.Level}
+	if c.InvocationSampling != (InvocationSamplingConfig{}) {
+		cfg.InvocationSampling = &c.InvocationSampling
+	}
+	return json.Marshal(cfg)
+}
+
+// configForMarshal is used for marshaling Config to JSON.
+type configForMarshal struct {
+	Level              Level                     `json:",omitempty"`
+	InvocationSampling *InvocationSamplingConfig `json:",omitempty"`
 }
 
 // Validate checks the logger configuration for problems and updates it with default values.
@@ -77,5 +103,37 @@ func (c *Config) Validate() error {
 	if _, err := WeightForLogLevel(c.Level); err != nil {
 		return err
 	}
+	if err := c.InvocationSampling.Validate(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// InvocationSamplingConfig configures logging a sample of the completed invocations.
+// An invocation is logged if it is selected by any of the enabled sampling modes.
+// Invocation sampling is disabled by default.
+type InvocationSamplingConfig struct {
+	// Every logs one in every N completed invocations.
+	// Set to 0 to disable.
+	Every int `json:",omitempty"`
+	// SlowerThan logs the invocations which took at least the given duration to complete.
+	// It is useful to spot slow operations.
+	// Set to 0 to disable.
+	SlowerThan types.Duration `json:",omitempty"`
+}
+
+// Enabled returns true if any of the sampling modes is enabled.
+func (c InvocationSamplingConfig) Enabled() bool {
+	return c.Every > 0 || c.SlowerThan > 0
+}
+
+// Validate checks the invocation sampling configuration for problems.
+func (c InvocationSamplingConfig) Validate() error {
+	if c.Every < 0 {
+		return hzerrors.NewIllegalArgumentError("invocation sampling rate cannot be negative", nil)
+	}
+	if c.SlowerThan < 0 {
+		return hzerrors.NewIllegalArgumentError("invocation sampling threshold cannot be negative", nil)
+	}
 	return nil
 }
@@ -17,12 +17,15 @@
 package logger
 
 import (
+	"encoding/json"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 
 	"github.com/hazelcast/hazelcast-go-client/hzerrors"
+	"github.com/hazelcast/hazelcast-go-client/types"
 )
 
 func TestGetLogLevel(t *testing.T) {
@@ -63,3 +66,42 @@ func TestLogLevelWithCustomLoggerFails(t *testing.T) {
 type customLogger struct{}
 
 func (c customLogger) Log(weight Weight, f func() string) {}
+
+func TestInvocationSamplingConfig_Validate(t *testing.T) {
+	testCases := []struct {
+		name    string
+		cfg     InvocationSamplingConfig
+		enabled bool
+		invalid bool
+	}{
+		{name: "disabled", cfg: InvocationSamplingConfig{}},
+		{name: "every", cfg: InvocationSamplingConfig{Every: 100}, enabled: true},
+		{name: "slower than", cfg: InvocationSamplingConfig{SlowerThan: types.Duration(time.Second)}, enabled: true},
+		{name: "negative every", cfg: InvocationSamplingConfig{Every: -1}, invalid: true},
+		{name: "negative slower than", cfg: InvocationSamplingConfig{SlowerThan: -1}, invalid: true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg := Config{InvocationSampling: tc.cfg}
+			err := cfg.Validate()
+			if tc.invalid {
+				assert.True(t, errors.Is(err, hzerrors.ErrIllegalArgument))
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.enabled, cfg.InvocationSampling.Enabled())
+		})
+	}
+}
+
+func TestConfig_MarshalJSON(t *testing.T) {
+	b, err := json.Marshal(Config{Level: DebugLevel})
+	assert.NoError(t, err)
+	assert.Equal(t, `{"Level":"debug"}`, string(b))
+	cfg := Config{InvocationSampling: InvocationSamplingConfig{Every: 100, SlowerThan: types.Duration(time.Second)}}
+	b, err = json.Marshal(cfg)
+	assert.NoError(t, err)
+	var got Config
+	assert.NoError(t, json.Unmarshal(b, &got))
+	assert.Equal(t, cfg, got)
+}
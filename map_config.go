@@ -0,0 +1,163 @@
+/*
+ * Copyright (c) 2008-2023, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package hazelcast
+
+import (
+	"fmt"
+
+	"github.com/hazelcast/hazelcast-go-client/internal/check"
+	ihzerrors "github.com/hazelcast/hazelcast-go-client/internal/hzerrors"
+)
+
+// Merge policies which are available on the member.
+// See https://docs.hazelcast.com/hazelcast/latest/network-partitioning/split-brain-recovery#merge-policies
+const (
+	// MergePolicyPutIfAbsent merges the entry from the smaller cluster only if it does not exist in the larger cluster.
+	MergePolicyPutIfAbsent = "com.hazelcast.spi.merge.PutIfAbsentMergePolicy"
+	// MergePolicyPassThrough merges the entry from the smaller cluster, overwriting the entry in the larger cluster.
+	MergePolicyPassThrough = "com.hazelcast.spi.merge.PassThroughMergePolicy"
+	// MergePolicyDiscard discards the entry from the smaller cluster.
+	MergePolicyDiscard = "com.hazelcast.spi.merge.DiscardMergePolicy"
+	// MergePolicyHigherHits keeps the entry with more hits.
+	MergePolicyHigherHits = "com.hazelcast.spi.merge.HigherHitsMergePolicy"
+	// MergePolicyLatestAccess keeps the entry which was accessed more recently.
+	MergePolicyLatestAccess = "com.hazelcast.spi.merge.LatestAccessMergePolicy"
+	// MergePolicyLatestUpdate keeps the entry which was updated more recently.
+	MergePolicyLatestUpdate = "com.hazelcast.spi.merge.LatestUpdateMergePolicy"
+	// MergePolicyExpirationTime keeps the entry with the later expiration time.
+	MergePolicyExpirationTime = "com.hazelcast.spi.merge.ExpirationTimeMergePolicy"
+)
+
+const (
+	defaultMapBackupCount    = 1
+	maxMapBackupCount        = 6
+	defaultMergeBatchSize    = 100
+	mapCacheDeserializedMode = "INDEX-ONLY"
+	mapInMemoryFormat        = "BINARY"
+)
+
+// MapConfig is the configuration of a map which is added to the cluster using Client.AddMapConfig.
+// The configuration is applied by the members when the map is created, so it must be added before the map is used.
+type MapConfig struct {
+	backupCount *int
+	// Name is the name of the map, which may contain a wildcard ('*').
+	Name string
+	// MergePolicy specifies how the entries of the map are merged after a split-brain is healed.
+	MergePolicy MergePolicyConfig
+	// AsyncBackupCount is the number of asynchronous backups.
+	// The sum of the synchronous and asynchronous backups must not exceed 6.
+	// The default is 0.
+	AsyncBackupCount int
+	// TimeToLiveSeconds is the maximum number of seconds for each entry to stay in the map.
+	// It is the default TTL of the entries which are put without an explicit TTL, e.g., using Map.Put or Map.Set.
+	// The member applies it when the entry is written, so PutWithTTL and similar methods override it.
+	// The value 0 means infinite.
+	// The default is 0.
+	TimeToLiveSeconds int
+	// MaxIdleSeconds is the maximum number of seconds for each entry to stay idle in the map.
+	// It is the default max idle of the entries which are put without an explicit max idle.
+	// The value 0 means infinite.
+	// The default is 0.
+	MaxIdleSeconds int
+	// ReadBackupData enables reading from the local backup of an entry if it is available.
+	// The default is false.
+	ReadBackupData bool
+}
+
+// Clone returns a copy of the configuration.
+func (c MapConfig) Clone() MapConfig {
+	return MapConfig{
+		backupCount:       c.backupCount,
+		Name:              c.Name,
+		MergePolicy:       c.MergePolicy.Clone(),
+		AsyncBackupCount:  c.AsyncBackupCount,
+		TimeToLiveSeconds: c.TimeToLiveSeconds,
+		MaxIdleSeconds:    c.MaxIdleSeconds,
+		ReadBackupData:    c.ReadBackupData,
+	}
+}
+
+// Validate validates the configuration and replaces missing configuration with defaults.
+func (c *MapConfig) Validate() error {
+	if c.Name == "" {
+		return ihzerrors.NewInvalidConfigurationError("MapConfig: Name: name is required", nil)
+	}
+	bc := c.BackupCount()
+	if bc < 0 || bc > maxMapBackupCount {
+		msg := fmt.Sprintf("MapConfig: BackupCount: must be in range [0,%d]: %d", maxMapBackupCount, bc)
+		return ihzerrors.NewInvalidConfigurationError(msg, nil)
+	}
+	if c.AsyncBackupCount < 0 || bc+c.AsyncBackupCount > maxMapBackupCount {
+		msg := fmt.Sprintf("MapConfig: AsyncBackupCount: sum of backups must be in range [0,%d]: %d", maxMapBackupCount, bc+c.AsyncBackupCount)
+		return ihzerrors.NewInvalidConfigurationError(msg, nil)
+	}
+	if err := check.NonNegativeInt32Config(c.TimeToLiveSeconds); err != nil {
+		return fmt.Errorf("MapConfig: TimeToLiveSeconds: %w", err)
+	}
+	if err := check.NonNegativeInt32Config(c.MaxIdleSeconds); err != nil {
+		return fmt.Errorf("MapConfig: MaxIdleSeconds: %w", err)
+	}
+	return c.MergePolicy.Validate()
+}
+
+// SetBackupCount sets the number of synchronous backups.
+// The sum of the synchronous and asynchronous backups must not exceed 6.
+func (c *MapConfig) SetBackupCount(count int) {
+	c.backupCount = &count
+}
+
+// BackupCount returns the number of synchronous backups.
+// The default is 1.
+func (c MapConfig) BackupCount() int {
+	if c.backupCount == nil {
+		return defaultMapBackupCount
+	}
+	return *c.backupCount
+}
+
+// MergePolicyConfig contains the split-brain merge policy configuration.
+type MergePolicyConfig struct {
+	// Policy is the class name of the merge policy on the member.
+	// See MergePolicyPutIfAbsent and the other MergePolicy constants for the built-in policies.
+	// The default is MergePolicyPutIfAbsent.
+	Policy string
+	// BatchSize is the number of entries which are sent together during merging.
+	// The default is 100.
+	BatchSize int
+}
+
+// Clone returns a copy of the configuration.
+func (c MergePolicyConfig) Clone() MergePolicyConfig {
+	return MergePolicyConfig{
+		Policy:    c.Policy,
+		BatchSize: c.BatchSize,
+	}
+}
+
+// Validate validates the configuration and replaces missing configuration with defaults.
+func (c *MergePolicyConfig) Validate() error {
+	if c.Policy == "" {
+		c.Policy = MergePolicyPutIfAbsent
+	}
+	if c.BatchSize == 0 {
+		c.BatchSize = defaultMergeBatchSize
+	}
+	if err := check.NonNegativeInt32Config(c.BatchSize); err != nil {
+		return fmt.Errorf("MergePolicyConfig: BatchSize: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,117 @@
+/*
+ * Copyright (c) 2008-2023, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package hazelcast_test
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hazelcast/hazelcast-go-client"
+	"github.com/hazelcast/hazelcast-go-client/hzerrors"
+	"github.com/hazelcast/hazelcast-go-client/internal/proto"
+	"github.com/hazelcast/hazelcast-go-client/internal/proto/codec"
+	iserialization "github.com/hazelcast/hazelcast-go-client/internal/serialization"
+	"github.com/hazelcast/hazelcast-go-client/serialization"
+	"github.com/hazelcast/hazelcast-go-client/types"
+)
+
+func TestMapConfig_ValidateDefaults(t *testing.T) {
+	c := hazelcast.MapConfig{Name: "my-map"}
+	require.NoError(t, c.Validate())
+	assert.Equal(t, 1, c.BackupCount())
+	assert.Equal(t, 0, c.AsyncBackupCount)
+	assert.Equal(t, hazelcast.MergePolicyPutIfAbsent, c.MergePolicy.Policy)
+	assert.Equal(t, 100, c.MergePolicy.BatchSize)
+}
+
+func TestMapConfig_ValidateKeepsMergePolicy(t *testing.T) {
+	c := hazelcast.MapConfig{Name: "my-map"}
+	c.MergePolicy.Policy = hazelcast.MergePolicyLatestUpdate
+	c.MergePolicy.BatchSize = 10
+	c.SetBackupCount(0)
+	require.NoError(t, c.Validate())
+	assert.Equal(t, 0, c.BackupCount())
+	assert.Equal(t, hazelcast.MergePolicyLatestUpdate, c.MergePolicy.Policy)
+	assert.Equal(t, 10, c.MergePolicy.BatchSize)
+}
+
+func TestMapConfig_ValidateInvalid(t *testing.T) {
+	testCases := []struct {
+		name string
+		f    func(c *hazelcast.MapConfig)
+	}{
+		{name: "empty name", f: func(c *hazelcast.MapConfig) { c.Name = "" }},
+		{name: "negative backup count", f: func(c *hazelcast.MapConfig) { c.SetBackupCount(-1) }},
+		{name: "backup count too large", f: func(c *hazelcast.MapConfig) { c.SetBackupCount(7) }},
+		{name: "negative async backup count", f: func(c *hazelcast.MapConfig) { c.AsyncBackupCount = -1 }},
+		{name: "sum of backup counts too large", f: func(c *hazelcast.MapConfig) { c.AsyncBackupCount = 6 }},
+		{name: "negative TTL", f: func(c *hazelcast.MapConfig) { c.TimeToLiveSeconds = -1 }},
+		{name: "negative max idle", f: func(c *hazelcast.MapConfig) { c.MaxIdleSeconds = -1 }},
+		{name: "negative merge batch size", f: func(c *hazelcast.MapConfig) { c.MergePolicy.BatchSize = -1 }},
+		{name: "merge batch size too large", f: func(c *hazelcast.MapConfig) { c.MergePolicy.BatchSize = math.MaxInt32 + 1 }},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			c := hazelcast.MapConfig{Name: "my-map"}
+			tc.f(&c)
+			err := c.Validate()
+			assert.True(t, errors.Is(err, hzerrors.ErrInvalidConfiguration), err)
+		})
+	}
+}
+
+func TestMapEntryMergedEventHasMergingValue(t *testing.T) {
+	ss, err := iserialization.NewService(&serialization.Config{}, nil)
+	require.NoError(t, err)
+	key, err := ss.ToData("key")
+	require.NoError(t, err)
+	value, err := ss.ToData("existing")
+	require.NoError(t, err)
+	mergingValue, err := ss.ToData("merging")
+	require.NoError(t, err)
+	msg := newMapEntryEventMessage(int32(hazelcast.EntryMerged), key, value, nil, mergingValue)
+	var event *hazelcast.EntryNotified
+	hazelcast.HandleMapEntryEvent(ss, "my-map", msg, func(e *hazelcast.EntryNotified) {
+		event = e
+	})
+	require.NotNil(t, event)
+	assert.Equal(t, hazelcast.EntryMerged, event.EventType)
+	assert.Equal(t, "my-map", event.MapName)
+	assert.Equal(t, "key", event.Key)
+	assert.Equal(t, "existing", event.Value)
+	assert.Nil(t, event.OldValue)
+	assert.Equal(t, "merging", event.MergingValue)
+}
+
+func newMapEntryEventMessage(eventType int32, key, value, oldValue, mergingValue iserialization.Data) *proto.ClientMessage {
+	msg := proto.NewClientMessageForEncode()
+	initialFrame := proto.NewFrameWith(make([]byte, codec.MapAddEntryListenerEventEntryNumberOfAffectedEntriesOffset+proto.IntSizeInBytes), proto.UnfragmentedMessage)
+	codec.FixSizedTypesCodec.EncodeInt(initialFrame.Content, codec.MapAddEntryListenerEventEntryEventTypeOffset, eventType)
+	codec.FixSizedTypesCodec.EncodeUUID(initialFrame.Content, codec.MapAddEntryListenerEventEntryUuidOffset, types.NewUUID())
+	codec.FixSizedTypesCodec.EncodeInt(initialFrame.Content, codec.MapAddEntryListenerEventEntryNumberOfAffectedEntriesOffset, 1)
+	msg.AddFrame(initialFrame)
+	msg.SetMessageType(codec.MapAddEntryListenerCodecEventEntryMessageType)
+	codec.EncodeNullableData(msg, key)
+	codec.EncodeNullableData(msg, value)
+	codec.EncodeNullableData(msg, oldValue)
+	codec.EncodeNullableData(msg, mergingValue)
+	return msg
+}
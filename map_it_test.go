@@ -22,6 +22,7 @@ import (
 	"fmt"
 	"reflect"
 	"sort"
+	"strings"
 	"sync"
 	"sync/atomic"
 	"testing"
@@ -34,8 +35,11 @@ import (
 	"github.com/hazelcast/hazelcast-go-client/hzerrors"
 	"github.com/hazelcast/hazelcast-go-client/internal/it"
 	"github.com/hazelcast/hazelcast-go-client/internal/it/skip"
+	"github.com/hazelcast/hazelcast-go-client/internal/proto/codec"
+	"github.com/hazelcast/hazelcast-go-client/logger"
 	"github.com/hazelcast/hazelcast-go-client/nearcache"
 	"github.com/hazelcast/hazelcast-go-client/predicate"
+	"github.com/hazelcast/hazelcast-go-client/projection"
 	"github.com/hazelcast/hazelcast-go-client/serialization"
 	"github.com/hazelcast/hazelcast-go-client/types"
 )
@@ -49,6 +53,7 @@ func TestMap(t *testing.T) {
 		noParallel bool
 	}{
 		{name: "AddIndexValidationError", f: mapAddIndexValidationError},
+		{name: "AddIndexSortedRangeQuery", f: mapAddIndexSortedRangeQuery},
 		{name: "AddIndexWithConfig", f: mapAddIndexWithConfig},
 		{name: "AddInterceptor", f: mapAddInterceptor},
 		{name: "Aggregate", f: mapAggregate},
@@ -59,6 +64,13 @@ func TestMap(t *testing.T) {
 		{name: "Destroy", f: mapDestroy},
 		{name: "DestroyWithNearCache", f: mapDestroyWithNearCache},
 		{name: "EntryNotifiedEvent", f: mapEntryNotifiedEvent},
+		{name: "EntryNotifiedEventIncludeInitial", f: mapEntryNotifiedEventIncludeInitial},
+		{name: "EntryNotifiedEventIncludeInitialWithAddListenerWithConfig", f: mapEntryNotifiedEventIncludeInitialWithAddListenerWithConfig},
+		{name: "EntryNotifiedEventIncludeInitialWithoutEntryAdded", f: mapEntryNotifiedEventIncludeInitialWithoutEntryAdded},
+		{name: "EntryNotifiedEventMergedWithMergePolicy", f: mapEntryNotifiedEventMergedWithMergePolicy},
+		{name: "EntryNotifiedEventUntilDone", f: mapEntryNotifiedEventUntilDone},
+		{name: "EntryNotifiedEventUntilDoneInvalidContext", f: mapEntryNotifiedEventUntilDoneInvalidContext},
+		{name: "EntryNotifiedEventIncludeInitialWithPredicate", f: mapEntryNotifiedEventIncludeInitialWithPredicate},
 		{name: "EntryNotifiedEventToKey", f: mapEntryNotifiedEventToKey},
 		{name: "EntryNotifiedEventToKeyAndPredicate", f: mapEntryNotifiedEventToKeyAndPredicate},
 		{name: "EntryNotifiedEventToKeyAndPredicateWithAddListenerWithPredicateAndKey", f: mapEntryNotifiedEventToKeyAndPredicateWithAddListenerWithPredicateAndKey},
@@ -66,33 +78,66 @@ func TestMap(t *testing.T) {
 		{name: "EntryNotifiedEventWithAddListener", f: mapEntryNotifiedEventWithAddListener},
 		{name: "EntryNotifiedEventWithPredicate", f: mapEntryNotifiedEventWithPredicate},
 		{name: "EntryNotifiedEventWithPredicateWithAddListenerWithPredicate", f: mapEntryNotifiedEventWithPredicateWithAddListenerWithPredicate},
+		{name: "EntryNotifiedEventWithNumericPredicate", f: mapEntryNotifiedEventWithNumericPredicate},
 		{name: "Evict", f: mapEvict},
 		{name: "ExecuteOnEntries", f: mapExecuteOnEntries},
 		{name: "ExecuteOnEntriesWithPredicate", f: mapExecuteOnEntriesWithPredicate},
+		{name: "ExecuteOnEntriesWithPredicateNoMatch", f: mapExecuteOnEntriesWithPredicateNoMatch},
 		{name: "ExecuteOnKey", f: mapExecuteOnKey},
+		{name: "ExecuteOnKeyAs", f: mapExecuteOnKeyAs},
 		{name: "ExecuteOnKeys", f: mapExecuteOnKeys},
 		{name: "Flush", f: mapFlush},
 		{name: "ForceUnlock", f: mapForceUnlock},
 		{name: "GetAll", f: mapGetAll},
+		{name: "GetAllBatchesByPartition", f: mapGetAllBatchesByPartition},
+		{name: "GetAllDuplicateAndMissingKeys", f: mapGetAllDuplicateAndMissingKeys},
+		{name: "GetAllOrdered", f: mapGetAllOrdered},
+		{name: "GetAllOrderedWithNearCache", f: mapGetAllOrderedWithNearCache},
 		{name: "GetEntrySet", f: mapGetEntrySet},
+		{name: "GetEntrySetWithPagingPredicate", f: mapGetEntrySetWithPagingPredicate},
+		{name: "GetEntrySetWithPagingPredicateInvalidPageSize", f: mapGetEntrySetWithPagingPredicateInvalidPageSize},
+		{name: "GetEntrySetWithPredicateStream", f: mapGetEntrySetWithPredicateStream},
+		{name: "GetEntrySetWithPredicateStreamCancel", f: mapGetEntrySetWithPredicateStreamCancel},
+		{name: "GetEntrySetWithPredicateStreamInvalidArgs", f: mapGetEntrySetWithPredicateStreamInvalidArgs},
 		{name: "GetEntrySetWithPredicateUsingJSON", f: mapGetEntrySetWithPredicateUsingJSON},
 		{name: "GetEntrySetWithPredicateUsingPortable", f: mapGetEntrySetWithPredicateUsingPortable},
 		{name: "GetEntryView", f: mapGetEntryView},
 		{name: "GetEntryView_2", f: mapGetEntryView_2},
 		{name: "GetEntryView_KeyNotFound", f: mapGetEntryView_KeyNotFound},
+		{name: "GetEntryViews", f: mapGetEntryViews},
 		{name: "GetKeySet", f: mapGetKeySet},
+		{name: "GetKeySetWithPagingPredicate", f: mapGetKeySetWithPagingPredicate},
 		{name: "GetKeySetWithPredicate", f: mapGetKeySetWithPredicate},
 		{name: "GetValues", f: mapGetValues},
+		{name: "GetValuesWithPagingPredicate", f: mapGetValuesWithPagingPredicate},
 		{name: "GetValuesWithPredicate", f: mapGetValuesWithPredicate},
+		{name: "Increment", f: mapIncrement},
+		{name: "IncrementConcurrent", f: mapIncrementConcurrent},
+		{name: "IncrementNonInt64Value", f: mapIncrementNonInt64Value},
 		{name: "IsEmptySize", f: mapIsEmptySize},
+		{name: "LoadAll", f: mapLoadAll, noParallel: true},
 		{name: "LoadAllReplacing", f: mapLoadAllReplacing, noParallel: true},
+		{name: "LoadAllWithKeys", f: mapLoadAllWithKeys, noParallel: true},
+		{name: "LoadAllWithKeysNilKeys", f: mapLoadAllWithKeysNilKeys},
+		{name: "LoadAllWithoutMapLoader", f: mapLoadAllWithoutMapLoader},
 		{name: "LoadAllWithoutReplacing", f: mapLoadAllWithoutReplacing, noParallel: true},
 		{name: "Lock", f: mapLock},
 		{name: "LockWithLease", f: mapLockWithLease},
+		{name: "LockWithLeaseRenewal", f: mapLockWithLeaseRenewal, noParallel: true},
+		{name: "LockWithLeaseRenewalInvalidLease", f: mapLockWithLeaseRenewalInvalidLease},
 		{name: "MapSetGet1000", f: mapMapSetGet1000},
 		{name: "MapSetGetLargePayload", f: mapMapSetGetLargePayload},
+		{name: "MaxValueSize", f: mapMaxValueSize},
+		{name: "NilKeyAndValue", f: mapNilKeyAndValue},
+		{name: "NilKeyWithNearCache", f: mapNilKeyWithNearCache},
+		{name: "NilKeyWithNearCacheSerializeKeys", f: mapNilKeyWithNearCacheSerializeKeys},
+		{name: "ProjectMultiAttribute", f: mapProjectMultiAttribute},
+		{name: "ProjectNilProjection", f: mapProjectNilProjection},
+		{name: "ProjectUsingPortable", f: mapProjectUsingPortable},
+		{name: "ProjectWithPredicate", f: mapProjectWithPredicate},
 		{name: "Put", f: mapPut},
 		{name: "PutAll", f: mapPutAll},
+		{name: "PutAllNilEntry", f: mapPutAllNilEntry},
 		{name: "PutIfAbsent", f: mapPutIfAbsent},
 		{name: "PutIfAbsentWithTTL", f: mapPutIfAbsentWithTTL, noParallel: true},
 		{name: "PutIfAbsentWithTTLAndMaxIdle", f: mapPutIfAbsentWithTTLAndMaxIdle, noParallel: true},
@@ -110,14 +155,22 @@ func TestMap(t *testing.T) {
 		{name: "Set", f: mapSet},
 		{name: "SetTTL", f: mapSetTTL},
 		{name: "SetTTLAffected", f: mapSetTTLAffected},
+		{name: "SetTTLNegative", f: mapSetTTLNegative, noParallel: true},
+		{name: "SetTTLShort", f: mapSetTTLShort, noParallel: true},
 		{name: "SetWithTTL", f: mapSetWithTTL, noParallel: true},
 		{name: "SetWithTTLAndMaxIdle", f: mapSetWithTTLAndMaxIdle, noParallel: true},
+		{name: "SubmitToKey", f: mapSubmitToKey},
+		{name: "SubmitToKeyNilArgs", f: mapSubmitToKeyNilArgs},
+		{name: "SubmitToKeyNotSerializable", f: mapSubmitToKeyNotSerializable},
 		{name: "TryLock", f: mapTryLock},
 		{name: "TryLockWithLease", f: mapTryLockWithLease},
 		{name: "TryLockWithLeaseAndTimeout", f: mapTryLockWithLeaseAndTimeout},
 		{name: "TryLockWithTimeout", f: mapTryLockWithTimeout},
 		{name: "TryPut", f: mapTryPut},
 		{name: "TryPutWithTimeout", f: mapTryPutWithTimeout},
+		{name: "TryPutWithTTL", f: mapTryPutWithTTL},
+		{name: "TryPutWithTTLAcquireTimeout", f: mapTryPutWithTTLAcquireTimeout},
+		{name: "TryPutWithTTLInvalidDurations", f: mapTryPutWithTTLInvalidDurations},
 		{name: "TryRemove", f: mapTryRemove},
 		{name: "TryRemoveWithTimeout", f: mapTryRemoveWithTimeout},
 	}
@@ -136,6 +189,69 @@ func TestMap(t *testing.T) {
 	}
 }
 
+func mapProjectUsingPortable(t *testing.T) {
+	cbCallback := func(config *hz.Config) {
+		config.Serialization.SetPortableFactories(it.SamplePortableFactory{})
+	}
+	it.MapTesterWithConfig(t, cbCallback, func(t *testing.T, m *hz.Map) {
+		ctx := context.Background()
+		for i := 0; i < 10; i++ {
+			it.MustValue(m.Put(ctx, fmt.Sprintf("k%d", i), &it.SamplePortable{A: fmt.Sprintf("v%d", i), B: int32(i)}))
+		}
+		values := it.MustValue(m.GetValues(ctx)).([]interface{})
+		target := make([]interface{}, len(values))
+		for i, v := range values {
+			target[i] = v.(*it.SamplePortable).A
+		}
+		results, err := m.Project(ctx, projection.SingleAttribute("A"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.ElementsMatch(t, target, results)
+	})
+}
+
+func mapProjectMultiAttribute(t *testing.T) {
+	cbCallback := func(config *hz.Config) {
+		config.Serialization.SetPortableFactories(it.SamplePortableFactory{})
+	}
+	it.MapTesterWithConfig(t, cbCallback, func(t *testing.T, m *hz.Map) {
+		ctx := context.Background()
+		it.MustValue(m.Put(ctx, "k1", &it.SamplePortable{A: "foo", B: 10}))
+		results, err := m.Project(ctx, projection.MultiAttribute("B", "A"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, []interface{}{[]interface{}{int32(10), "foo"}}, results)
+	})
+}
+
+func mapProjectNilProjection(t *testing.T) {
+	it.MapTester(t, func(t *testing.T, m *hz.Map) {
+		_, err := m.Project(context.Background(), nil)
+		if !errors.Is(err, hzerrors.ErrIllegalArgument) {
+			t.Fatalf("expected ErrIllegalArgument, got: %v", err)
+		}
+	})
+}
+
+func mapProjectWithPredicate(t *testing.T) {
+	cbCallback := func(config *hz.Config) {
+		config.Serialization.SetPortableFactories(it.SamplePortableFactory{})
+	}
+	it.MapTesterWithConfig(t, cbCallback, func(t *testing.T, m *hz.Map) {
+		ctx := context.Background()
+		for i := 0; i < 10; i++ {
+			it.MustValue(m.Put(ctx, fmt.Sprintf("k%d", i), &it.SamplePortable{A: fmt.Sprintf("v%d", i), B: int32(i)}))
+		}
+		results, err := m.ProjectWithPredicate(ctx, projection.SingleAttribute("A"), predicate.Less("B", int32(3)))
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.ElementsMatch(t, []interface{}{"v0", "v1", "v2"}, results)
+	})
+}
+
 func mapPut(t *testing.T) {
 	it.MapTester(t, func(t *testing.T, m *hz.Map) {
 		targetValue := "value"
@@ -386,6 +502,98 @@ func mapRemove(t *testing.T) {
 	})
 }
 
+func mapGetAllBatchesByPartition(t *testing.T) {
+	lg := &messageTypeCounter{pattern: fmt.Sprintf("message type: %d,", codec.MapGetAllCodecRequestMessageType)}
+	cb := func(c *hz.Config) {
+		c.Logger.CustomLogger = lg
+		c.Logger.InvocationSampling.Every = 1
+	}
+	it.MapTesterWithConfig(t, cb, func(t *testing.T, m *hz.Map) {
+		ctx := context.Background()
+		const keyCount = 1000
+		keys := make([]interface{}, keyCount)
+		for i := 0; i < keyCount; i++ {
+			keys[i] = fmt.Sprintf("k%d", i)
+			it.Must(m.Set(ctx, keys[i], i))
+		}
+		entries, err := m.GetAll(ctx, keys...)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Len(t, entries, keyCount)
+		it.Eventually(t, func() bool { return lg.Count() > 0 })
+		// a request is sent per partition, calling Get for each key would send keyCount requests.
+		assert.Less(t, lg.Count(), int64(keyCount))
+	})
+}
+
+func mapGetAllDuplicateAndMissingKeys(t *testing.T) {
+	it.MapTester(t, func(t *testing.T, m *hz.Map) {
+		ctx := context.Background()
+		it.Must(m.Set(ctx, "k1", "v1"))
+		it.Must(m.Set(ctx, "k2", "v2"))
+		entries, err := m.GetAll(ctx, "k1", "k2", "k1", "missing")
+		if err != nil {
+			t.Fatal(err)
+		}
+		target := []types.Entry{types.NewEntry("k1", "v1"), types.NewEntry("k2", "v2")}
+		assert.ElementsMatch(t, target, entries)
+		_, err = m.GetAll(ctx, "k1", nil)
+		assert.True(t, errors.Is(err, hzerrors.ErrIllegalArgument))
+	})
+}
+
+// messageTypeCounter counts the log messages which contain the pattern.
+type messageTypeCounter struct {
+	pattern string
+	count   int64
+}
+
+func (c *messageTypeCounter) Log(weight logger.Weight, f func() string) {
+	if strings.Contains(f(), c.pattern) {
+		atomic.AddInt64(&c.count, 1)
+	}
+}
+
+func (c *messageTypeCounter) Count() int64 {
+	return atomic.LoadInt64(&c.count)
+}
+
+func mapGetAllOrdered(t *testing.T) {
+	it.MapTester(t, func(t *testing.T, m *hz.Map) {
+		mapGetAllOrderedCheck(t, m)
+	})
+}
+
+func mapGetAllOrderedWithNearCache(t *testing.T) {
+	tcx := it.MapTestContext{
+		T: t,
+		ConfigCallback: func(tcx it.MapTestContext) {
+			tcx.Config.AddNearCache(nearcache.Config{Name: tcx.MapName})
+		},
+	}
+	tcx.Tester(func(tcx it.MapTestContext) {
+		mapGetAllOrderedCheck(tcx.T, tcx.M)
+	})
+}
+
+func mapGetAllOrderedCheck(t *testing.T, m *hz.Map) {
+	ctx := context.Background()
+	for i := 0; i < 100; i++ {
+		it.Must(m.Set(ctx, fmt.Sprintf("k%d", i), fmt.Sprintf("v%d", i)))
+	}
+	keys := []interface{}{"k42", "missing-1", "k1", "k42", "k99", "missing-2", "k1", "k0"}
+	values, err := m.GetAllOrdered(ctx, keys)
+	if err != nil {
+		t.Fatal(err)
+	}
+	target := []interface{}{"v42", nil, "v1", "v42", "v99", nil, "v1", "v0"}
+	assert.Equal(t, target, values)
+	values, err = m.GetAllOrdered(ctx, nil)
+	assert.NoError(t, err)
+	assert.Nil(t, values)
+}
+
 func mapGetAll(t *testing.T) {
 	it.MapTester(t, func(t *testing.T, m *hz.Map) {
 		const maxKeys = 100
@@ -503,6 +711,20 @@ func mapPutAll(t *testing.T) {
 	})
 }
 
+func mapPutAllNilEntry(t *testing.T) {
+	it.MapTester(t, func(t *testing.T, m *hz.Map) {
+		ctx := context.Background()
+		err := m.PutAll(ctx, types.NewEntry("k1", "v1"), types.NewEntry("k2", nil))
+		assert.True(t, errors.Is(err, hzerrors.ErrIllegalArgument))
+		assert.Contains(t, err.Error(), "entry 1")
+		// nothing is written if an entry is invalid.
+		assert.Nil(t, it.MustValue(m.Get(ctx, "k1")))
+		err = m.PutAll(ctx, types.NewEntry(nil, "v1"))
+		assert.True(t, errors.Is(err, hzerrors.ErrIllegalArgument))
+		assert.Contains(t, err.Error(), "entry 0")
+	})
+}
+
 func mapGetEntrySet(t *testing.T) {
 	it.MapTester(t, func(t *testing.T, m *hz.Map) {
 		target := []types.Entry{
@@ -548,6 +770,156 @@ func mapGetEntrySetWithPredicateUsingPortable(t *testing.T) {
 	})
 }
 
+func mapGetEntrySetWithPagingPredicate(t *testing.T) {
+	it.MapTester(t, func(t *testing.T, m *hz.Map) {
+		ctx := context.Background()
+		const count = 25
+		for i := 0; i < count; i++ {
+			// keys and values are the same, so the natural order of the entries is the order of the keys.
+			it.Must(m.Set(ctx, int64(i), int64(i)))
+		}
+		pred := predicate.Paging(nil, 10)
+		pageEntries := func(from, to int) []types.Entry {
+			entries := []types.Entry{}
+			for i := from; i < to; i++ {
+				entries = append(entries, types.Entry{Key: int64(i), Value: int64(i)})
+			}
+			return entries
+		}
+		targets := [][]types.Entry{pageEntries(0, 10), pageEntries(10, 20), pageEntries(20, count), {}}
+		for page, target := range targets {
+			entries, err := m.GetEntrySetWithPredicate(ctx, pred)
+			if err != nil {
+				t.Fatal(err)
+			}
+			assert.Equal(t, target, entries, "page %d", page)
+			pred.NextPage()
+		}
+		// walking back uses the anchors from the previous calls.
+		pred.SetPage(1)
+		entries, err := m.GetEntrySetWithPredicate(ctx, pred)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, pageEntries(10, 20), entries)
+		// the paging predicate filters the entries with the given predicate.
+		pred = predicate.Paging(predicate.GreaterOrEqual("this", int64(15)), 4)
+		pred.SetPage(2)
+		entries, err = m.GetEntrySetWithPredicate(ctx, pred)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, pageEntries(23, count), entries)
+	})
+}
+
+func mapGetEntrySetWithPagingPredicateInvalidPageSize(t *testing.T) {
+	it.MapTester(t, func(t *testing.T, m *hz.Map) {
+		_, err := m.GetEntrySetWithPredicate(context.Background(), predicate.Paging(nil, 0))
+		if !errors.Is(err, hzerrors.ErrIllegalArgument) {
+			t.Fatalf("expected ErrIllegalArgument, got: %v", err)
+		}
+	})
+}
+
+func mapGetKeySetWithPagingPredicate(t *testing.T) {
+	it.MapTester(t, func(t *testing.T, m *hz.Map) {
+		_, err := m.GetKeySetWithPredicate(context.Background(), predicate.Paging(nil, 10))
+		if !errors.Is(err, hzerrors.ErrIllegalArgument) {
+			t.Fatalf("expected ErrIllegalArgument, got: %v", err)
+		}
+	})
+}
+
+func mapGetValuesWithPagingPredicate(t *testing.T) {
+	it.MapTester(t, func(t *testing.T, m *hz.Map) {
+		_, err := m.GetValuesWithPredicate(context.Background(), predicate.Paging(nil, 10))
+		if !errors.Is(err, hzerrors.ErrIllegalArgument) {
+			t.Fatalf("expected ErrIllegalArgument, got: %v", err)
+		}
+	})
+}
+
+func mapGetEntrySetWithPredicateStream(t *testing.T) {
+	it.MapTester(t, func(t *testing.T, m *hz.Map) {
+		ctx := context.Background()
+		const count = 1000
+		entries := make([]types.Entry, count)
+		for i := 0; i < count; i++ {
+			entries[i] = types.NewEntry(int64(i), int64(i))
+		}
+		it.Must(m.PutAll(ctx, entries...))
+		// 900 entries in batches of 64, the last batch is not full
+		iter, err := m.GetEntrySetWithPredicateStream(ctx, predicate.GreaterOrEqual("this", int64(100)), 64)
+		if err != nil {
+			t.Fatal(err)
+		}
+		seen := map[int64]struct{}{}
+		for iter.HasNext() {
+			entry, err := iter.Next()
+			if err != nil {
+				t.Fatal(err)
+			}
+			key := entry.Key.(int64)
+			if _, ok := seen[key]; ok {
+				t.Fatalf("duplicate key: %d", key)
+			}
+			seen[key] = struct{}{}
+			assert.Equal(t, key, entry.Value)
+			assert.GreaterOrEqual(t, key, int64(100))
+		}
+		assert.Equal(t, count-100, len(seen))
+		assert.False(t, iter.HasNext())
+		// nil predicate returns all entries, the last batch is empty
+		iter, err = m.GetEntrySetWithPredicateStream(ctx, nil, 100)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var n int
+		for iter.HasNext() {
+			if _, err := iter.Next(); err != nil {
+				t.Fatal(err)
+			}
+			n++
+		}
+		assert.Equal(t, count, n)
+	})
+}
+
+func mapGetEntrySetWithPredicateStreamCancel(t *testing.T) {
+	it.MapTester(t, func(t *testing.T, m *hz.Map) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		for i := 0; i < 20; i++ {
+			it.Must(m.Set(ctx, int64(i), int64(i)))
+		}
+		iter, err := m.GetEntrySetWithPredicateStream(ctx, nil, 10)
+		if err != nil {
+			t.Fatal(err)
+		}
+		// consume the first batch
+		for i := 0; i < 10; i++ {
+			assert.True(t, iter.HasNext())
+			it.MustValue(iter.Next())
+		}
+		cancel()
+		assert.True(t, iter.HasNext())
+		_, err = iter.Next()
+		assert.True(t, errors.Is(err, context.Canceled), err)
+		assert.False(t, iter.HasNext())
+	})
+}
+
+func mapGetEntrySetWithPredicateStreamInvalidArgs(t *testing.T) {
+	it.MapTester(t, func(t *testing.T, m *hz.Map) {
+		ctx := context.Background()
+		_, err := m.GetEntrySetWithPredicateStream(ctx, nil, 0)
+		assert.True(t, errors.Is(err, hzerrors.ErrIllegalArgument), err)
+		_, err = m.GetEntrySetWithPredicateStream(ctx, predicate.Paging(nil, 10), 10)
+		assert.True(t, errors.Is(err, hzerrors.ErrIllegalArgument), err)
+	})
+}
+
 func mapGetEntrySetWithPredicateUsingJSON(t *testing.T) {
 	it.MapTester(t, func(t *testing.T, m *hz.Map) {
 		entries := []types.Entry{
@@ -604,6 +976,64 @@ func mapGetEntryView_KeyNotFound(t *testing.T) {
 	})
 }
 
+func mapGetEntryViews(t *testing.T) {
+	it.MapTester(t, func(t *testing.T, m *hz.Map) {
+		ctx := context.Background()
+		it.Must(m.Set(ctx, "k1", "v1"))
+		it.Must(m.Set(ctx, "k2", "v2"))
+		evs, err := m.GetEntryViews(ctx, "k1", "k2", "k3", "k1")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !assert.Len(t, evs, 2) {
+			t.FailNow()
+		}
+		byKey := map[interface{}]*types.SimpleEntryView{}
+		for _, ev := range evs {
+			byKey[ev.Key] = ev
+		}
+		assert.Equal(t, "v1", byKey["k1"].Value)
+		assert.Equal(t, "v2", byKey["k2"].Value)
+		assert.Greater(t, byKey["k1"].CreationTime, int64(0))
+		assert.Greater(t, byKey["k2"].CreationTime, int64(0))
+		_, found := byKey["k3"]
+		assert.False(t, found)
+		evs, err = m.GetEntryViews(ctx)
+		assert.NoError(t, err)
+		assert.Nil(t, evs)
+		// more keys than the number of requests in flight
+		keys := make([]interface{}, 1000)
+		for i := range keys {
+			keys[i] = fmt.Sprintf("k%d", i)
+		}
+		evs, err = m.GetEntryViews(ctx, keys...)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Len(t, evs, 2)
+	})
+}
+
+func mapAddIndexSortedRangeQuery(t *testing.T) {
+	it.MapTester(t, func(t *testing.T, m *hz.Map) {
+		ctx := context.Background()
+		indexConfig := types.IndexConfig{
+			Name:       "age-index",
+			Type:       types.IndexTypeSorted,
+			Attributes: []string{"age"},
+		}
+		it.Must(m.AddIndex(ctx, indexConfig))
+		for i := 0; i < 10; i++ {
+			it.Must(m.Set(ctx, fmt.Sprintf("k%d", i), serialization.JSON(fmt.Sprintf(`{"age": %d}`, i*10))))
+		}
+		keys, err := m.GetKeySetWithPredicate(ctx, predicate.Between("age", 25, 55))
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.ElementsMatch(t, []interface{}{"k3", "k4", "k5"}, keys)
+	})
+}
+
 func mapAddIndexWithConfig(t *testing.T) {
 	it.MapTester(t, func(t *testing.T, m *hz.Map) {
 		it.Must(m.Set(context.Background(), "k1", serialization.JSON(`{"A": 10, "B": 40}`)))
@@ -667,6 +1097,74 @@ func mapLoadAllWithoutReplacing(t *testing.T) {
 	})
 }
 
+func mapLoadAll(t *testing.T) {
+	// NOTE: do not parallize this test, it uses a static map name.
+	makeMapName := func(_ ...string) string {
+		// the map name for this test should be static, since only that map has a MapLoader.
+		return "test-map"
+	}
+	it.MapTesterWithConfigAndName(t, makeMapName, nil, func(t *testing.T, m *hz.Map) {
+		ctx := context.Background()
+		keys := putSampleKeyValues(m, 10)
+		it.Must(m.EvictAll(ctx))
+		assert.Equal(t, 0, it.MustValue(m.Size(ctx)))
+		it.Must(m.LoadAll(ctx, false))
+		assert.Equal(t, len(keys), it.MustValue(m.Size(ctx)))
+		// the value is changed only in the map, the loaded value replaces it.
+		it.Must(m.PutTransient(ctx, "k0", "new-v0"))
+		it.Must(m.LoadAll(ctx, true))
+		assert.Equal(t, "v0", it.MustValue(m.Get(ctx, "k0")))
+	})
+}
+
+func mapLoadAllWithKeys(t *testing.T) {
+	// NOTE: do not parallize this test, it uses a static map name.
+	makeMapName := func(_ ...string) string {
+		// the map name for this test should be static, since only that map has a MapLoader.
+		return "test-map"
+	}
+	it.MapTesterWithConfigAndName(t, makeMapName, nil, func(t *testing.T, m *hz.Map) {
+		ctx := context.Background()
+		putSampleKeyValues(m, 10)
+		it.Must(m.EvictAll(ctx))
+		it.Must(m.LoadAllWithKeys(ctx, []interface{}{"k0", "k1", "k2"}, false))
+		assert.Equal(t, 3, it.MustValue(m.Size(ctx)))
+		// an empty slice loads nothing.
+		it.Must(m.LoadAllWithKeys(ctx, []interface{}{}, false))
+		assert.Equal(t, 3, it.MustValue(m.Size(ctx)))
+		it.Must(m.PutTransient(ctx, "k0", "new-v0"))
+		it.Must(m.LoadAllWithKeys(ctx, []interface{}{"k0"}, false))
+		assert.Equal(t, "new-v0", it.MustValue(m.Get(ctx, "k0")))
+		it.Must(m.LoadAllWithKeys(ctx, []interface{}{"k0"}, true))
+		assert.Equal(t, "v0", it.MustValue(m.Get(ctx, "k0")))
+	})
+}
+
+func mapLoadAllWithKeysNilKeys(t *testing.T) {
+	it.MapTester(t, func(t *testing.T, m *hz.Map) {
+		err := m.LoadAllWithKeys(context.Background(), nil, false)
+		if !errors.Is(err, hzerrors.ErrIllegalArgument) {
+			t.Fatalf("expected ErrIllegalArgument, got: %v", err)
+		}
+	})
+}
+
+func mapLoadAllWithoutMapLoader(t *testing.T) {
+	it.MapTester(t, func(t *testing.T, m *hz.Map) {
+		err := m.LoadAll(context.Background(), false)
+		if !errors.Is(err, hzerrors.ErrIllegalArgument) {
+			t.Fatalf("expected ErrIllegalArgument, got: %v", err)
+		}
+		err = m.LoadAllWithKeys(context.Background(), []interface{}{"k0"}, false)
+		if !errors.Is(err, hzerrors.ErrIllegalArgument) {
+			t.Fatalf("expected ErrIllegalArgument, got: %v", err)
+		}
+		// loading no keys is a no-op, so it does not fail.
+		assert.NoError(t, m.LoadAllReplacing(context.Background()))
+		assert.NoError(t, m.LoadAllWithoutReplacing(context.Background()))
+	})
+}
+
 func mapLoadAllReplacing(t *testing.T) {
 	// NOTE: do not parallize this test, it uses a static map name.
 	makeMapName := func(_ ...string) string {
@@ -857,6 +1355,50 @@ func mapReplaceIfSame(t *testing.T) {
 	})
 }
 
+func mapEntryNotifiedEventUntilDone(t *testing.T) {
+	it.MapTester(t, func(t *testing.T, m *hz.Map) {
+		var callCount int32
+		handler := func(event *hz.EntryNotified) {
+			atomic.AddInt32(&callCount, 1)
+		}
+		listenerConfig := hz.MapEntryListenerConfig{}
+		listenerConfig.NotifyEntryAdded(true)
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		subscriptionID, err := m.AddEntryListenerUntilDone(ctx, listenerConfig, handler)
+		if err != nil {
+			t.Fatal(err)
+		}
+		it.MustValue(m.Put(context.Background(), "key-0", "value"))
+		it.Eventually(t, func() bool {
+			return atomic.LoadInt32(&callCount) == 1
+		})
+		cancel()
+		// the listener is removed in the background, so wait until the member does not send events anymore.
+		var i int
+		it.Eventually(t, func() bool {
+			i++
+			atomic.StoreInt32(&callCount, 0)
+			it.MustValue(m.Put(context.Background(), fmt.Sprintf("key-%d", i), "value"))
+			time.Sleep(200 * time.Millisecond)
+			return atomic.LoadInt32(&callCount) == 0
+		})
+		// removing the listener again is safe
+		if err := m.RemoveEntryListener(context.Background(), subscriptionID); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+func mapEntryNotifiedEventUntilDoneInvalidContext(t *testing.T) {
+	it.MapTester(t, func(t *testing.T, m *hz.Map) {
+		_, err := m.AddEntryListenerUntilDone(context.Background(), hz.MapEntryListenerConfig{}, func(event *hz.EntryNotified) {})
+		if !errors.Is(err, hzerrors.ErrIllegalArgument) {
+			t.Fatalf("expected ErrIllegalArgument, got: %v", err)
+		}
+	})
+}
+
 func mapEntryNotifiedEvent(t *testing.T) {
 	it.MapTester(t, func(t *testing.T, m *hz.Map) {
 		const totalCallCount = int32(100)
@@ -951,6 +1493,197 @@ func mapEntryNotifiedEventWithAddListener(t *testing.T) {
 	})
 }
 
+func mapEntryNotifiedEventIncludeInitial(t *testing.T) {
+	it.MapTester(t, func(t *testing.T, m *hz.Map) {
+		ctx := context.Background()
+		it.Must(m.Set(ctx, "k1", "v1"))
+		it.Must(m.Set(ctx, "k2", "v2"))
+		var mu sync.Mutex
+		var keys []interface{}
+		handler := func(event *hz.EntryNotified) {
+			mu.Lock()
+			defer mu.Unlock()
+			keys = append(keys, event.Key)
+		}
+		listenerConfig := hz.MapEntryListenerConfig{
+			IncludeValue:   true,
+			IncludeInitial: true,
+		}
+		listenerConfig.NotifyEntryAdded(true)
+		subscriptionID, err := m.AddEntryListener(ctx, listenerConfig, handler)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer m.RemoveEntryListener(ctx, subscriptionID)
+		it.Must(m.Set(ctx, "k3", "v3"))
+		it.Eventually(t, func() bool {
+			mu.Lock()
+			defer mu.Unlock()
+			return len(keys) == 3
+		})
+		mu.Lock()
+		defer mu.Unlock()
+		assert.ElementsMatch(t, []interface{}{"k1", "k2"}, keys[:2])
+		assert.Equal(t, "k3", keys[2])
+	})
+}
+
+func mapEntryNotifiedEventIncludeInitialWithAddListenerWithConfig(t *testing.T) {
+	it.MapTester(t, func(t *testing.T, m *hz.Map) {
+		ctx := context.Background()
+		it.Must(m.Set(ctx, "k1", "v1"))
+		it.Must(m.Set(ctx, "k2", "v2"))
+		var mu sync.Mutex
+		var events []string
+		listener := hz.MapListener{
+			EntryAdded: func(event *hz.EntryNotified) {
+				mu.Lock()
+				defer mu.Unlock()
+				events = append(events, fmt.Sprintf("added:%v", event.Key))
+			},
+			EntryUpdated: func(event *hz.EntryNotified) {
+				mu.Lock()
+				defer mu.Unlock()
+				events = append(events, fmt.Sprintf("updated:%v", event.Key))
+			},
+		}
+		listenerConfig := hz.MapEntryListenerConfig{
+			IncludeValue:   true,
+			IncludeInitial: true,
+		}
+		subscriptionID, err := m.AddListenerWithConfig(ctx, listener, listenerConfig)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer m.RemoveListener(ctx, subscriptionID)
+		it.Must(m.Set(ctx, "k1", "v1-new"))
+		it.Eventually(t, func() bool {
+			mu.Lock()
+			defer mu.Unlock()
+			return len(events) == 3
+		})
+		mu.Lock()
+		defer mu.Unlock()
+		assert.ElementsMatch(t, []string{"added:k1", "added:k2"}, events[:2])
+		assert.Equal(t, "updated:k1", events[2])
+	})
+}
+
+func mapEntryNotifiedEventIncludeInitialWithoutEntryAdded(t *testing.T) {
+	it.MapTester(t, func(t *testing.T, m *hz.Map) {
+		ctx := context.Background()
+		it.Must(m.Set(ctx, "k1", "v1"))
+		it.Must(m.Set(ctx, "k2", "v2"))
+		var mu sync.Mutex
+		var events []string
+		handler := func(event *hz.EntryNotified) {
+			mu.Lock()
+			defer mu.Unlock()
+			events = append(events, fmt.Sprintf("%d:%v", event.EventType, event.Key))
+		}
+		listenerConfig := hz.MapEntryListenerConfig{
+			IncludeValue:   true,
+			IncludeInitial: true,
+		}
+		// the listener is not subscribed to EntryAdded events, so the initial entries are not delivered.
+		listenerConfig.NotifyEntryUpdated(true)
+		listenerConfig.NotifyEntryRemoved(true)
+		subscriptionID, err := m.AddEntryListener(ctx, listenerConfig, handler)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer m.RemoveEntryListener(ctx, subscriptionID)
+		it.Must(m.Set(ctx, "k1", "v1-new"))
+		it.MustValue(m.Remove(ctx, "k2"))
+		it.Eventually(t, func() bool {
+			mu.Lock()
+			defer mu.Unlock()
+			return len(events) == 2
+		})
+		mu.Lock()
+		defer mu.Unlock()
+		assert.ElementsMatch(t, []string{fmt.Sprintf("%d:k1", hz.EntryUpdated), fmt.Sprintf("%d:k2", hz.EntryRemoved)}, events)
+	})
+}
+
+func mapEntryNotifiedEventMergedWithMergePolicy(t *testing.T) {
+	tcx := it.MapTestContext{T: t}
+	tcx.Tester(func(tcx it.MapTestContext) {
+		t := tcx.T
+		ctx := context.Background()
+		mapName := tcx.MapName + "-merged"
+		cfg := hz.MapConfig{Name: mapName}
+		cfg.MergePolicy.Policy = hz.MergePolicyPassThrough
+		it.Must(tcx.Client.AddMapConfig(ctx, cfg))
+		m := it.MustValue(tcx.Client.GetMap(ctx, mapName)).(*hz.Map)
+		defer m.Destroy(ctx)
+		events := make(chan *hz.EntryNotified, 1)
+		listener := hz.MapListener{
+			EntryMerged: func(event *hz.EntryNotified) {
+				events <- event
+			},
+		}
+		subscriptionID := it.MustValue(m.AddListener(ctx, listener, true)).(types.UUID)
+		defer m.RemoveListener(ctx, subscriptionID)
+		// the test cluster cannot heal a split-brain, so the merged event is published on the member the same way merging an entry publishes it.
+		tcx.ExecuteScript(ctx, fmt.Sprintf(`
+			var nodeEngine = instance_0.getOriginal().node.getNodeEngine();
+			var ss = nodeEngine.getSerializationService();
+			var publisher = nodeEngine.getService("hz:impl:mapService").getMapServiceContext().getMapEventPublisher();
+			var EntryEventType = Java.type("com.hazelcast.core.EntryEventType");
+			publisher.publishEvent(nodeEngine.getThisAddress(), "%s", EntryEventType.MERGED, ss.toData("key"), null, ss.toData("existing"), ss.toData("merging"));
+		`, mapName))
+		select {
+		case event := <-events:
+			assert.Equal(t, hz.EntryMerged, event.EventType)
+			assert.Equal(t, mapName, event.MapName)
+			assert.Equal(t, "key", event.Key)
+			assert.Equal(t, "existing", event.Value)
+			assert.Equal(t, "merging", event.MergingValue)
+		case <-time.After(10 * time.Second):
+			t.Fatal("the merged event was not received")
+		}
+	})
+}
+
+func mapEntryNotifiedEventIncludeInitialWithPredicate(t *testing.T) {
+	it.MapTester(t, func(t *testing.T, m *hz.Map) {
+		ctx := context.Background()
+		for i := 0; i < 10; i++ {
+			it.Must(m.Set(ctx, fmt.Sprintf("k%d", i), int32(i)))
+		}
+		var mu sync.Mutex
+		var values []interface{}
+		handler := func(event *hz.EntryNotified) {
+			mu.Lock()
+			defer mu.Unlock()
+			values = append(values, event.Value)
+		}
+		listenerConfig := hz.MapEntryListenerConfig{
+			Predicate:      predicate.GreaterOrEqual("this", int32(8)),
+			IncludeValue:   true,
+			IncludeInitial: true,
+		}
+		listenerConfig.NotifyEntryAdded(true)
+		subscriptionID, err := m.AddEntryListener(ctx, listenerConfig, handler)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer m.RemoveEntryListener(ctx, subscriptionID)
+		it.Must(m.Set(ctx, "k10", int32(10)))
+		it.Must(m.Set(ctx, "k-1", int32(-1)))
+		it.Eventually(t, func() bool {
+			mu.Lock()
+			defer mu.Unlock()
+			return len(values) == 3
+		})
+		mu.Lock()
+		defer mu.Unlock()
+		assert.ElementsMatch(t, []interface{}{int32(8), int32(9)}, values[:2])
+		assert.Equal(t, int32(10), values[2])
+	})
+}
+
 func mapEntryNotifiedEventToKey(t *testing.T) {
 	it.MapTester(t, func(t *testing.T, m *hz.Map) {
 		callCount := int32(0)
@@ -1069,6 +1802,69 @@ func mapEntryNotifiedEventWithPredicateWithAddListenerWithPredicate(t *testing.T
 	})
 }
 
+func mapEntryNotifiedEventWithNumericPredicate(t *testing.T) {
+	cbCallback := func(config *hz.Config) {
+		config.Serialization.SetPortableFactories(it.SamplePortableFactory{})
+	}
+	it.MapTesterWithConfig(t, cbCallback, func(t *testing.T, m *hz.Map) {
+		ctx := context.Background()
+		var mu sync.Mutex
+		var events []string
+		handler := func(event *hz.EntryNotified) {
+			mu.Lock()
+			defer mu.Unlock()
+			var b int32 = -1
+			if v, ok := event.Value.(*it.SamplePortable); ok {
+				b = v.B
+			} else if v, ok := event.OldValue.(*it.SamplePortable); ok {
+				b = v.B
+			}
+			events = append(events, fmt.Sprintf("%d:%s:%d", event.EventType, event.Key, b))
+		}
+		subID, err := m.AddListenerWithPredicate(ctx, hz.MapListener{
+			EntryAdded:   handler,
+			EntryUpdated: handler,
+			EntryRemoved: handler,
+		}, predicate.Greater("B", int32(4)), true)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for i := 0; i < 10; i++ {
+			it.MustValue(m.Put(ctx, fmt.Sprintf("k%d", i), &it.SamplePortable{A: "foo", B: int32(i)}))
+		}
+		// only the update of k7 matches the predicate
+		it.MustValue(m.Put(ctx, "k1", &it.SamplePortable{A: "foo", B: 2}))
+		it.MustValue(m.Put(ctx, "k7", &it.SamplePortable{A: "foo", B: 8}))
+		// only the removal of k9 matches the predicate
+		it.MustValue(m.Remove(ctx, "k0"))
+		it.MustValue(m.Remove(ctx, "k9"))
+		target := []string{
+			fmt.Sprintf("%d:k5:5", hz.EntryAdded),
+			fmt.Sprintf("%d:k6:6", hz.EntryAdded),
+			fmt.Sprintf("%d:k7:7", hz.EntryAdded),
+			fmt.Sprintf("%d:k8:8", hz.EntryAdded),
+			fmt.Sprintf("%d:k9:9", hz.EntryAdded),
+			fmt.Sprintf("%d:k7:8", hz.EntryUpdated),
+			fmt.Sprintf("%d:k9:9", hz.EntryRemoved),
+		}
+		it.Eventually(t, func() bool {
+			mu.Lock()
+			defer mu.Unlock()
+			return len(events) == len(target)
+		})
+		mu.Lock()
+		assert.ElementsMatch(t, target, events)
+		mu.Unlock()
+		// no events are delivered after the listener is removed
+		it.Must(m.RemoveListener(ctx, subID))
+		it.MustValue(m.Put(ctx, "k10", &it.SamplePortable{A: "foo", B: 10}))
+		time.Sleep(1 * time.Second)
+		mu.Lock()
+		assert.Equal(t, len(target), len(events))
+		mu.Unlock()
+	})
+}
+
 func mapEntryNotifiedEventToKeyAndPredicate(t *testing.T) {
 	cbCallback := func(config *hz.Config) {
 		config.Serialization.SetPortableFactories(it.SamplePortableFactory{})
@@ -1157,6 +1953,88 @@ func mapDestroyWithNearCache(t *testing.T) {
 	})
 }
 
+func mapMaxValueSize(t *testing.T) {
+	const maxValueSize = 1024
+	configCallback := func(config *hz.Config) {
+		config.MaxValueSize = maxValueSize
+	}
+	it.MapTesterWithConfig(t, configCallback, func(t *testing.T, m *hz.Map) {
+		ctx := context.Background()
+		// a serialized string has 12 bytes of header and length.
+		under := strings.Repeat("a", maxValueSize-12)
+		over := strings.Repeat("a", maxValueSize-11)
+		it.Must(m.Set(ctx, "under", under))
+		it.AssertEquals(t, under, it.MustValue(m.Get(ctx, "under")))
+		err := m.Set(ctx, "over", over)
+		assert.True(t, errors.Is(err, hzerrors.ErrIllegalArgument))
+		it.AssertEquals(t, nil, it.MustValue(m.Get(ctx, "over")))
+		err = m.PutAll(ctx, types.Entry{Key: "over", Value: over})
+		assert.True(t, errors.Is(err, hzerrors.ErrIllegalArgument))
+	})
+}
+
+func mapNilKeyAndValue(t *testing.T) {
+	it.MapTester(t, func(t *testing.T, m *hz.Map) {
+		ctx := context.Background()
+		var nilPtr *string
+		_, err := m.Put(ctx, nil, "value")
+		assert.True(t, errors.Is(err, hzerrors.ErrIllegalArgument))
+		_, err = m.Put(ctx, "key", nil)
+		assert.True(t, errors.Is(err, hzerrors.ErrIllegalArgument))
+		err = m.Set(ctx, nilPtr, "value")
+		assert.True(t, errors.Is(err, hzerrors.ErrIllegalArgument))
+		err = m.Set(ctx, "key", nilPtr)
+		assert.True(t, errors.Is(err, hzerrors.ErrIllegalArgument))
+		_, err = m.Get(ctx, nil)
+		assert.True(t, errors.Is(err, hzerrors.ErrIllegalArgument))
+		_, err = m.ContainsValue(ctx, nil)
+		assert.True(t, errors.Is(err, hzerrors.ErrIllegalArgument))
+		err = m.PutAll(ctx, types.NewEntry("key", nil))
+		assert.True(t, errors.Is(err, hzerrors.ErrIllegalArgument))
+		// a missing key reads back as nil
+		assert.Equal(t, nil, it.MustValue(m.Get(ctx, "missing-key")))
+	})
+}
+
+func mapNilKeyWithNearCache(t *testing.T) {
+	mapNilKeyWithNearCacheConfig(t, false)
+}
+
+func mapNilKeyWithNearCacheSerializeKeys(t *testing.T) {
+	mapNilKeyWithNearCacheConfig(t, true)
+}
+
+func mapNilKeyWithNearCacheConfig(t *testing.T, serializeKeys bool) {
+	tcx := it.MapTestContext{
+		T: t,
+		ConfigCallback: func(tcx it.MapTestContext) {
+			ncc := nearcache.Config{Name: tcx.MapName, SerializeKeys: serializeKeys}
+			tcx.Config.AddNearCache(ncc)
+		},
+	}
+	tcx.Tester(func(tcx it.MapTestContext) {
+		t := tcx.T
+		m := tcx.M
+		ctx := context.Background()
+		var nilPtr *string
+		_, err := m.Put(ctx, nil, "value")
+		assert.True(t, errors.Is(err, hzerrors.ErrIllegalArgument))
+		_, err = m.Put(ctx, "key", nil)
+		assert.True(t, errors.Is(err, hzerrors.ErrIllegalArgument))
+		_, err = m.Get(ctx, nil)
+		assert.True(t, errors.Is(err, hzerrors.ErrIllegalArgument))
+		_, err = m.Get(ctx, nilPtr)
+		assert.True(t, errors.Is(err, hzerrors.ErrIllegalArgument))
+		_, err = m.ContainsKey(ctx, nil)
+		assert.True(t, errors.Is(err, hzerrors.ErrIllegalArgument))
+		_, err = m.Remove(ctx, nil)
+		assert.True(t, errors.Is(err, hzerrors.ErrIllegalArgument))
+		err = m.Delete(ctx, nil)
+		assert.True(t, errors.Is(err, hzerrors.ErrIllegalArgument))
+		assert.Equal(t, nil, it.MustValue(m.Get(ctx, "missing-key")))
+	})
+}
+
 func mapAggregate(t *testing.T) {
 	cbCallback := func(config *hz.Config) {
 		config.Serialization.SetPortableFactories(it.SamplePortableFactory{})
@@ -1261,6 +2139,38 @@ func mapLockWithLease(t *testing.T) {
 	})
 }
 
+func mapLockWithLeaseRenewal(t *testing.T) {
+	it.MapTester(t, func(t *testing.T, m *hz.Map) {
+		const key = "foo"
+		const lease = 500 * time.Millisecond
+		ownerCtx := m.NewLockContext(context.Background())
+		it.Must(m.LockWithLeaseRenewal(ownerCtx, key, lease))
+		// hold the lock much longer than the lease.
+		time.Sleep(4 * lease)
+		otherCtx := m.NewLockContext(context.Background())
+		if it.MustBool(m.TryLock(otherCtx, key)) {
+			t.Fatalf("the lock was lost while the lease was renewed")
+		}
+		it.Must(m.Set(ownerCtx, key, "bar"))
+		it.Must(m.Unlock(ownerCtx, key))
+		if !it.MustBool(m.TryLock(otherCtx, key)) {
+			t.Fatalf("the lock was not released after unlock")
+		}
+		it.Must(m.Unlock(otherCtx, key))
+	})
+}
+
+func mapLockWithLeaseRenewalInvalidLease(t *testing.T) {
+	it.MapTester(t, func(t *testing.T, m *hz.Map) {
+		const key = "foo"
+		ctx := m.NewLockContext(context.Background())
+		err := m.LockWithLeaseRenewal(ctx, key, 0)
+		if !errors.Is(err, hzerrors.ErrIllegalArgument) {
+			t.Fatalf("expected illegal argument error, got: %v", err)
+		}
+	})
+}
+
 func mapTryLockWithLease(t *testing.T) {
 	it.MapTester(t, func(t *testing.T, m *hz.Map) {
 		wg := &sync.WaitGroup{}
@@ -1337,6 +2247,34 @@ func mapSetTTL(t *testing.T) {
 	})
 }
 
+func mapSetTTLShort(t *testing.T) {
+	it.MapTester(t, func(t *testing.T, m *hz.Map) {
+		ctx := context.Background()
+		it.Must(m.Set(ctx, "key", "value"))
+		it.Must(m.Set(ctx, "other", "value"))
+		it.Must(m.SetTTL(ctx, "key", time.Second))
+		// the value is not changed.
+		assert.Equal(t, "value", it.MustValue(m.Get(ctx, "key")))
+		it.Eventually(t, func() bool {
+			return it.MustValue(m.Get(ctx, "key")) == nil
+		})
+		assert.Equal(t, "value", it.MustValue(m.Get(ctx, "other")))
+	})
+}
+
+func mapSetTTLNegative(t *testing.T) {
+	it.SkipIf(t, "hz < 4.2")
+	it.MapTester(t, func(t *testing.T, m *hz.Map) {
+		ctx := context.Background()
+		it.Must(m.Set(ctx, "key", "value"))
+		it.Must(m.SetTTL(ctx, "key", 2*time.Second))
+		// a negative TTL reverts to the TTL of the map configuration, which is infinite.
+		assert.True(t, it.MustValue(m.SetTTLAffected(ctx, "key", -time.Nanosecond)).(bool))
+		time.Sleep(3 * time.Second)
+		assert.Equal(t, "value", it.MustValue(m.Get(ctx, "key")))
+	})
+}
+
 func mapSetTTLAffected(t *testing.T) {
 	it.SkipIf(t, "hz < 4.2")
 	it.MapTester(t, func(t *testing.T, m *hz.Map) {
@@ -1413,6 +2351,29 @@ func mapExecuteOnEntriesWithPredicate(t *testing.T) {
 	})
 }
 
+func mapExecuteOnEntriesWithPredicateNoMatch(t *testing.T) {
+	cb := func(c *hz.Config) {
+		c.Serialization.SetIdentifiedDataSerializableFactories(&SimpleEntryProcessorFactory{})
+	}
+	it.MapTesterWithConfig(t, cb, func(t *testing.T, m *hz.Map) {
+		ctx := context.Background()
+		for i := 0; i < 100; i++ {
+			it.Must(m.Set(ctx, fmt.Sprintf("k%d", i), int32(i)))
+		}
+		vs, err := m.ExecuteOnEntriesWithPredicate(ctx, &SimpleEntryProcessor{value: "test"}, predicate.Greater("this", int32(100)))
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Empty(t, vs)
+		// no entries were processed.
+		for i := 0; i < 100; i++ {
+			it.AssertEquals(t, int32(i), it.MustValue(m.Get(ctx, fmt.Sprintf("k%d", i))))
+		}
+		_, err = m.ExecuteOnEntriesWithPredicate(ctx, &SimpleEntryProcessor{value: "test"}, nil)
+		assert.True(t, errors.Is(err, hzerrors.ErrIllegalArgument))
+	})
+}
+
 func mapExecuteOnKey(t *testing.T) {
 	cb := func(c *hz.Config) {
 		c.Serialization.SetIdentifiedDataSerializableFactories(&SimpleEntryProcessorFactory{})
@@ -1431,6 +2392,25 @@ func mapExecuteOnKey(t *testing.T) {
 	})
 }
 
+func mapExecuteOnKeyAs(t *testing.T) {
+	cb := func(c *hz.Config) {
+		c.Serialization.SetIdentifiedDataSerializableFactories(&SimpleEntryProcessorFactory{})
+	}
+	it.MapTesterWithConfig(t, cb, func(t *testing.T, m *hz.Map) {
+		ctx := context.Background()
+		it.MustValue(m.Put(ctx, "k1", "my-value"))
+		v, err := hz.ExecuteOnKeyAs[string](ctx, m, &SimpleEntryProcessor{value: "test"}, "k1")
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, "test", v)
+		_, err = hz.ExecuteOnKeyAs[int64](ctx, m, &SimpleEntryProcessor{value: "other"}, "k1")
+		assert.True(t, errors.Is(err, hzerrors.ErrClassCast))
+		// the entry processor is applied even if the result could not be cast.
+		assert.Equal(t, "other", it.MustValue(m.Get(ctx, "k1")))
+	})
+}
+
 func mapExecuteOnKeys(t *testing.T) {
 	cb := func(c *hz.Config) {
 		c.Serialization.SetIdentifiedDataSerializableFactories(&SimpleEntryProcessorFactory{})
@@ -1459,6 +2439,54 @@ func mapExecuteOnKeys(t *testing.T) {
 	})
 }
 
+func mapSubmitToKey(t *testing.T) {
+	cb := func(c *hz.Config) {
+		c.Serialization.SetIdentifiedDataSerializableFactories(&SimpleEntryProcessorFactory{})
+	}
+	it.MapTesterWithConfig(t, cb, func(t *testing.T, m *hz.Map) {
+		ctx := context.Background()
+		const count = 20
+		futures := make([]*hz.EntryProcessorFuture, count)
+		for i := 0; i < count; i++ {
+			key := fmt.Sprintf("k%d", i)
+			it.MustValue(m.Put(ctx, key, "my-value"))
+			f, err := m.SubmitToKey(ctx, key, &SimpleEntryProcessor{value: fmt.Sprintf("v%d", i)})
+			if err != nil {
+				t.Fatal(err)
+			}
+			futures[i] = f
+		}
+		for i, f := range futures {
+			v, err := f.Get(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+			assert.Equal(t, fmt.Sprintf("v%d", i), v)
+			<-f.Done()
+			assert.Equal(t, fmt.Sprintf("v%d", i), it.MustValue(m.Get(ctx, fmt.Sprintf("k%d", i))))
+		}
+	})
+}
+
+func mapSubmitToKeyNilArgs(t *testing.T) {
+	it.MapTester(t, func(t *testing.T, m *hz.Map) {
+		ctx := context.Background()
+		_, err := m.SubmitToKey(ctx, nil, &SimpleEntryProcessor{value: "test"})
+		assert.True(t, errors.Is(err, hzerrors.ErrIllegalArgument))
+		_, err = m.SubmitToKey(ctx, "k1", nil)
+		assert.True(t, errors.Is(err, hzerrors.ErrIllegalArgument))
+	})
+}
+
+func mapSubmitToKeyNotSerializable(t *testing.T) {
+	it.MapTester(t, func(t *testing.T, m *hz.Map) {
+		// the entry processor is serialized before SubmitToKey returns, so the error is not deferred to the future.
+		f, err := m.SubmitToKey(context.Background(), "k1", make(chan int))
+		assert.Error(t, err)
+		assert.Nil(t, f)
+	})
+}
+
 func mapAddInterceptor(t *testing.T) {
 	it.MapTester(t, func(t *testing.T, m *hz.Map) {
 		ctx := context.Background()
@@ -1542,6 +2570,103 @@ func mapTryPutWithTimeout(t *testing.T) {
 	})
 }
 
+func mapIncrement(t *testing.T) {
+	it.MapTester(t, func(t *testing.T, m *hz.Map) {
+		ctx := context.Background()
+		// a missing key is treated as 0
+		assert.Equal(t, int64(5), it.MustValue(m.IncrementAndGet(ctx, "counter", 5)))
+		assert.Equal(t, int64(2), it.MustValue(m.IncrementAndGet(ctx, "counter", -3)))
+		it.Must(m.Increment(ctx, "counter", 10))
+		assert.Equal(t, int64(12), it.MustValue(m.Get(ctx, "counter")))
+	})
+}
+
+func mapIncrementConcurrent(t *testing.T) {
+	const goroutineCount = 50
+	const incrementCount = 20
+	it.MapTester(t, func(t *testing.T, m *hz.Map) {
+		ctx := context.Background()
+		wg := &sync.WaitGroup{}
+		wg.Add(goroutineCount)
+		for i := 0; i < goroutineCount; i++ {
+			go func() {
+				defer wg.Done()
+				for j := 0; j < incrementCount; j++ {
+					if err := m.Increment(ctx, "counter", 1); err != nil {
+						panic(err)
+					}
+				}
+			}()
+		}
+		wg.Wait()
+		assert.Equal(t, int64(goroutineCount*incrementCount), it.MustValue(m.Get(ctx, "counter")))
+	})
+}
+
+func mapIncrementNonInt64Value(t *testing.T) {
+	it.MapTester(t, func(t *testing.T, m *hz.Map) {
+		ctx := context.Background()
+		it.Must(m.Set(ctx, "counter", "not a number"))
+		_, err := m.IncrementAndGet(ctx, "counter", 1)
+		assert.True(t, errors.Is(err, hzerrors.ErrClassCast))
+		assert.Equal(t, "not a number", it.MustValue(m.Get(ctx, "counter")))
+	})
+}
+
+func mapTryPutWithTTL(t *testing.T) {
+	it.MapTester(t, func(t *testing.T, m *hz.Map) {
+		ctx := m.NewLockContext(context.Background())
+		ok, err := m.TryPutWithTTL(ctx, "foo", "bar", 1*time.Second, 1*time.Second)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, true, ok)
+		assert.Equal(t, "bar", it.MustValue(m.Get(ctx, "foo")))
+		// the key is unlocked after the put
+		assert.Equal(t, false, it.MustBool(m.IsLocked(ctx, "foo")))
+		// the entry expires after the TTL
+		it.Eventually(t, func() bool {
+			return it.MustValue(m.Get(ctx, "foo")) == nil
+		})
+	})
+}
+
+func mapTryPutWithTTLAcquireTimeout(t *testing.T) {
+	it.MapTester(t, func(t *testing.T, m *hz.Map) {
+		ctx1 := m.NewLockContext(context.Background())
+		it.Must(m.Lock(ctx1, "foo"))
+		defer m.Unlock(ctx1, "foo")
+		// TryPutWithTTL with a different lock context returns false after the acquire timeout
+		ctx2 := m.NewLockContext(context.Background())
+		start := time.Now()
+		ok, err := m.TryPutWithTTL(ctx2, "foo", "bar", 500*time.Millisecond, 1*time.Minute)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, false, ok)
+		assert.GreaterOrEqual(t, int64(time.Since(start)), int64(500*time.Millisecond))
+		assert.Nil(t, it.MustValue(m.Get(ctx1, "foo")))
+		// TryPutWithTTL with the same lock context returns true and keeps the lock
+		ok, err = m.TryPutWithTTL(ctx1, "foo", "bar", 0, 1*time.Minute)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, true, ok)
+		assert.Equal(t, true, it.MustBool(m.IsLocked(ctx1, "foo")))
+	})
+}
+
+func mapTryPutWithTTLInvalidDurations(t *testing.T) {
+	it.MapTester(t, func(t *testing.T, m *hz.Map) {
+		ctx := context.Background()
+		_, err := m.TryPutWithTTL(ctx, "foo", "bar", -1*time.Second, 1*time.Second)
+		assert.True(t, errors.Is(err, hzerrors.ErrIllegalArgument))
+		_, err = m.TryPutWithTTL(ctx, "foo", "bar", 1*time.Second, -1*time.Second)
+		assert.True(t, errors.Is(err, hzerrors.ErrIllegalArgument))
+		assert.Equal(t, false, it.MustBool(m.ContainsKey(ctx, "foo")))
+	})
+}
+
 func mapTryRemove(t *testing.T) {
 	it.MapTester(t, func(t *testing.T, m *hz.Map) {
 		ctx1 := m.NewLockContext(context.Background())
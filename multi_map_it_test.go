@@ -18,6 +18,7 @@ package hazelcast_test
 
 import (
 	"context"
+	"errors"
 	"sync"
 	"testing"
 	"time"
@@ -25,6 +26,7 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	hz "github.com/hazelcast/hazelcast-go-client"
+	"github.com/hazelcast/hazelcast-go-client/hzerrors"
 	"github.com/hazelcast/hazelcast-go-client/internal/it"
 	"github.com/hazelcast/hazelcast-go-client/types"
 )
@@ -503,3 +505,20 @@ func TestMultiMap_NonExistentKey(t *testing.T) {
 		assert.Equal(t, []interface{}{}, v)
 	})
 }
+
+func TestMultiMap_NilKeyAndValue(t *testing.T) {
+	it.MultiMapTester(t, func(t *testing.T, m *hz.MultiMap) {
+		ctx := context.Background()
+		var nilPtr *string
+		_, err := m.Put(ctx, nil, "value")
+		assert.True(t, errors.Is(err, hzerrors.ErrIllegalArgument))
+		_, err = m.Put(ctx, "key", nil)
+		assert.True(t, errors.Is(err, hzerrors.ErrIllegalArgument))
+		_, err = m.Put(ctx, "key", nilPtr)
+		assert.True(t, errors.Is(err, hzerrors.ErrIllegalArgument))
+		_, err = m.Get(ctx, nil)
+		assert.True(t, errors.Is(err, hzerrors.ErrIllegalArgument))
+		_, err = m.ContainsEntry(ctx, "key", nil)
+		assert.True(t, errors.Is(err, hzerrors.ErrIllegalArgument))
+	})
+}
@@ -31,27 +31,54 @@ const (
 	defaultEvictionPolicy           = EvictionPolicyLRU
 	defaultStoreInitialDelaySeconds = 600
 	defaultStoreIntervalSeconds     = 600
+	// see: com.hazelcast.internal.nearcache.NearCache#DEFAULT_EXPIRATION_TASK_PERIOD_SECONDS
+	defaultExpirationTaskPeriodSeconds = 5
 )
 
 // Config is the Near Cache configuration.
 type Config struct {
 	// Eviction is the optional eviction configuration for the Near Cache.
-	Eviction           EvictionConfig
+	Eviction EvictionConfig
+	// Preloader is the optional configuration for storing the keys of the Near Cache to disk and loading them back when the Near Cache is created.
+	Preloader          PreloaderConfig
 	invalidateOnChange *bool
 	// Name is the name of this Near Cache configuration.
 	// If the name is not specified, it is set to "default".
 	Name string
+	// TimeToLiveSeconds is the maximum number of seconds for an entry to stay in the Near Cache after it is written, regardless of reads.
+	// Reading an expired entry removes it from the Near Cache and fetches it from the cluster.
+	// If MaxIdleSeconds is also set, an entry expires at whichever limit is hit first.
 	// Must be non-negative.
 	// The value 0 means math.MaxInt32
 	// The default is 0.
 	TimeToLiveSeconds int
+	// NilTimeToLiveSeconds is the maximum number of seconds for an entry to stay in the Near Cache if it caches that the key was not found in the map.
+	// Keys often appear shortly after they are missed, so this can be set lower than TimeToLiveSeconds to fetch missing keys sooner than existing ones.
+	// If it is greater than TimeToLiveSeconds, TimeToLiveSeconds applies.
+	// Must be non-negative.
+	// The value 0 means TimeToLiveSeconds applies.
+	// The default is 0.
+	NilTimeToLiveSeconds int `json:",omitempty"`
 	// MaxIdleSeconds is the maximum number of seconds each entry can stay in the Near Cache as untouched (not-read).
 	// Entries that are not read (touched) more than MaxIdleSeconds value will get removed from the Near Cache.
-	// Accepts any integer between {@code 0} and {@link Integer#MAX_VALUE}.
+	// Reading an idle entry removes it from the Near Cache and fetches it from the cluster.
 	// Must be non-negative.
 	// The value 0 means math.MaxInt32
 	// The default is 0.
 	MaxIdleSeconds int
+	// ExpirationTaskPeriodSeconds is the number of seconds between the runs of the background task which removes the expired entries.
+	// The task runs only if TimeToLiveSeconds or MaxIdleSeconds is set.
+	// It removes the entries which expired because of time to live or max idle, even if they are not read.
+	// Must be non-negative.
+	// The default is 5.
+	ExpirationTaskPeriodSeconds int `json:",omitempty"`
+	// MaxEntryCount is the hard limit of the number of entries in the Near Cache.
+	// Once the Near Cache has that many entries, new entries are not cached and they are read from the cluster instead, independent of the eviction policy.
+	// Unlike Eviction.Size, reaching this limit never evicts existing entries.
+	// Must be non-negative.
+	// The value 0 means there is no hard limit.
+	// The default is 0.
+	MaxEntryCount int `json:",omitempty"`
 	// SerializeKeys specifies how the entry keys are stored in the Near Cache.
 	// If false, keys are stored in their original form.
 	// If true, keys are stored after serializing them.
@@ -62,19 +89,34 @@ type Config struct {
 	// InMemoryFormatBinary stores the values after serializing them.
 	// InMemoryFormatObject stores the values in their original form.
 	// The default is InMemoryFormatBinary.
+	// If the map stores values in OBJECT format on the member side, InMemoryFormatObject is usually the better choice.
+	// Otherwise, values are serialized by the member for each read and deserialized again on each Near Cache hit.
+	// The client does not check the in-memory format of the map, since that requires access to the member configuration.
 	InMemoryFormat InMemoryFormat
+	// LocalUpdatePolicy specifies how the Near Cache is updated when Map.Set or Map.SetWithTTL of this client succeeds.
+	// LocalUpdatePolicyInvalidate removes the entry from the Near Cache.
+	// LocalUpdatePolicyCacheOnUpdate stores the written value in the Near Cache, which speeds up reading the values this client writes.
+	// The Near Cache time to live applies to the cached value, not the time to live given to Map.SetWithTTL.
+	// Other writes always invalidate the entry.
+	// The default is LocalUpdatePolicyInvalidate.
+	LocalUpdatePolicy LocalUpdatePolicy `json:",omitempty"`
 }
 
 // Clone returns a copy of the configuration.
 func (c Config) Clone() Config {
 	return Config{
-		invalidateOnChange: c.invalidateOnChange,
-		Name:               c.Name,
-		Eviction:           c.Eviction.Clone(),
-		InMemoryFormat:     c.InMemoryFormat,
-		SerializeKeys:      c.SerializeKeys,
-		TimeToLiveSeconds:  c.TimeToLiveSeconds,
-		MaxIdleSeconds:     c.MaxIdleSeconds,
+		invalidateOnChange:          c.invalidateOnChange,
+		Name:                        c.Name,
+		Eviction:                    c.Eviction.Clone(),
+		Preloader:                   c.Preloader.Clone(),
+		InMemoryFormat:              c.InMemoryFormat,
+		SerializeKeys:               c.SerializeKeys,
+		TimeToLiveSeconds:           c.TimeToLiveSeconds,
+		NilTimeToLiveSeconds:        c.NilTimeToLiveSeconds,
+		MaxIdleSeconds:              c.MaxIdleSeconds,
+		ExpirationTaskPeriodSeconds: c.ExpirationTaskPeriodSeconds,
+		MaxEntryCount:               c.MaxEntryCount,
+		LocalUpdatePolicy:           c.LocalUpdatePolicy,
 	}
 }
 
@@ -89,18 +131,36 @@ func (c *Config) Validate() error {
 	if c.MaxIdleSeconds == 0 {
 		c.MaxIdleSeconds = math.MaxInt32
 	}
+	if c.ExpirationTaskPeriodSeconds == 0 {
+		c.ExpirationTaskPeriodSeconds = defaultExpirationTaskPeriodSeconds
+	}
 	if err := c.Eviction.Validate(); err != nil {
 		return err
 	}
+	if err := c.Preloader.Validate(); err != nil {
+		return err
+	}
 	if err := check.NonNegativeInt32Config(c.TimeToLiveSeconds); err != nil {
 		return fmt.Errorf("nearcache.Config: TimeToLiveSeconds: %w", err)
 	}
+	if err := check.NonNegativeInt32Config(c.NilTimeToLiveSeconds); err != nil {
+		return fmt.Errorf("nearcache.Config: NilTimeToLiveSeconds: %w", err)
+	}
 	if err := check.NonNegativeInt32Config(c.MaxIdleSeconds); err != nil {
 		return fmt.Errorf("nearcache.Config: MaxIdleSeconds: %w", err)
 	}
+	if err := check.NonNegativeInt32Config(c.ExpirationTaskPeriodSeconds); err != nil {
+		return fmt.Errorf("nearcache.Config: ExpirationTaskPeriodSeconds: %w", err)
+	}
+	if err := check.NonNegativeInt32Config(c.MaxEntryCount); err != nil {
+		return fmt.Errorf("nearcache.Config: MaxEntryCount: %w", err)
+	}
 	if c.InMemoryFormat != InMemoryFormatBinary && c.InMemoryFormat != InMemoryFormatObject {
 		return ihzerrors.NewInvalidConfigurationError("nearcache.Config: InMemoryFormat: invalid memory format", nil)
 	}
+	if c.LocalUpdatePolicy != LocalUpdatePolicyInvalidate && c.LocalUpdatePolicy != LocalUpdatePolicyCacheOnUpdate {
+		return ihzerrors.NewInvalidConfigurationError("nearcache.Config: LocalUpdatePolicy: invalid local update policy", nil)
+	}
 	return nil
 }
 
@@ -134,18 +194,84 @@ func (c *Config) UnmarshalJSON(b []byte) error {
 }
 
 func (c Config) MarshalJSON() ([]byte, error) {
-	cfg := *(*configForMarshal)(unsafe.Pointer(&c))
+	cfg := configWithPreloaderForMarshal{configForMarshal: *(*configForMarshal)(unsafe.Pointer(&c))}
+	// omitempty does not apply to struct fields, so the preloader configuration is left out explicitly if it is not set.
+	if c.Preloader != (PreloaderConfig{}) {
+		cfg.Preloader = &c.Preloader
+	}
 	return json.Marshal(cfg)
 }
 
 type configForMarshal struct {
-	Eviction           EvictionConfig
-	InvalidateOnChange *bool `json:",omitempty"`
-	Name               string
-	TimeToLiveSeconds  int
-	MaxIdleSeconds     int
-	SerializeKeys      bool
-	InMemoryFormat     InMemoryFormat
+	Eviction                    EvictionConfig
+	Preloader                   PreloaderConfig
+	InvalidateOnChange          *bool `json:",omitempty"`
+	Name                        string
+	TimeToLiveSeconds           int
+	NilTimeToLiveSeconds        int `json:",omitempty"`
+	MaxIdleSeconds              int
+	ExpirationTaskPeriodSeconds int `json:",omitempty"`
+	MaxEntryCount               int `json:",omitempty"`
+	SerializeKeys               bool
+	InMemoryFormat              InMemoryFormat
+	LocalUpdatePolicy           LocalUpdatePolicy `json:",omitempty"`
+}
+
+// configWithPreloaderForMarshal is used for marshaling Config to JSON.
+// Its Preloader field hides the one of configForMarshal.
+type configWithPreloaderForMarshal struct {
+	configForMarshal
+	Preloader *PreloaderConfig `json:",omitempty"`
+}
+
+/*
+PreloaderConfig is the configuration for storing the keys of a Near Cache to disk and pre-loading them.
+
+When the preloader is enabled, the key set of the Near Cache is written to a file in Directory periodically and when the client shuts down.
+The next time the Near Cache is created, the values of the stored keys are fetched from the cluster in the background, so the Near Cache is warm right after a restart.
+The file name is derived from the Near Cache name as nearCache-NAME.store, the same as Hazelcast members and Java clients use.
+Only the keys are stored, never the values.
+*/
+type PreloaderConfig struct {
+	// Directory is the directory to store the key files.
+	// It is created if it does not exist.
+	// The default is the current working directory.
+	Directory string `json:",omitempty"`
+	// StoreInitialDelaySeconds is the number of seconds to wait after the Near Cache is created before storing the keys the first time.
+	// Must be non-negative.
+	// The value 0 means the default, which is 600.
+	StoreInitialDelaySeconds int `json:",omitempty"`
+	// StoreIntervalSeconds is the number of seconds between storing the keys.
+	// Must be non-negative.
+	// The value 0 means the default, which is 600.
+	StoreIntervalSeconds int `json:",omitempty"`
+	// Enabled enables storing and pre-loading the keys.
+	// The default is false.
+	Enabled bool `json:",omitempty"`
+}
+
+// Clone returns a copy of the configuration.
+func (c PreloaderConfig) Clone() PreloaderConfig {
+	return c
+}
+
+// Validate validates the configuration and sets the defaults if the preloader is enabled.
+func (c *PreloaderConfig) Validate() error {
+	if c.Enabled {
+		if c.StoreInitialDelaySeconds == 0 {
+			c.StoreInitialDelaySeconds = defaultStoreInitialDelaySeconds
+		}
+		if c.StoreIntervalSeconds == 0 {
+			c.StoreIntervalSeconds = defaultStoreIntervalSeconds
+		}
+	}
+	if err := check.NonNegativeInt32Config(c.StoreInitialDelaySeconds); err != nil {
+		return fmt.Errorf("nearcache.Preloader: StoreInitialDelaySeconds: %w", err)
+	}
+	if err := check.NonNegativeInt32Config(c.StoreIntervalSeconds); err != nil {
+		return fmt.Errorf("nearcache.Preloader: StoreIntervalSeconds: %w", err)
+	}
+	return nil
 }
 
 /*
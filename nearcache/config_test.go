@@ -119,16 +119,30 @@ func TestDefaultConfig(t *testing.T) {
 		t.Fatal(err)
 	}
 	target := nearcache.Config{
-		Name:              "default",
-		Eviction:          nearcache.EvictionConfig{},
-		InMemoryFormat:    nearcache.InMemoryFormatBinary,
-		SerializeKeys:     false,
-		TimeToLiveSeconds: math.MaxInt32,
-		MaxIdleSeconds:    math.MaxInt32,
+		Name:                        "default",
+		Eviction:                    nearcache.EvictionConfig{},
+		InMemoryFormat:              nearcache.InMemoryFormatBinary,
+		SerializeKeys:               false,
+		TimeToLiveSeconds:           math.MaxInt32,
+		MaxIdleSeconds:              math.MaxInt32,
+		ExpirationTaskPeriodSeconds: 5,
 	}
 	assert.Equal(t, target, ncc)
 }
 
+func TestPreloaderConfig_Defaults(t *testing.T) {
+	pc := nearcache.PreloaderConfig{Enabled: true}
+	if err := pc.Validate(); err != nil {
+		t.Fatal(err)
+	}
+	target := nearcache.PreloaderConfig{
+		Enabled:                  true,
+		StoreInitialDelaySeconds: 600,
+		StoreIntervalSeconds:     600,
+	}
+	assert.Equal(t, target, pc)
+}
+
 type testCase struct {
 	name string
 	cfg  nearcache.Config
@@ -153,10 +167,34 @@ func TestConfigInvalid(t *testing.T) {
 			name: "negative max idle",
 			cfg:  nearcache.Config{MaxIdleSeconds: -1},
 		},
+		{
+			name: "negative expiration task period",
+			cfg:  nearcache.Config{ExpirationTaskPeriodSeconds: -1},
+		},
+		{
+			name: "negative nil time to live",
+			cfg:  nearcache.Config{NilTimeToLiveSeconds: -1},
+		},
+		{
+			name: "negative max entry count",
+			cfg:  nearcache.Config{MaxEntryCount: -1},
+		},
 		{
 			name: "invalid memory format",
 			cfg:  nearcache.Config{InMemoryFormat: 3},
 		},
+		{
+			name: "invalid local update policy",
+			cfg:  nearcache.Config{LocalUpdatePolicy: 2},
+		},
+		{
+			name: "negative preloader store initial delay",
+			cfg:  nearcache.Config{Preloader: nearcache.PreloaderConfig{Enabled: true, StoreInitialDelaySeconds: -1}},
+		},
+		{
+			name: "negative preloader store interval",
+			cfg:  nearcache.Config{Preloader: nearcache.PreloaderConfig{Enabled: true, StoreIntervalSeconds: -1}},
+		},
 	}
 	for _, tc := range testCases {
 		tc.Run(t)
@@ -236,17 +274,21 @@ func configJSONTestCases() []configJSONTestCase {
 	withEvc.Eviction = nearcache.EvictionConfig{}
 	withEvc.Eviction.SetPolicy(nearcache.EvictionPolicyLFU)
 	withEvc.Eviction.SetSize(400)
+	cacheOnUpdate := nearcache.Config{
+		Name:              "mymap*",
+		LocalUpdatePolicy: nearcache.LocalUpdatePolicyCacheOnUpdate,
+	}
 	return []configJSONTestCase{
 		{
 			name:           "empty",
 			text:           "{}",
-			marshalledText: `{"Name":"default","Eviction":{},"InMemoryFormat":"binary","SerializeKeys":false,"TimeToLiveSeconds":2147483647,"MaxIdleSeconds":2147483647}`,
+			marshalledText: `{"Name":"default","Eviction":{},"InMemoryFormat":"binary","SerializeKeys":false,"TimeToLiveSeconds":2147483647,"MaxIdleSeconds":2147483647,"ExpirationTaskPeriodSeconds":5}`,
 			cfg:            nearcache.Config{},
 		},
 		{
 			name:           "simple",
 			text:           `{"InvalidateOnChange": true, "Name": "mymap*"}`,
-			marshalledText: `{"InvalidateOnChange":true,"Name":"mymap*","Eviction":{},"InMemoryFormat":"binary","SerializeKeys":false,"TimeToLiveSeconds":2147483647,"MaxIdleSeconds":2147483647}`,
+			marshalledText: `{"InvalidateOnChange":true,"Name":"mymap*","Eviction":{},"InMemoryFormat":"binary","SerializeKeys":false,"TimeToLiveSeconds":2147483647,"MaxIdleSeconds":2147483647,"ExpirationTaskPeriodSeconds":5}`,
 			cfg:            simple,
 		},
 		{
@@ -266,13 +308,52 @@ func configJSONTestCases() []configJSONTestCase {
 				"InvalidateOnChange":false,
 				"Name":"mymap*",
 				"Eviction":{"Policy":"lfu","Size":400},
+				
 				"InMemoryFormat":"object",
 				"SerializeKeys":false,
 				"TimeToLiveSeconds":2147483647,
-				"MaxIdleSeconds":2147483647
+				"MaxIdleSeconds":2147483647,
+				"ExpirationTaskPeriodSeconds":5
 			}`,
 			cfg: withEvc,
 		},
+		{
+			name:           "with local update policy",
+			text:           `{"Name": "mymap*", "LocalUpdatePolicy": "CACHE_ON_UPDATE"}`,
+			marshalledText: `{"Name":"mymap*","Eviction":{},"InMemoryFormat":"binary","SerializeKeys":false,"TimeToLiveSeconds":2147483647,"MaxIdleSeconds":2147483647,"ExpirationTaskPeriodSeconds":5,"LocalUpdatePolicy":"cache_on_update"}`,
+			cfg:            cacheOnUpdate,
+		},
+		{
+			name: "with preloader config",
+			text: `
+					{
+						"Name": "mymap*",
+						"Preloader": {
+							"Enabled": true,
+							"Directory": "/tmp/hz",
+							"StoreIntervalSeconds": 30
+						}
+					}
+				`,
+			marshalledText: `{
+				"Name":"mymap*",
+				"Eviction":{},
+				"Preloader":{"Directory":"/tmp/hz","StoreInitialDelaySeconds":600,"StoreIntervalSeconds":30,"Enabled":true},
+				"InMemoryFormat":"binary",
+				"SerializeKeys":false,
+				"TimeToLiveSeconds":2147483647,
+				"MaxIdleSeconds":2147483647,
+				"ExpirationTaskPeriodSeconds":5
+			}`,
+			cfg: nearcache.Config{
+				Name: "mymap*",
+				Preloader: nearcache.PreloaderConfig{
+					Enabled:              true,
+					Directory:            "/tmp/hz",
+					StoreIntervalSeconds: 30,
+				},
+			},
+		},
 	}
 }
 
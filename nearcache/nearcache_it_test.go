@@ -150,6 +150,75 @@ func TestGetAllPopulatesNearCache(t *testing.T) {
 	})
 }
 
+func TestGetAllRequestsOnlyMissingKeys(t *testing.T) {
+	tcx := newNearCacheMapTestContext(t, nearcache.InMemoryFormatObject, false)
+	tcx.Tester(func(tcx it.MapTestContext) {
+		t := tcx.T
+		m := tcx.M
+		ctx := context.Background()
+		const size = 10
+		var keys []interface{}
+		var target []types.Entry
+		for i := int64(0); i < size; i++ {
+			if _, err := m.Put(ctx, i, i); err != nil {
+				t.Fatal(err)
+			}
+			keys = append(keys, i)
+			target = append(target, types.Entry{Key: i, Value: i})
+		}
+		// populate the Near Cache with the first half of the keys
+		for i := int64(0); i < size/2; i++ {
+			if _, err := m.Get(ctx, i); err != nil {
+				t.Fatal(err)
+			}
+		}
+		before := m.LocalMapStats().NearCacheStats
+		// duplicate and missing keys are not included in the result
+		vs, err := m.GetAll(ctx, append(keys, int64(0), int64(size), int64(size))...)
+		if err != nil {
+			t.Fatal(err)
+		}
+		require.ElementsMatch(t, target, vs)
+		stats := m.LocalMapStats().NearCacheStats
+		require.Equal(t, int64(size/2), stats.Hits-before.Hits)
+		require.Equal(t, int64(size/2+1), stats.Misses-before.Misses)
+		require.Equal(t, int64(size), stats.OwnedEntryCount)
+	})
+}
+
+func TestPutAllInvalidatesNearCache(t *testing.T) {
+	tcx := newNearCacheMapTestContext(t, nearcache.InMemoryFormatObject, false)
+	tcx.Tester(func(tcx it.MapTestContext) {
+		t := tcx.T
+		m := tcx.M
+		ctx := context.Background()
+		const size = 10
+		entries := make([]types.Entry, size)
+		for i := int64(0); i < size; i++ {
+			if _, err := m.Put(ctx, i, i); err != nil {
+				t.Fatal(err)
+			}
+			// populate Near Cache
+			if _, err := m.Get(ctx, i); err != nil {
+				t.Fatal(err)
+			}
+			entries[i] = types.Entry{Key: i, Value: i * 10}
+		}
+		require.Equal(t, int64(size), m.LocalMapStats().NearCacheStats.OwnedEntryCount)
+		if err := m.PutAll(ctx, entries...); err != nil {
+			t.Fatal(err)
+		}
+		require.Equal(t, int64(0), m.LocalMapStats().NearCacheStats.OwnedEntryCount)
+		for i := int64(0); i < size; i++ {
+			v, err := m.Get(ctx, i)
+			if err != nil {
+				t.Fatal(err)
+			}
+			require.Equal(t, i*10, v)
+		}
+	})
+}
+
 func TestGetNearCacheStatsBeforePopulation(t *testing.T) {
 	// ported from: com.hazelcast.client.map.impl.nearcache.ClientMapNearCacheTest#testGetNearCacheStatsBeforePopulation
 	tcx := newNearCacheMapTestContext(t, nearcache.InMemoryFormatObject, false)
@@ -166,6 +235,43 @@ func TestGetNearCacheStatsBeforePopulation(t *testing.T) {
 	})
 }
 
+func TestDumpNearCache(t *testing.T) {
+	tcx := newNearCacheMapTestContext(t, nearcache.InMemoryFormatBinary, false)
+	tcx.Tester(func(tcx it.MapTestContext) {
+		t := tcx.T
+		m := tcx.M
+		ctx := context.Background()
+		start := time.Now().Truncate(time.Second)
+		for i := int64(0); i < 10; i++ {
+			it.MustValue(m.Put(ctx, i, i))
+		}
+		// populate the Near Cache with the first 5 keys
+		for i := int64(0); i < 5; i++ {
+			it.MustValue(m.Get(ctx, i))
+		}
+		// read the first key again to hit the Near Cache
+		it.MustValue(m.Get(ctx, int64(0)))
+		infos, err := m.DumpNearCache()
+		if err != nil {
+			t.Fatal(err)
+		}
+		var keys []interface{}
+		for _, info := range infos {
+			keys = append(keys, info.Key)
+			assert.False(t, info.CreationTime.Before(start))
+			assert.False(t, info.CreationTime.After(time.Now()))
+			if info.Key == int64(0) {
+				assert.Equal(t, int32(1), info.Hits)
+				assert.False(t, info.LastAccessTime.Before(info.CreationTime))
+			} else {
+				assert.Equal(t, int32(0), info.Hits)
+				assert.True(t, info.LastAccessTime.IsZero())
+			}
+		}
+		assert.ElementsMatch(t, []interface{}{int64(0), int64(1), int64(2), int64(3), int64(4)}, keys)
+	})
+}
+
 func TestNearCacheClearFromClient(t *testing.T) {
 	// ported from: com.hazelcast.client.map.impl.nearcache.ClientMapNearCacheTest#testNearCache_clearFromClient
 	tcx := newNearCacheMapTestContext(t, nearcache.InMemoryFormatObject, true)
@@ -190,6 +296,27 @@ func TestNearCacheClearFromClient(t *testing.T) {
 	})
 }
 
+func TestReconcileNearCache(t *testing.T) {
+	// no corresponding test in the reference implementation
+	tcx := newNearCacheMapTestContext(t, nearcache.InMemoryFormatBinary, true)
+	tcx.Tester(func(tcx it.MapTestContext) {
+		t := tcx.T
+		m := tcx.M
+		ctx := context.Background()
+		const size = int32(100)
+		populateMap(tcx, size)
+		populateNearCache(tcx, size)
+		// no invalidations were missed, so reconciliation keeps all entries.
+		require.NoError(t, m.ReconcileNearCache(ctx))
+		assert.Equal(t, int64(size), m.LocalMapStats().NearCacheStats.OwnedEntryCount)
+		for i := int32(0); i < size; i++ {
+			v, err := m.Get(ctx, i)
+			require.NoError(t, err)
+			require.Equal(t, i, v)
+		}
+	})
+}
+
 func TestNearCacheClearFromRemote(t *testing.T) {
 	// ported from: com.hazelcast.client.map.impl.nearcache.ClientMapNearCacheTest#testNearCache_clearFromRemote
 	tcx := newNearCacheMapTestContextWithExpiration(t, nearcache.InMemoryFormatObject, true)
@@ -420,6 +547,119 @@ func TestNearCacheInvalidateOnChange(t *testing.T) {
 	})
 }
 
+func TestMapClearAndEvictAllWithMapStore(t *testing.T) {
+	// no corresponding test in the reference implementation
+	for _, withNearCache := range []bool{false, true} {
+		t.Run(fmt.Sprintf("nearCache=%t", withNearCache), func(t *testing.T) {
+			ctx := context.Background()
+			port := it.NextPort()
+			mapName := it.NewUniqueObjectName("map")
+			cls := it.StartNewClusterWithConfig(1, invalidationXMLConfig(t.Name(), mapName, port), port)
+			defer cls.Shutdown()
+			cfg := cls.DefaultConfigWithNoSSL()
+			if withNearCache {
+				cfg.AddNearCache(nearcache.Config{Name: mapName})
+			}
+			client := it.MustClient(hz.StartNewClientWithConfig(ctx, cfg))
+			defer client.Shutdown(ctx)
+			m := it.MustValue(client.GetMap(ctx, mapName)).(*hz.Map)
+			const size = 10
+			// the entries are written to the map store.
+			for i := 0; i < size; i++ {
+				it.Must(m.Set(ctx, strconv.Itoa(i), strconv.Itoa(i)))
+			}
+			getAll := func() {
+				for i := 0; i < size; i++ {
+					v := it.MustValue(m.Get(ctx, strconv.Itoa(i)))
+					require.Equal(t, strconv.Itoa(i), v)
+				}
+			}
+			getAll()
+			if withNearCache {
+				require.Equal(t, int64(size), m.LocalMapStats().NearCacheStats.OwnedEntryCount)
+			}
+			// EvictAll leaves the store intact, so the entries are loaded back.
+			it.Must(m.EvictAll(ctx))
+			if withNearCache {
+				require.Equal(t, int64(0), m.LocalMapStats().NearCacheStats.OwnedEntryCount)
+			}
+			getAll()
+			// Clear deletes the entries from the store as well.
+			it.Must(m.Clear(ctx))
+			if withNearCache {
+				require.Equal(t, int64(0), m.LocalMapStats().NearCacheStats.OwnedEntryCount)
+			}
+			for i := 0; i < size; i++ {
+				v := it.MustValue(m.Get(ctx, strconv.Itoa(i)))
+				require.Nil(t, v)
+			}
+		})
+	}
+}
+
+func TestNearCacheInvalidation_fromAnotherClient(t *testing.T) {
+	// no corresponding test in the reference implementation
+	tcx := newNearCacheMapTestContextWithExpiration(t, nearcache.InMemoryFormatBinary, true)
+	tcx.Tester(func(tcx it.MapTestContext) {
+		t := tcx.T
+		m := tcx.M
+		ctx := context.Background()
+		it.Must(m.Set(ctx, "key", "value-1"))
+		// populate the near cache
+		require.Equal(t, "value-1", it.MustValue(m.Get(ctx, "key")))
+		require.Equal(t, int64(1), m.LocalMapStats().NearCacheStats.OwnedEntryCount)
+		// the other client does not have a near cache
+		cfg := tcx.Cluster.DefaultConfig()
+		cfg.Cluster.Unisocket = tcx.Config.Cluster.Unisocket
+		other := it.MustClient(hz.StartNewClientWithConfig(ctx, cfg))
+		defer other.Shutdown(ctx)
+		om := it.MustValue(other.GetMap(ctx, tcx.MapName)).(*hz.Map)
+		before := m.LocalMapStats().NearCacheStats.InvalidationRequests
+		it.Must(om.Set(ctx, "key", "value-2"))
+		it.Eventually(t, func() bool {
+			return m.LocalMapStats().NearCacheStats.InvalidationRequests > before
+		})
+		require.Equal(t, "value-2", it.MustValue(m.Get(ctx, "key")))
+		// the invalidations caused by this client are applied immediately and the invalidation messages for them are skipped.
+		before = m.LocalMapStats().NearCacheStats.InvalidationRequests
+		it.Must(m.Set(ctx, "key", "value-3"))
+		time.Sleep(1 * time.Second)
+		require.Equal(t, before+1, m.LocalMapStats().NearCacheStats.InvalidationRequests)
+		require.Equal(t, "value-3", it.MustValue(m.Get(ctx, "key")))
+	})
+}
+
+func TestNearCacheInvalidationStats(t *testing.T) {
+	// no corresponding test in the reference implementation
+	tcx := newNearCacheMapTestContextWithExpiration(t, nearcache.InMemoryFormatBinary, true)
+	tcx.Tester(func(tcx it.MapTestContext) {
+		t := tcx.T
+		m := tcx.M
+		ctx := context.Background()
+		const size = 10
+		populateMapWithString(tcx, size)
+		populateNearCacheWithString(tcx, size)
+		before := m.LocalMapStats().NearCacheStats
+		// local writes invalidate the cached keys immediately.
+		for i := 0; i < size; i++ {
+			key := strconv.Itoa(i)
+			it.Must(m.Set(ctx, key, key))
+		}
+		afterLocal := m.LocalMapStats().NearCacheStats
+		require.Equal(t, before.InvalidationRequests+size, afterLocal.InvalidationRequests)
+		require.Equal(t, before.Invalidations+size, afterLocal.Invalidations)
+		require.Equal(t, int64(0), afterLocal.OwnedEntryCount)
+		// remote writes invalidate the cached keys when the invalidation messages are received.
+		populateNearCacheWithString(tcx, size)
+		populateServerMapWithString(ctx, tcx, size)
+		it.Eventually(t, func() bool {
+			st := m.LocalMapStats().NearCacheStats
+			return st.Invalidations >= afterLocal.Invalidations+size &&
+				st.InvalidationRequests >= afterLocal.InvalidationRequests+size
+		})
+	})
+}
+
 func TestNearCacheInvalidation_WithLFU_whenMaxSizeExceeded(t *testing.T) {
 	// port of: com.hazelcast.client.map.impl.nearcache.ClientMapNearCacheTest#testNearCacheInvalidation_WithLFU_whenMaxSizeExceeded
 	ncc := makeNearCacheConfigWithEviction(nearcache.EvictionPolicyLFU)
@@ -454,6 +694,173 @@ func TestNearCacheInvalidation_WithNone_whenMaxSizeExceeded(t *testing.T) {
 	})
 }
 
+func TestNearCacheGet_whenNearCacheIsFull(t *testing.T) {
+	tcx := it.MapTestContext{
+		T: t,
+		ConfigCallback: func(tcx it.MapTestContext) {
+			ncc := makeNearCacheConfigWithEviction(nearcache.EvictionPolicyNone)
+			tcx.Config.AddNearCache(ncc)
+		},
+	}
+	tcx.Tester(func(tcx it.MapTestContext) {
+		t := tcx.T
+		const mapSize = maxCacheSize * 2
+		populateMap(tcx, mapSize)
+		// fill the Near Cache, so no more values can be stored in it.
+		populateNearCache(tcx, maxCacheSize)
+		// the values which cannot be stored in the Near Cache are still returned.
+		for i := int32(maxCacheSize); i < mapSize; i++ {
+			v, err := tcx.M.Get(context.Background(), i)
+			require.NoError(t, err)
+			require.Equal(t, i, v)
+		}
+		assert.Equal(t, int64(maxCacheSize), tcx.M.LocalMapStats().NearCacheStats.OwnedEntryCount)
+	})
+}
+
+func TestNearCacheGet_whenMaxEntryCountIsReached(t *testing.T) {
+	// no corresponding test in the reference implementation
+	const maxEntryCount = maxCacheSize / 2
+	tcx := it.MapTestContext{
+		T: t,
+		ConfigCallback: func(tcx it.MapTestContext) {
+			// the eviction policy would evict entries, but the hard limit is reached first.
+			ncc := makeNearCacheConfigWithEviction(nearcache.EvictionPolicyLRU)
+			ncc.MaxEntryCount = maxEntryCount
+			tcx.Config.AddNearCache(ncc)
+		},
+	}
+	tcx.Tester(func(tcx it.MapTestContext) {
+		t := tcx.T
+		const mapSize = maxCacheSize * 2
+		populateMap(tcx, mapSize)
+		for i := int32(0); i < mapSize; i++ {
+			v, err := tcx.M.Get(context.Background(), i)
+			require.NoError(t, err)
+			require.Equal(t, i, v)
+			require.LessOrEqual(t, tcx.M.LocalMapStats().NearCacheStats.OwnedEntryCount, int64(maxEntryCount))
+		}
+		// the values which are not cached are still returned.
+		for i := int32(maxEntryCount); i < mapSize; i++ {
+			v, err := tcx.M.Get(context.Background(), i)
+			require.NoError(t, err)
+			require.Equal(t, i, v)
+		}
+		assert.Equal(t, int64(maxEntryCount), tcx.M.LocalMapStats().NearCacheStats.OwnedEntryCount)
+	})
+}
+
+func TestNearCacheGet_whenNilTimeToLiveExpires(t *testing.T) {
+	// no corresponding test in the reference implementation
+	tcx := it.MapTestContext{
+		T: t,
+		ConfigCallback: func(tcx it.MapTestContext) {
+			ncc := nearcache.Config{
+				Name:                 tcx.MapName,
+				TimeToLiveSeconds:    60,
+				NilTimeToLiveSeconds: 1,
+			}
+			tcx.Config.AddNearCache(ncc)
+		},
+	}
+	tcx.Tester(func(tcx it.MapTestContext) {
+		t := tcx.T
+		m := tcx.M
+		ctx := context.Background()
+		it.Must(m.Set(ctx, "present", "value"))
+		// cache both the present and the missing key
+		require.Nil(t, it.MustValue(m.Get(ctx, "missing")))
+		require.Equal(t, "value", it.MustValue(m.Get(ctx, "present")))
+		require.Nil(t, it.MustValue(m.Get(ctx, "missing")))
+		require.Equal(t, "value", it.MustValue(m.Get(ctx, "present")))
+		stats := m.LocalMapStats().NearCacheStats
+		require.Equal(t, int64(2), stats.Hits)
+		require.Equal(t, int64(2), stats.Misses)
+		// record times have the resolution of a second
+		time.Sleep(2100 * time.Millisecond)
+		// the missing key is fetched again, the present key is still cached
+		require.Nil(t, it.MustValue(m.Get(ctx, "missing")))
+		require.Equal(t, "value", it.MustValue(m.Get(ctx, "present")))
+		// the missing key is not a hit, whether it was removed by the expiration task or expired on read
+		assert.Equal(t, int64(3), m.LocalMapStats().NearCacheStats.Hits)
+	})
+}
+
+func TestNearCachePreloader_storeOnShutdownThenPreload(t *testing.T) {
+	// no corresponding test in the reference implementation
+	dir := t.TempDir()
+	tcx := it.MapTestContext{
+		T: t,
+		ConfigCallback: func(tcx it.MapTestContext) {
+			ncc := nearcache.Config{
+				Name:      tcx.MapName,
+				Preloader: nearcache.PreloaderConfig{Enabled: true, Directory: dir},
+			}
+			tcx.Config.AddNearCache(ncc)
+		},
+	}
+	tcx.Tester(func(tcx it.MapTestContext) {
+		t := tcx.T
+		ctx := context.Background()
+		const size = 118
+		populateMapWithString(tcx, size)
+		// the keys are stored when the client shuts down
+		client := it.MustClient(hz.StartNewClientWithConfig(ctx, *tcx.Config))
+		tcx.M = it.MustValue(client.GetMap(ctx, tcx.MapName)).(*hz.Map)
+		populateNearCacheWithString(tcx, size)
+		it.Must(client.Shutdown(ctx))
+		stats := tcx.M.LocalMapStats().NearCacheStats
+		require.Equal(t, int64(1), stats.PersistenceCount)
+		require.Equal(t, int64(size), stats.LastPersistenceKeyCount)
+		require.Equal(t, "", stats.LastPersistenceFailure)
+		// a new client preloads the stored keys
+		client = it.MustClient(hz.StartNewClientWithConfig(ctx, *tcx.Config))
+		defer client.Shutdown(ctx)
+		m := it.MustValue(client.GetMap(ctx, tcx.MapName)).(*hz.Map)
+		it.Eventually(t, func() bool {
+			return m.LocalMapStats().NearCacheStats.OwnedEntryCount == size
+		})
+		tcx.M = m
+		populateNearCacheWithString(tcx, size)
+		assert.Equal(t, int64(size), m.LocalMapStats().NearCacheStats.Hits)
+	})
+}
+
+func TestNearCacheSet_withCacheOnUpdate(t *testing.T) {
+	// no corresponding test in the reference implementation
+	tcx := it.MapTestContext{
+		T: t,
+		ConfigCallback: func(tcx it.MapTestContext) {
+			ncc := nearcache.Config{
+				Name:              tcx.MapName,
+				LocalUpdatePolicy: nearcache.LocalUpdatePolicyCacheOnUpdate,
+			}
+			tcx.Config.AddNearCache(ncc)
+		},
+	}
+	tcx.Tester(func(tcx it.MapTestContext) {
+		t := tcx.T
+		m := tcx.M
+		ctx := context.Background()
+		const size = 100
+		for i := 0; i < size; i++ {
+			it.Must(m.Set(ctx, i, strconv.Itoa(i)))
+		}
+		require.Equal(t, int64(size), m.LocalMapStats().NearCacheStats.OwnedEntryCount)
+		// the values written by Set are read from the Near Cache
+		for i := 0; i < size; i++ {
+			require.Equal(t, strconv.Itoa(i), it.MustValue(m.Get(ctx, i)))
+		}
+		stats := m.LocalMapStats().NearCacheStats
+		assert.Equal(t, int64(size), stats.Hits)
+		assert.Equal(t, int64(0), stats.Misses)
+		// other writes still invalidate the key
+		it.MustValue(m.Put(ctx, 0, "new"))
+		require.Equal(t, "new", it.MustValue(m.Get(ctx, 0)))
+		assert.Equal(t, int64(1), m.LocalMapStats().NearCacheStats.Misses)
+	})
+}
+
 func TestNearCacheInvalidationWithRandom_whenMaxSizeExceeded(t *testing.T) {
 	// port of: com.hazelcast.client.map.impl.nearcache.ClientMapNearCacheTest#testNearCacheInvalidation_WithRandom_whenMaxSizeExceeded
 	ncc := makeNearCacheConfigWithEviction(nearcache.EvictionPolicyRandom)
@@ -654,6 +1061,22 @@ func TestAfterPutNearCacheIsInvalidated(t *testing.T) {
 				}
 			},
 		},
+		{
+			name: "PutTransientWithMaxIdle",
+			f: func(ctx context.Context, tcx it.MapTestContext, i int32) {
+				if err := tcx.M.PutTransientWithMaxIdle(ctx, i, i, 5*time.Second); err != nil {
+					tcx.T.Fatal(err)
+				}
+			},
+		},
+		{
+			name: "TryPutWithTTL",
+			f: func(ctx context.Context, tcx it.MapTestContext, i int32) {
+				if _, err := tcx.M.TryPutWithTTL(ctx, i, i, 1*time.Second, 10*time.Second); err != nil {
+					tcx.T.Fatal(err)
+				}
+			},
+		},
 		{
 			name: "PutTransientWithTTLAndMaxIdle",
 			f: func(ctx context.Context, tcx it.MapTestContext, i int32) {
@@ -696,6 +1119,51 @@ func TestAfterPutNearCacheIsInvalidated(t *testing.T) {
 	invalidationRunner(t, testCases)
 }
 
+func TestNearCacheReadYourWrites(t *testing.T) {
+	// a Get which follows a Put on the same client must not return a value older than the one put,
+	// even when other goroutines concurrently populate the Near Cache with the same key.
+	for _, inMemFmt := range []nearcache.InMemoryFormat{nearcache.InMemoryFormatBinary, nearcache.InMemoryFormatObject} {
+		t.Run(inMemoryFmtToString(inMemFmt), func(t *testing.T) {
+			tcx := newNearCacheMapTestContext(t, inMemFmt, true)
+			tcx.Tester(func(tcx it.MapTestContext) {
+				t := tcx.T
+				m := tcx.M
+				ctx, cancel := context.WithCancel(context.Background())
+				defer cancel()
+				const key = "key"
+				it.MustValue(m.Put(ctx, key, int64(0)))
+				wg := &sync.WaitGroup{}
+				for i := 0; i < 8; i++ {
+					wg.Add(1)
+					go func() {
+						defer wg.Done()
+						for ctx.Err() == nil {
+							// errors are expected when the context is canceled.
+							_, _ = m.Get(ctx, key)
+						}
+					}()
+				}
+				for i := int64(1); i <= 2000; i++ {
+					if _, err := m.Put(ctx, key, i); err != nil {
+						t.Fatal(err)
+					}
+					v, err := m.Get(ctx, key)
+					if err != nil {
+						t.Fatal(err)
+					}
+					if v.(int64) < i {
+						cancel()
+						wg.Wait()
+						t.Fatalf("stale read after put %d: %d", i, v)
+					}
+				}
+				cancel()
+				wg.Wait()
+			})
+		})
+	}
+}
+
 func TestAfterPulAllNearCacheIsInvalidated(t *testing.T) {
 	// this test does not exist in the reference implementation
 	tcx := newNearCacheMapTestContextWithExpiration(t, nearcache.InMemoryFormatBinary, true)
@@ -874,6 +1342,18 @@ func TestAfterLoadAllWithDefinedKeysNearCacheIsInvalidated(t *testing.T) {
 				return tcx.M.LoadAllReplacing(ctx, keys...)
 			},
 		},
+		{
+			name: "LoadAll",
+			f: func(ctx context.Context, tcx it.MapTestContext, keys []interface{}) error {
+				return tcx.M.LoadAll(ctx, true)
+			},
+		},
+		{
+			name: "LoadAllWithKeys",
+			f: func(ctx context.Context, tcx it.MapTestContext, keys []interface{}) error {
+				return tcx.M.LoadAllWithKeys(ctx, keys, true)
+			},
+		},
 	}
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
@@ -949,6 +1429,27 @@ func TestAfterTryPutNearCacheIsInvalidated(t *testing.T) {
 	invalidationRunner(t, testCases)
 }
 
+func TestAfterSetTTLNearCacheIsInvalidated(t *testing.T) {
+	// no corresponding test in the reference implementation
+	testCases := []mapTestCase{
+		{
+			name: "SetTTL",
+			f: func(ctx context.Context, tcx it.MapTestContext, i int32) {
+				require.NoError(tcx.T, tcx.M.SetTTL(ctx, i, time.Hour))
+			},
+		},
+		{
+			name: "SetTTLAffected",
+			f: func(ctx context.Context, tcx it.MapTestContext, i int32) {
+				v, err := tcx.M.SetTTLAffected(ctx, i, time.Hour)
+				require.NoError(tcx.T, err)
+				require.True(tcx.T, v)
+			},
+		},
+	}
+	invalidationRunner(t, testCases)
+}
+
 func TestMemberLoadAllInvalidatesClientNearCache(t *testing.T) {
 	// ported from: com.hazelcast.client.map.impl.nearcache.ClientMapNearCacheTest#testMemberLoadAll_invalidates_clientNearCache
 	f := func(tcx it.MapTestContext, size int32) string {
@@ -1452,3 +1953,38 @@ func smokeXMLConfig(clusterName string, port int) string {
         </hazelcast>
 	`, clusterName, port)
 }
+
+func TestClientNearCacheConfigAndNames(t *testing.T) {
+	// no corresponding test in the reference implementation
+	prefix := it.NewUniqueObjectName("ncnames")
+	cb := func(cfg *hz.Config) {
+		cfg.AddNearCache(nearcache.Config{Name: "*", TimeToLiveSeconds: 1})
+		cfg.AddNearCache(nearcache.Config{Name: prefix + "-*", TimeToLiveSeconds: 2})
+		cfg.AddNearCache(nearcache.Config{Name: prefix + "-map-*", TimeToLiveSeconds: 3})
+	}
+	it.TesterWithConfigBuilder(t, cb, func(t *testing.T, client *hz.Client) {
+		ctx := context.Background()
+		// the most specific pattern applies.
+		ncc, ok, err := client.NearCacheConfig(prefix + "-map-1")
+		require.NoError(t, err)
+		require.True(t, ok)
+		assert.Equal(t, prefix+"-map-*", ncc.Name)
+		assert.Equal(t, 3, ncc.TimeToLiveSeconds)
+		ncc, ok, err = client.NearCacheConfig(prefix + "-other")
+		require.NoError(t, err)
+		require.True(t, ok)
+		assert.Equal(t, prefix+"-*", ncc.Name)
+		ncc, ok, err = client.NearCacheConfig("unrelated")
+		require.NoError(t, err)
+		require.True(t, ok)
+		assert.Equal(t, "*", ncc.Name)
+		// Near Caches are created when the maps are retrieved.
+		assert.Equal(t, []string{}, client.NearCacheNames())
+		names := []string{prefix + "-map-2", prefix + "-map-1"}
+		for _, name := range names {
+			m := it.MustValue(client.GetMap(ctx, name)).(*hz.Map)
+			defer m.Destroy(ctx)
+		}
+		assert.Equal(t, []string{prefix + "-map-1", prefix + "-map-2"}, client.NearCacheNames())
+	})
+}
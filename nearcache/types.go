@@ -63,6 +63,44 @@ func (m InMemoryFormat) MarshalText() ([]byte, error) {
 	}
 }
 
+// LocalUpdatePolicy specifies how the Near Cache is updated when this client writes an entry.
+type LocalUpdatePolicy int8
+
+const (
+	// LocalUpdatePolicyInvalidate removes the written entry from the Near Cache, so it is fetched from the cluster on the next read.
+	LocalUpdatePolicyInvalidate LocalUpdatePolicy = iota
+	// LocalUpdatePolicyCacheOnUpdate stores the written value in the Near Cache once the write succeeds, so the next read is served locally.
+	LocalUpdatePolicyCacheOnUpdate
+)
+
+// UnmarshalText unmarshals the local update policy from a byte array.
+func (p *LocalUpdatePolicy) UnmarshalText(b []byte) error {
+	s := string(b)
+	switch strings.ToLower(s) {
+	case "invalidate":
+		*p = LocalUpdatePolicyInvalidate
+	case "cache_on_update":
+		*p = LocalUpdatePolicyCacheOnUpdate
+	default:
+		msg := fmt.Sprintf("unknown local update policy: %s", s)
+		return hzerrors.NewIllegalArgumentError(msg, nil)
+	}
+	return nil
+}
+
+// MarshalText marshals the local update policy to a byte array.
+func (p LocalUpdatePolicy) MarshalText() ([]byte, error) {
+	switch p {
+	case LocalUpdatePolicyInvalidate:
+		return []byte("invalidate"), nil
+	case LocalUpdatePolicyCacheOnUpdate:
+		return []byte("cache_on_update"), nil
+	default:
+		err := hzerrors.NewIllegalArgumentError(fmt.Sprintf("unknown local update policy: %d", p), nil)
+		return nil, err
+	}
+}
+
 // EvictionPolicy specifies which entry is evicted.
 type EvictionPolicy int32
 
@@ -132,8 +170,11 @@ func (p EvictionPolicy) MarshalText() ([]byte, error) {
 // Stats contains statistics for a Near Cache instance.
 type Stats struct {
 	// InvalidationRequests is the number of times an invalidation was requested.
+	// It is incremented for invalidations triggered by the writes of this client and for the invalidation messages received from the cluster,
+	// whether or not the key was in the Near Cache.
 	InvalidationRequests int64
 	// Misses is the number of times an entry was not found in the Near Cache.
+	// Reading an entry which expired because of time to live or max idle is a miss too.
 	Misses int64
 	// Hits is the number of times an entry was found in the Near Cache.
 	Hits int64
@@ -144,8 +185,10 @@ type Stats struct {
 	// OwnedEntryCount is the number of entries in the Near Cache.
 	OwnedEntryCount int64
 	// OwnedEntryMemoryCost is the estimated memory cost in bytes for the entries in the Near Cache.
+	// It is always 0 if the in-memory format is InMemoryFormatObject, since the size of deserialized values cannot be estimated.
 	OwnedEntryMemoryCost int64
 	// Invalidations is the number of successful invalidations.
+	// It is incremented when an invalidation, either triggered by the writes of this client or received from the cluster, removes an entry from the Near Cache.
 	Invalidations int64
 	// LastPersistenceKeyCount is the number of keys saved in the last persistence task when the pre-load feature is enabled.
 	LastPersistenceKeyCount int64
@@ -153,11 +196,13 @@ type Stats struct {
 	LastPersistenceWrittenBytes int64
 	// PersistenceCount is the number of completed persistence tasks when the pre-load feature is enabled.
 	PersistenceCount int64
+	// StoreFailures is the number of times a value fetched from the cluster could not be stored in the Near Cache.
+	StoreFailures int64
 	// CreationTime is the time the Near Cache was initialized.
 	CreationTime time.Time
 	// LastPersistenceTime is the time of the last completed persistence task when the pre-load feature is enabled.
 	LastPersistenceTime time.Time
-	// LastPersistenceFailure is the error message of the last completed persistence task when the pre-load feature is enabled.
+	// LastPersistenceFailure is the error message of the last persistence task if it failed, or an empty string if it succeeded, when the pre-load feature is enabled.
 	LastPersistenceFailure string
 	// LastPersistenceDuration is the duration of the last completed persistence task when the pre-load feature is enabled.
 	LastPersistenceDuration time.Duration
@@ -176,6 +221,28 @@ func (s Stats) Ratio() float64 {
 	return (float64(s.Hits) / float64(s.Misses)) * 100.0
 }
 
+// RecordInfo contains the metadata of an entry in the Near Cache.
+// It does not contain the value of the entry.
+// The times have a resolution of one second.
+type RecordInfo struct {
+	// Key is the key of the entry.
+	Key interface{}
+	// CreationTime is the time the entry was cached.
+	CreationTime time.Time
+	// LastAccessTime is the time the entry was last read from the Near Cache.
+	// It is the zero time if the entry was never read.
+	LastAccessTime time.Time
+	// ExpirationTime is the time the entry expires due to the TTL constraint.
+	// It is the zero time if the entry does not expire.
+	ExpirationTime time.Time
+	// Hits is the number of times the entry was read from the Near Cache.
+	Hits int32
+	// PartitionID is the partition of the entry.
+	PartitionID int32
+	// CachedAsNil is true if the entry was not found in the map, and this was cached.
+	CachedAsNil bool
+}
+
 // EvictionPolicyComparator is used for comparing entries to be evicted.
 type EvictionPolicyComparator interface {
 	// Compare returns a negative integer if a is less than b, 0 if a is equal to b or a positive integer if a is greater than b.
@@ -187,6 +254,7 @@ type EvictableEntryView interface {
 	// Hits is the number of accesses to the entry.
 	Hits() int64
 	// Key is the key of the entry.
+	// It is the key in serialized form if SerializeKeys is enabled in the Near Cache configuration.
 	Key() interface{}
 	// Value is the value of the entry.
 	Value() interface{}
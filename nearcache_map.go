@@ -24,12 +24,14 @@ import (
 
 	"github.com/hazelcast/hazelcast-go-client/predicate"
 
+	"github.com/hazelcast/hazelcast-go-client/internal/check"
 	"github.com/hazelcast/hazelcast-go-client/internal/cluster"
 	"github.com/hazelcast/hazelcast-go-client/internal/logger"
 	inearcache "github.com/hazelcast/hazelcast-go-client/internal/nearcache"
 	"github.com/hazelcast/hazelcast-go-client/internal/proto"
 	"github.com/hazelcast/hazelcast-go-client/internal/proto/codec"
 	"github.com/hazelcast/hazelcast-go-client/internal/serialization"
+	"github.com/hazelcast/hazelcast-go-client/nearcache"
 	"github.com/hazelcast/hazelcast-go-client/types"
 )
 
@@ -51,6 +53,7 @@ type nearCacheMap struct {
 	lg                     logger.LogAdaptor
 	invalidationListenerID atomic.Value
 	serializeKeys          bool
+	cacheOnUpdate          bool
 }
 
 func newNearCacheMap(ctx context.Context, nc *inearcache.NearCache, ss *serialization.Service, rt *inearcache.ReparingTask, lg logger.LogAdaptor, name string, lb *cluster.ConnectionListenerBinder, local bool) (nearCacheMap, error) {
@@ -61,6 +64,7 @@ func newNearCacheMap(ctx context.Context, nc *inearcache.NearCache, ss *serializ
 		lb:            lb,
 		lg:            lg,
 		serializeKeys: nc.Config().SerializeKeys,
+		cacheOnUpdate: nc.Config().LocalUpdatePolicy == nearcache.LocalUpdatePolicyCacheOnUpdate,
 	}
 	ncc := nc.Config()
 	if ncc.InvalidateOnChange() {
@@ -72,8 +76,12 @@ func newNearCacheMap(ctx context.Context, nc *inearcache.NearCache, ss *serializ
 		}
 	}
 	// toNearCacheKey returns the raw key if SerializeKeys is not true.
+	// nil keys are rejected in both cases, the same way the proxy does for maps without a near cache.
 	if ncc.SerializeKeys {
 		ncm.toNearCacheKey = func(key interface{}) (interface{}, error) {
+			if check.Nil(key) {
+				return nil, errNilArg()
+			}
 			data, err := ss.ToData(key)
 			if err != nil {
 				return nil, err
@@ -82,12 +90,53 @@ func newNearCacheMap(ctx context.Context, nc *inearcache.NearCache, ss *serializ
 		}
 	} else {
 		ncm.toNearCacheKey = func(key interface{}) (interface{}, error) {
+			if check.Nil(key) {
+				return nil, errNilArg()
+			}
 			return key, nil
 		}
 	}
 	return ncm, nil
 }
 
+// preload fetches the values of the keys which were stored by the Near Cache preloader, so that they are cached.
+// Failures are logged, since the Near Cache is usable without preloading.
+// Preloading stops when ctx is cancelled.
+// see: com.hazelcast.internal.nearcache.impl.preloader.NearCachePreloader#loadKeys
+func (ncm *nearCacheMap) preload(ctx context.Context, m *Map) {
+	keyDatas, err := ncm.nc.PreloadKeys()
+	if err != nil {
+		ncm.lg.Warnf("hazelcast.nearCacheMap.preload: map %s: %s", m.name, err.Error())
+		return
+	}
+	for len(keyDatas) > 0 {
+		n := inearcache.PreloaderLoadBatchSize
+		if n > len(keyDatas) {
+			n = len(keyDatas)
+		}
+		batch := keyDatas[:n]
+		keyDatas = keyDatas[n:]
+		keys := make([]interface{}, len(batch))
+		for i, kd := range batch {
+			if keys[i], err = ncm.ss.ToObject(kd); err != nil {
+				ncm.lg.Warnf("hazelcast.nearCacheMap.preload: map %s: deserializing key: %s", m.name, err.Error())
+				return
+			}
+		}
+		if _, err := ncm.GetAll(ctx, m, keys, batch); err != nil {
+			if ctx.Err() != nil {
+				// the client is shutting down.
+				return
+			}
+			ncm.lg.Warnf("hazelcast.nearCacheMap.preload: map %s: %s", m.name, err.Error())
+			return
+		}
+	}
+	ncm.lg.Debug(func() string {
+		return fmt.Sprintf("hazelcast.nearCacheMap.preload: map %s: preloading done", m.name)
+	})
+}
+
 func (ncm *nearCacheMap) Destroy(ctx context.Context, name string) error {
 	ncm.lg.Trace(func() string {
 		return fmt.Sprintf("hazelcast.nearCacheMap.Destroy: %s", name)
@@ -105,6 +154,8 @@ func (ncm *nearCacheMap) Destroy(ctx context.Context, name string) error {
 func (ncm *nearCacheMap) registerInvalidationListener(ctx context.Context, name string, local bool) error {
 	// port of: com.hazelcast.client.map.impl.nearcache.NearCachedClientMapProxy#registerInvalidationListener
 	addMsg := codec.EncodeMapAddNearCacheInvalidationListenerRequest(name, eventTypeInvalidation, local)
+	// registering the handler fetches the partition UUIDs and sequences from the members,
+	// so the first invalidation event is checked against an accurate baseline.
 	rth, err := ncm.rt.RegisterAndGetHandler(ctx, name, ncm.nc)
 	if err != nil {
 		return fmt.Errorf("nearCacheMap.registerInvalidationListener: %w", err)
@@ -210,7 +261,7 @@ func (ncm *nearCacheMap) PutAll(ctx context.Context, m *Map, entries []types.Ent
 	for i, e := range entries {
 		k, err := ncm.toNearCacheKey(e.Key)
 		if err != nil {
-			return err
+			return fmt.Errorf("entry %d: %w", i, err)
 		}
 		keys[i] = k
 	}
@@ -244,45 +295,50 @@ func (ncm *nearCacheMap) Get(ctx context.Context, m *Map, key interface{}) (inte
 	return value, nil
 }
 
-func (ncm *nearCacheMap) GetAll(ctx context.Context, m *Map, keys []interface{}) ([]types.Entry, error) {
+// GetAll returns the entries for the given keys.
+// keys must be distinct and keyDatas[i] must be the serialized form of keys[i].
+// Only the keys which are not found in the near cache are requested from the cluster.
+func (ncm *nearCacheMap) GetAll(ctx context.Context, m *Map, keys []interface{}, keyDatas []serialization.Data) ([]types.Entry, error) {
 	// see: com.hazelcast.client.map.impl.nearcache.NearCachedClientMapProxy#getAllInternal
-	entries := make([]types.Entry, len(keys))
-	missKeys, err := ncm.populateResultFromNearCache(keys, entries)
+	entries, missIdx, err := ncm.populateResultFromNearCache(keys)
 	if err != nil {
 		return nil, fmt.Errorf("nearCacheMap.GetAll: populating result from near cache: %w", err)
 	}
-	if len(missKeys) == 0 {
+	if len(missIdx) == 0 {
 		return entries, nil
 	}
-	keyDatas := make([]serialization.Data, len(keys))
-	for i, k := range missKeys {
-		kd, err := ncm.ss.ToData(k)
+	missKeys := make([]interface{}, len(missIdx))
+	missKeyDatas := make([]serialization.Data, len(missIdx))
+	for i, idx := range missIdx {
+		nk, err := ncm.toNearCacheKey(keys[idx])
 		if err != nil {
 			return nil, err
 		}
-		keyDatas[i] = kd
+		missKeys[i] = nk
+		missKeyDatas[i] = keyDatas[idx]
 	}
-	resMap, err := ncm.getNearCacheReservations(keys, keyDatas)
+	resMap, err := ncm.getNearCacheReservations(missKeys, missKeyDatas)
 	if err != nil {
 		return nil, err
 	}
-	partitionToKeys, err := m.partitionToKeys(keys, false)
+	defer ncm.releaseRemainingReservedKeys(resMap)
+	partitionToKeys, err := m.partitionToKeys(missKeyDatas)
 	if err != nil {
 		return nil, err
 	}
-	defer ncm.releaseRemainingReservedKeys(resMap)
-	pairs, err := m.getAllFromRemote(ctx, len(missKeys), partitionToKeys)
+	pairs, err := m.getAllFromRemote(ctx, len(missKeyDatas), partitionToKeys)
 	if err != nil {
 		return nil, fmt.Errorf("nearCacheMap.GetAll: getting keys from remote: %w", err)
 	}
-	keyCount, err := ncm.populateResultFromRemote(pairs, entries, resMap)
-	if err != nil {
-		return nil, err
-	}
-	return entries[:keyCount], nil
+	return ncm.populateResultFromRemote(pairs, entries, resMap)
 }
 
 func (ncm *nearCacheMap) LoadAll(ctx context.Context, m *Map, replaceExisting bool, keys []interface{}) error {
+	if len(keys) == 0 {
+		// any key may be loaded
+		defer ncm.nc.Clear()
+		return m.loadAllFromRemote(ctx, replaceExisting, nil)
+	}
 	ncKeys := make([]interface{}, len(keys))
 	for i, k := range keys {
 		nck, err := ncm.toNearCacheKey(k)
@@ -401,10 +457,56 @@ func (ncm *nearCacheMap) Set(ctx context.Context, m *Map, key, value interface{}
 	if err != nil {
 		return err
 	}
+	if ncm.cacheOnUpdate {
+		return ncm.setCachingOnUpdate(ctx, m, key, value, ttl)
+	}
 	defer ncm.nc.Invalidate(key)
 	return m.setFromRemote(ctx, key, value, ttl)
 }
 
+// setCachingOnUpdate sets the value in the cluster and stores it in the Near Cache, instead of invalidating the key.
+// see: com.hazelcast.client.cache.impl.NearCachedClientCacheProxy#callPutSync
+func (ncm *nearCacheMap) setCachingOnUpdate(ctx context.Context, m *Map, key, value interface{}, ttl int64) error {
+	keyData, valueData, err := m.validateAndSerialize2(key, value)
+	if err != nil {
+		return err
+	}
+	// the key is reserved before the write, like it is for reads.
+	// a concurrent write or invalidation removes the reservation, so the value is not published after a newer one.
+	rid, err := ncm.nc.TryReserveForUpdate(key, keyData, inearcache.UpdateSemanticWriteUpdate)
+	if err != nil {
+		ncm.lg.Warnf("nearCacheMap.setCachingOnUpdate: reserving the key in the Near Cache: %v", err)
+		rid = inearcache.RecordNotReserved
+	}
+	if rid == inearcache.RecordNotReserved {
+		defer ncm.nc.Invalidate(key)
+		return m.setDataFromRemote(ctx, keyData, valueData, ttl)
+	}
+	if err := m.setDataFromRemote(ctx, keyData, valueData, ttl); err != nil {
+		// the write may have succeeded on the member, so the cached value cannot be trusted.
+		ncm.nc.Invalidate(key)
+		return err
+	}
+	// the serialized value is stored as is in the binary in-memory format.
+	var toCache interface{} = valueData
+	if ncm.nc.Config().InMemoryFormat == nearcache.InMemoryFormatObject {
+		toCache = value
+	}
+	if err := ncm.nc.TryPublishReservedUpdate(key, toCache, rid); err != nil {
+		ncm.lg.Warnf("nearCacheMap.setCachingOnUpdate: storing the value in the Near Cache: %v", err)
+	}
+	return nil
+}
+
+func (ncm *nearCacheMap) SetTTL(ctx context.Context, m *Map, key interface{}, ttl time.Duration) (bool, error) {
+	key, err := ncm.toNearCacheKey(key)
+	if err != nil {
+		return false, err
+	}
+	defer ncm.nc.Invalidate(key)
+	return m.setTTLFromRemote(ctx, key, ttl)
+}
+
 func (ncm *nearCacheMap) SetWithTTLAndMaxIdle(ctx context.Context, m *Map, key, value interface{}, ttl time.Duration, maxIdle time.Duration) error {
 	key, err := ncm.toNearCacheKey(key)
 	if err != nil {
@@ -438,6 +540,21 @@ func (ncm *nearCacheMap) GetLocalMapStats() LocalMapStats {
 	}
 }
 
+func (ncm *nearCacheMap) Reconcile(ctx context.Context, m *Map) error {
+	removed, err := ncm.rt.Reconcile(ctx, m.name)
+	if err != nil {
+		return fmt.Errorf("reconciling the Near Cache of map %s: %w", m.name, err)
+	}
+	ncm.lg.Debug(func() string {
+		return fmt.Sprintf("hazelcast.nearCacheMap.Reconcile: removed %d stale records from the Near Cache of map %s", removed, m.name)
+	})
+	return nil
+}
+
+func (ncm *nearCacheMap) Dump() ([]nearcache.RecordInfo, error) {
+	return ncm.nc.Dump()
+}
+
 func (ncm *nearCacheMap) getCachedValue(key interface{}, deserialize bool) (value interface{}, found bool, err error) {
 	value, found, err = ncm.nc.Get(key)
 	if err != nil {
@@ -466,19 +583,27 @@ func (ncm *nearCacheMap) getFromRemote(ctx context.Context, m *Map, key interfac
 	if err != nil {
 		return nil, err
 	}
+	// the key is reserved before the value is fetched.
+	// a local write invalidates the key after it completes, which removes the reservation.
+	// the reservation ID acts as the version of the key, so a value fetched before the write is never published after it.
 	rid, err := ncm.nc.TryReserveForUpdate(key, keyData, inearcache.UpdateSemanticReadUpdate)
 	if err != nil {
-		return nil, err
+		// failing to store the value in the Near Cache should not fail the read.
+		ncm.lg.Warnf("nearCacheMap.getFromRemote: reserving the key in the Near Cache: %v", err)
+		rid = inearcache.RecordNotReserved
 	}
 	value, err := m.getFromRemote(ctx, keyData)
 	if err != nil {
 		return nil, err
 	}
 	if rid != inearcache.RecordNotReserved {
-		value, err = ncm.nc.TryPublishReserved(key, value, rid)
+		cached, err := ncm.nc.TryPublishReserved(key, value, rid)
 		if err != nil {
-			return nil, err
+			// the reserved record was already removed, return the value fetched from the cluster.
+			ncm.lg.Warnf("nearCacheMap.getFromRemote: storing the value in the Near Cache: %v", err)
+			return value, nil
 		}
+		value = cached
 	}
 	return value, nil
 }
@@ -498,27 +623,27 @@ func (ncm *nearCacheMap) handleBatchInvalidationMsg(rth *inearcache.RepairingHan
 	return rth.HandleBatch(keys, sources, partitions, seqs)
 }
 
-func (ncm *nearCacheMap) populateResultFromNearCache(keys []interface{}, entries []types.Entry) ([]interface{}, error) {
+// populateResultFromNearCache returns the entries found in the near cache and the indexes of the keys which were not found.
+func (ncm *nearCacheMap) populateResultFromNearCache(keys []interface{}) ([]types.Entry, []int, error) {
 	// see: com.hazelcast.client.map.impl.nearcache.NearCachedClientMapProxy#populateResultFromNearCache
-	var missKeys []interface{}
-	var i int
-	for _, k := range keys {
+	entries := make([]types.Entry, 0, len(keys))
+	var missIdx []int
+	for i, k := range keys {
 		nk, err := ncm.toNearCacheKey(k)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 		cached, ok, err := ncm.getCachedValue(nk, true)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 		if ok && cached != nil {
-			entries[i] = types.Entry{Key: k, Value: cached}
-			i++
+			entries = append(entries, types.Entry{Key: k, Value: cached})
 			continue
 		}
-		missKeys = append(missKeys, k)
+		missIdx = append(missIdx, i)
 	}
-	return missKeys, nil
+	return entries, missIdx, nil
 }
 
 func (ncm *nearCacheMap) getNearCacheReservations(keys []interface{}, keyDatas []serialization.Data) (map[inearcache.DataString]keyReservation, error) {
@@ -550,35 +675,47 @@ func (ncm *nearCacheMap) releaseRemainingReservedKeys(rks map[inearcache.DataStr
 	}
 }
 
-func (ncm *nearCacheMap) populateResultFromRemote(pairs []proto.Pair, entries []types.Entry, reservations map[inearcache.DataString]keyReservation) (int, error) {
+// populateResultFromRemote appends the entries fetched from the cluster to entries.
+// The values of the reserved keys are published to the near cache.
+func (ncm *nearCacheMap) populateResultFromRemote(pairs []proto.Pair, entries []types.Entry, reservations map[inearcache.DataString]keyReservation) ([]types.Entry, error) {
 	// see: com.hazelcast.client.map.impl.nearcache.NearCachedClientMapProxy#populateResultFromRemote
-	// assumes entries has max capacity.
-	serialize := ncm.serializeKeys
-	i := len(entries) - len(pairs)
 	for _, p := range pairs {
 		kd := p.Key.(serialization.Data)
+		vd := p.Value.(serialization.Data)
 		k, err := ncm.ss.ToObject(kd)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		e := types.Entry{Key: k}
 		kds := inearcache.DataString(kd)
 		kr, ok := reservations[kds]
 		if !ok {
+			// the key could not be reserved, so the value is not stored in the near cache.
+			v, err := ncm.ss.ToObject(vd)
+			if err != nil {
+				return nil, err
+			}
+			entries = append(entries, types.Entry{Key: k, Value: v})
 			continue
 		}
-		if serialize {
-			k = kd
-		}
-		vd := p.Value.(serialization.Data)
-		v, err := ncm.nc.TryPublishReserved(k, vd, kr.ID)
+		v, err := ncm.nc.TryPublishReserved(kr.Key, vd, kr.ID)
 		if err != nil {
-			return 0, err
+			// failing to store the value in the Near Cache should not fail the read.
+			// the reservation is kept in reservations, so that it is released by the caller.
+			ncm.lg.Warnf("nearCacheMap.populateResultFromRemote: storing the value in the Near Cache: %v", err)
+			if v, err = ncm.ss.ToObject(vd); err != nil {
+				return nil, err
+			}
+			entries = append(entries, types.Entry{Key: k, Value: v})
+			continue
+		}
+		if data, ok := v.(serialization.Data); ok {
+			// the reservation was removed by an invalidation, the value was not stored.
+			if v, err = ncm.ss.ToObject(data); err != nil {
+				return nil, err
+			}
 		}
-		e.Value = v
-		entries[i] = e
-		i++
+		entries = append(entries, types.Entry{Key: k, Value: v})
 		delete(reservations, kds)
 	}
-	return i, nil
+	return entries, nil
 }
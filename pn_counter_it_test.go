@@ -200,6 +200,36 @@ func TestPNCounter_Add1000Sub1000(t *testing.T) {
 	})
 }
 
+func TestPNCounter_MonotonicReads(t *testing.T) {
+	it.PNCounterTester(t, func(t *testing.T, pn *hz.PNCounter) {
+		const count = 100
+		ctx := context.Background()
+		wg := &sync.WaitGroup{}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < count; i++ {
+				if _, err := pn.IncrementAndGet(ctx); err != nil {
+					panic(err)
+				}
+			}
+		}()
+		var last int64
+		for last < count {
+			v, err := pn.Get(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if v < last {
+				t.Fatalf("read %d after reading %d", v, last)
+			}
+			last = v
+		}
+		wg.Wait()
+		assert.Equal(t, int64(count), last)
+	})
+}
+
 func TestPNCounter_Reset_And_Continue(t *testing.T) {
 	cls := it.StartNewClusterWithOptions(t.Name(), it.NextPort(), 3)
 	defer cls.Shutdown()
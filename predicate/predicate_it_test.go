@@ -27,6 +27,7 @@ import (
 	"github.com/hazelcast/hazelcast-go-client/internal/it"
 	"github.com/hazelcast/hazelcast-go-client/predicate"
 	"github.com/hazelcast/hazelcast-go-client/serialization"
+	"github.com/hazelcast/hazelcast-go-client/types"
 )
 
 func TestPredicate_And(t *testing.T) {
@@ -183,6 +184,34 @@ func TestPredicate_SQL(t *testing.T) {
 	check(t, pred, target)
 }
 
+func TestPredicate_ThisRange(t *testing.T) {
+	preds := []predicate.Predicate{
+		predicate.SQL("this > 5 AND this < 100"),
+		predicate.And(predicate.Greater("this", 5), predicate.Less("this", 100)),
+	}
+	for _, pred := range preds {
+		t.Run(pred.String(), func(t *testing.T) {
+			it.MapTester(t, func(t *testing.T, m *hz.Map) {
+				ctx := context.Background()
+				var targetKeys, targetValues []interface{}
+				var targetEntries []types.Entry
+				for i := int32(0); i < 200; i++ {
+					key := fmt.Sprintf("k%d", i)
+					it.Must(m.Set(ctx, key, i))
+					if i > 5 && i < 100 {
+						targetKeys = append(targetKeys, key)
+						targetValues = append(targetValues, i)
+						targetEntries = append(targetEntries, types.NewEntry(key, i))
+					}
+				}
+				assert.ElementsMatch(t, targetKeys, it.MustValue(m.GetKeySetWithPredicate(ctx, pred)))
+				assert.ElementsMatch(t, targetValues, it.MustValue(m.GetValuesWithPredicate(ctx, pred)))
+				assert.ElementsMatch(t, targetEntries, it.MustValue(m.GetEntrySetWithPredicate(ctx, pred)))
+			})
+		})
+	}
+}
+
 func TestPredicate_True(t *testing.T) {
 	pred := predicate.True()
 	target := []interface{}{
@@ -0,0 +1,169 @@
+/*
+ * Copyright (c) 2008-2023, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package predicate
+
+import (
+	"fmt"
+
+	"github.com/hazelcast/hazelcast-go-client/serialization"
+)
+
+// PagingAnchor is the last entry of a page, which the member uses as the starting point of the next page.
+type PagingAnchor struct {
+	Key   interface{}
+	Value interface{}
+	Page  int
+}
+
+/*
+Paging creates a predicate that returns the entries which satisfy the given predicate one page at a time.
+If the given predicate is nil, all entries are returned.
+
+The entries are sorted by their natural order on the member side.
+Use NextPage, PreviousPage or SetPage to navigate to other pages and run the query again with the same paging predicate.
+Pages beyond the last one return no entries.
+
+A paging predicate keeps the anchors of the pages it has visited, so it must not be shared by concurrent queries.
+Currently, only Map.GetEntrySetWithPredicate supports paging predicates.
+*/
+func Paging(pred Predicate, pageSize int) *PagingPredicate {
+	return &PagingPredicate{
+		pred:     pred,
+		pageSize: pageSize,
+	}
+}
+
+/*
+PagingWithComparator creates a paging predicate which sorts the entries using the given comparator.
+The comparator must be serializable and must implement java.util.Comparator on the member side.
+See Paging for details.
+*/
+func PagingWithComparator(pred Predicate, comparator interface{}, pageSize int) *PagingPredicate {
+	return &PagingPredicate{
+		pred:       pred,
+		comparator: comparator,
+		pageSize:   pageSize,
+	}
+}
+
+// PagingPredicate is a predicate which splits the query result into pages.
+type PagingPredicate struct {
+	pred       Predicate
+	comparator interface{}
+	anchors    []PagingAnchor
+	pageSize   int
+	page       int
+}
+
+func (p PagingPredicate) FactoryID() int32 {
+	return factoryID
+}
+
+func (p PagingPredicate) ClassID() int32 {
+	return 15
+}
+
+func (p *PagingPredicate) ReadData(input serialization.DataInput) {
+	if pred := input.ReadObject(); pred != nil {
+		p.pred = pred.(Predicate)
+	}
+	p.comparator = input.ReadObject()
+	p.page = int(input.ReadInt32())
+	p.pageSize = int(input.ReadInt32())
+	// iteration type
+	input.ReadString()
+	length := int(input.ReadInt32())
+	p.anchors = make([]PagingAnchor, length)
+	for i := 0; i < length; i++ {
+		p.anchors[i].Page = int(input.ReadInt32())
+		p.anchors[i].Key = input.ReadObject()
+		p.anchors[i].Value = input.ReadObject()
+	}
+}
+
+func (p PagingPredicate) WriteData(output serialization.DataOutput) {
+	output.WriteObject(p.pred)
+	output.WriteObject(p.comparator)
+	output.WriteInt32(int32(p.page))
+	output.WriteInt32(int32(p.pageSize))
+	output.WriteString("ENTRY")
+	output.WriteInt32(int32(len(p.anchors)))
+	for _, a := range p.anchors {
+		output.WriteInt32(int32(a.Page))
+		output.WriteObject(a.Key)
+		output.WriteObject(a.Value)
+	}
+}
+
+func (p PagingPredicate) String() string {
+	return fmt.Sprintf("Paging(predicate=%v, pageSize=%d, page=%d)", p.pred, p.pageSize, p.page)
+}
+
+// Predicate returns the predicate which filters the entries before paging.
+func (p *PagingPredicate) Predicate() Predicate {
+	return p.pred
+}
+
+// Comparator returns the comparator which sorts the entries, or nil if the natural order is used.
+func (p *PagingPredicate) Comparator() interface{} {
+	return p.comparator
+}
+
+// PageSize returns the maximum number of entries in a page.
+func (p *PagingPredicate) PageSize() int {
+	return p.pageSize
+}
+
+// Page returns the current page index, starting from 0.
+func (p *PagingPredicate) Page() int {
+	return p.page
+}
+
+// NextPage moves to the next page.
+func (p *PagingPredicate) NextPage() {
+	p.page++
+}
+
+// PreviousPage moves to the previous page.
+// It has no effect on the first page.
+func (p *PagingPredicate) PreviousPage() {
+	if p.page > 0 {
+		p.page--
+	}
+}
+
+// SetPage moves to the given page.
+func (p *PagingPredicate) SetPage(page int) {
+	p.page = page
+}
+
+// Reset moves to the first page and clears the anchors.
+func (p *PagingPredicate) Reset() {
+	p.page = 0
+	p.anchors = nil
+}
+
+// AnchorList returns the anchors of the pages visited so far.
+func (p *PagingPredicate) AnchorList() []PagingAnchor {
+	return p.anchors
+}
+
+// SetAnchorList replaces the anchors of the pages visited so far.
+// It is called by the map proxy with the anchors returned by the member and is not meant to be called by users.
+func (p *PagingPredicate) SetAnchorList(anchors []PagingAnchor) {
+	p.anchors = anchors
+}
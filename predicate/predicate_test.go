@@ -20,6 +20,8 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/stretchr/testify/assert"
+
 	"github.com/hazelcast/hazelcast-go-client/predicate"
 )
 
@@ -34,3 +36,23 @@ func TestAnd(t *testing.T) {
 	)
 	fmt.Println(p)
 }
+
+func TestPaging(t *testing.T) {
+	p := predicate.Paging(predicate.Equal("age", 20), 10)
+	assert.Equal(t, 10, p.PageSize())
+	assert.Equal(t, 0, p.Page())
+	p.PreviousPage()
+	assert.Equal(t, 0, p.Page())
+	p.NextPage()
+	p.NextPage()
+	assert.Equal(t, 2, p.Page())
+	p.PreviousPage()
+	assert.Equal(t, 1, p.Page())
+	p.SetPage(5)
+	assert.Equal(t, 5, p.Page())
+	assert.Equal(t, "Paging(predicate=age=20, pageSize=10, page=5)", p.String())
+	p.SetAnchorList([]predicate.PagingAnchor{{Key: 1, Value: 1, Page: 0}})
+	p.Reset()
+	assert.Equal(t, 0, p.Page())
+	assert.Empty(t, p.AnchorList())
+}
@@ -0,0 +1,25 @@
+/*
+ * Copyright (c) 2008-2023, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+/*
+Package projection provides built-in projections to use with Map.Project and Map.ProjectWithPredicate.
+
+A projection selects some attributes of the values on the members, so only those attributes are sent to the client instead of the whole values.
+For instance, the following gets only the names of the employees:
+
+	names, err := m.Project(ctx, projection.SingleAttribute("name"))
+*/
+package projection
@@ -0,0 +1,41 @@
+/*
+ * Copyright (c) 2008-2023, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package projection_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/hazelcast/hazelcast-go-client/projection"
+)
+
+func TestProjectionString(t *testing.T) {
+	tcs := []struct {
+		proj projection.Projection
+		want string
+	}{
+		{proj: projection.SingleAttribute("name"), want: "SingleAttribute(name)"},
+		{proj: projection.MultiAttribute("name", "age"), want: "MultiAttribute(name, age)"},
+		{proj: projection.MultiAttribute(), want: "MultiAttribute()"},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.want, func(t *testing.T) {
+			assert.Equal(t, tc.want, tc.proj.String())
+		})
+	}
+}
@@ -19,15 +19,18 @@ package hazelcast
 import (
 	"context"
 	"fmt"
+	"sort"
 	"strings"
 	"time"
 
 	"github.com/hazelcast/hazelcast-go-client/aggregate"
 	pubcluster "github.com/hazelcast/hazelcast-go-client/cluster"
+	"github.com/hazelcast/hazelcast-go-client/hzerrors"
 	"github.com/hazelcast/hazelcast-go-client/internal/cb"
 	"github.com/hazelcast/hazelcast-go-client/internal/check"
 	"github.com/hazelcast/hazelcast-go-client/internal/client"
 	"github.com/hazelcast/hazelcast-go-client/internal/cluster"
+	"github.com/hazelcast/hazelcast-go-client/internal/event"
 	ihzerrors "github.com/hazelcast/hazelcast-go-client/internal/hzerrors"
 	"github.com/hazelcast/hazelcast-go-client/internal/invocation"
 	"github.com/hazelcast/hazelcast-go-client/internal/logger"
@@ -36,6 +39,7 @@ import (
 	iproxy "github.com/hazelcast/hazelcast-go-client/internal/proxy"
 	iserialization "github.com/hazelcast/hazelcast-go-client/internal/serialization"
 	"github.com/hazelcast/hazelcast-go-client/predicate"
+	"github.com/hazelcast/hazelcast-go-client/projection"
 	"github.com/hazelcast/hazelcast-go-client/types"
 )
 
@@ -61,6 +65,12 @@ const (
 	maxIndexAttributes = 255
 	defaultLockID      = 0
 	leaseUnset         = -1
+	// see: com.hazelcast.config.BitmapIndexOptions#DEFAULT_UNIQUE_KEY
+	defaultBitmapIndexUniqueKey = "__key"
+	// maxEntryViewRequestsInFlight is the maximum number of concurrent requests sent by Map.GetEntryViews.
+	maxEntryViewRequestsInFlight = 256
+	// see: com.hazelcast.map.impl.proxy.MapProxyImpl#loadAll
+	mapLoaderNotConfiguredMessage = "First you should configure a map store"
 )
 
 type creationBundle struct {
@@ -74,6 +84,8 @@ type creationBundle struct {
 	Logger               logger.LogAdaptor
 	NCMDestroyFn         func(service, object string)
 	Invoker              *client.Invoker
+	LockLeaseRenewer     *lockLeaseRenewer
+	EventDispatcher      *event.DispatchService
 }
 
 func (b creationBundle) Check() {
@@ -107,6 +119,12 @@ func (b creationBundle) Check() {
 	if b.Invoker == nil {
 		panic("Invoker is nil")
 	}
+	if b.LockLeaseRenewer == nil {
+		panic("LockLeaseRenewer is nil")
+	}
+	if b.EventDispatcher == nil {
+		panic("EventDispatcher is nil")
+	}
 }
 
 type proxy struct {
@@ -119,6 +137,8 @@ type proxy struct {
 	refIDGen             *iproxy.ReferenceIDGenerator
 	removeFromCacheFn    func(ctx context.Context) bool
 	invoker              *client.Invoker
+	lockLeaseRenewer     *lockLeaseRenewer
+	eventDispatcher      *event.DispatchService
 	serviceName          string
 	name                 string
 	smart                bool
@@ -136,6 +156,8 @@ func newProxy(ctx context.Context, bundle creationBundle, svc string, obj string
 		config:               bundle.Config,
 		logger:               bundle.Logger,
 		invoker:              bundle.Invoker,
+		lockLeaseRenewer:     bundle.LockLeaseRenewer,
+		eventDispatcher:      bundle.EventDispatcher,
 		removeFromCacheFn:    removeFromCacheFn,
 		refIDGen:             idg,
 		smart:                !bundle.Config.Cluster.Unisocket,
@@ -177,43 +199,76 @@ func (p *proxy) removeFromCache(ctx context.Context) bool {
 	return p.removeFromCacheFn(ctx)
 }
 
+// errNilArg is returned when a nil key or value, including a typed nil pointer, is passed to a proxy.
+// Members do not store null keys or values, so nil arguments are rejected before serialization.
+func errNilArg() error {
+	return ihzerrors.NewIllegalArgumentError("nil arg is not allowed", nil)
+}
+
+// errValueTooLarge is returned when the size of a serialized key or value exceeds Config.MaxValueSize.
+func errValueTooLarge(size, maxSize int) error {
+	msg := fmt.Sprintf("serialized value size %d exceeds the maximum value size %d", size, maxSize)
+	return ihzerrors.NewIllegalArgumentError(msg, nil)
+}
+
 func (p *proxy) validateAndSerialize(arg1 interface{}) (iserialization.Data, error) {
 	if check.Nil(arg1) {
-		return nil, ihzerrors.NewIllegalArgumentError("nil arg is not allowed", nil)
+		return nil, errNilArg()
+	}
+	return p.serializeWithinLimit(arg1)
+}
+
+// validateAndSerializeArg is like validateAndSerialize, but it does not check the size of the serialized argument against Config.MaxValueSize.
+// It is used for arguments which are not stored, such as entry processors, predicates and filters.
+func (p *proxy) validateAndSerializeArg(arg interface{}) (iserialization.Data, error) {
+	if check.Nil(arg) {
+		return nil, errNilArg()
 	}
-	return p.serializationService.ToData(arg1)
+	return p.serializationService.ToData(arg)
 }
 
 func (p *proxy) validateAndSerialize2(arg1 interface{}, arg2 interface{}) (arg1Data iserialization.Data,
 	arg2Data iserialization.Data, err error) {
 	if check.Nil(arg1) || check.Nil(arg2) {
-		return nil, nil, ihzerrors.NewIllegalArgumentError("nil arg is not allowed", nil)
+		return nil, nil, errNilArg()
 	}
-	arg1Data, err = p.serializationService.ToData(arg1)
+	arg1Data, err = p.serializeWithinLimit(arg1)
 	if err != nil {
 		return
 	}
-	arg2Data, err = p.serializationService.ToData(arg2)
+	arg2Data, err = p.serializeWithinLimit(arg2)
 	return
 }
 
 func (p *proxy) validateAndSerialize3(arg1 interface{}, arg2 interface{}, arg3 interface{}) (arg1Data iserialization.Data,
 	arg2Data iserialization.Data, arg3Data iserialization.Data, err error) {
 	if check.Nil(arg1) || check.Nil(arg2) || check.Nil(arg3) {
-		return nil, nil, nil, ihzerrors.NewIllegalArgumentError("nil arg is not allowed", nil)
+		return nil, nil, nil, errNilArg()
 	}
-	arg1Data, err = p.serializationService.ToData(arg1)
+	arg1Data, err = p.serializeWithinLimit(arg1)
 	if err != nil {
 		return
 	}
-	arg2Data, err = p.serializationService.ToData(arg2)
+	arg2Data, err = p.serializeWithinLimit(arg2)
 	if err != nil {
 		return
 	}
-	arg3Data, err = p.serializationService.ToData(arg3)
+	arg3Data, err = p.serializeWithinLimit(arg3)
 	return
 }
 
+// serializeWithinLimit serializes the given object and checks the size of the result against Config.MaxValueSize.
+func (p *proxy) serializeWithinLimit(object interface{}) (iserialization.Data, error) {
+	data, err := p.serializationService.ToData(object)
+	if err != nil {
+		return nil, err
+	}
+	if maxSize := p.config.MaxValueSize; maxSize > 0 && len(data) > maxSize {
+		return nil, errValueTooLarge(len(data), maxSize)
+	}
+	return data, nil
+}
+
 func (p *proxy) validateAndSerializeAggregate(agg aggregate.Aggregator) (arg1Data iserialization.Data, err error) {
 	if check.Nil(agg) {
 		return nil, ihzerrors.NewIllegalArgumentError("aggregate should not be nil", nil)
@@ -222,6 +277,14 @@ func (p *proxy) validateAndSerializeAggregate(agg aggregate.Aggregator) (arg1Dat
 	return
 }
 
+func (p *proxy) validateAndSerializeProjection(proj projection.Projection) (arg1Data iserialization.Data, err error) {
+	if check.Nil(proj) {
+		return nil, ihzerrors.NewIllegalArgumentError("projection should not be nil", nil)
+	}
+	arg1Data, err = p.serializationService.ToData(proj)
+	return
+}
+
 func (p *proxy) validateAndSerializePredicate(pred predicate.Predicate) (arg1Data iserialization.Data, err error) {
 	if check.Nil(pred) {
 		return nil, ihzerrors.NewIllegalArgumentError("predicate should not be nil", nil)
@@ -230,6 +293,69 @@ func (p *proxy) validateAndSerializePredicate(pred predicate.Predicate) (arg1Dat
 	return
 }
 
+func (p *proxy) makePagingPredicateHolder(pp *predicate.PagingPredicate, iterationType byte) (codec.PagingPredicateHolder, error) {
+	if pp.PageSize() <= 0 {
+		return codec.PagingPredicateHolder{}, ihzerrors.NewIllegalArgumentError(fmt.Sprintf("page size should be positive: %d", pp.PageSize()), nil)
+	}
+	if pp.Page() < 0 {
+		return codec.PagingPredicateHolder{}, ihzerrors.NewIllegalArgumentError(fmt.Sprintf("page should not be negative: %d", pp.Page()), nil)
+	}
+	if _, ok := pp.Predicate().(*predicate.PagingPredicate); ok {
+		return codec.PagingPredicateHolder{}, ihzerrors.NewIllegalArgumentError("nested paging predicates are not supported", nil)
+	}
+	predData, err := p.serializationService.ToData(pp.Predicate())
+	if err != nil {
+		return codec.PagingPredicateHolder{}, err
+	}
+	compData, err := p.serializationService.ToData(pp.Comparator())
+	if err != nil {
+		return codec.PagingPredicateHolder{}, err
+	}
+	anchors := pp.AnchorList()
+	pages := make([]int32, len(anchors))
+	pairs := make([]proto.Pair, len(anchors))
+	for i, a := range anchors {
+		keyData, err := p.serializationService.ToData(a.Key)
+		if err != nil {
+			return codec.PagingPredicateHolder{}, err
+		}
+		valueData, err := p.serializationService.ToData(a.Value)
+		if err != nil {
+			return codec.PagingPredicateHolder{}, err
+		}
+		pages[i] = int32(a.Page)
+		pairs[i] = proto.NewPair(keyData, valueData)
+	}
+	return codec.PagingPredicateHolder{
+		AnchorDataListHolder: codec.AnchorDataListHolder{
+			AnchorPageList: pages,
+			AnchorDataList: pairs,
+		},
+		PredicateData:   predData,
+		ComparatorData:  compData,
+		PageSize:        int32(pp.PageSize()),
+		Page:            int32(pp.Page()),
+		IterationTypeId: iterationType,
+	}, nil
+}
+
+func (p *proxy) updatePagingAnchors(pp *predicate.PagingPredicate, holder codec.AnchorDataListHolder) error {
+	anchors := make([]predicate.PagingAnchor, len(holder.AnchorDataList))
+	for i, pair := range holder.AnchorDataList {
+		key, err := p.convertToObject(pair.Key.(iserialization.Data))
+		if err != nil {
+			return err
+		}
+		value, err := p.convertToObject(pair.Value.(iserialization.Data))
+		if err != nil {
+			return err
+		}
+		anchors[i] = predicate.PagingAnchor{Key: key, Value: value, Page: int(holder.AnchorPageList[i])}
+	}
+	pp.SetAnchorList(anchors)
+	return nil
+}
+
 func (p *proxy) validateAndSerializeValues(values []interface{}) ([]iserialization.Data, error) {
 	valuesData := make([]iserialization.Data, len(values))
 	for i, value := range values {
@@ -282,18 +408,29 @@ func (p *proxy) convertToData(object interface{}) (iserialization.Data, error) {
 	return p.serializationService.ToData(object)
 }
 
-func (p *proxy) partitionToPairs(keyValuePairs []types.Entry) (map[int32][]proto.Pair, error) {
+// partitionEntries contains the entries of a partition and their serialized forms.
+type partitionEntries struct {
+	entries []types.Entry
+	pairs   []proto.Pair
+}
+
+func (p *proxy) partitionToPairs(keyValuePairs []types.Entry) (map[int32]*partitionEntries, error) {
 	ps := p.partitionService
-	partitionToPairs := map[int32][]proto.Pair{}
-	for _, pair := range keyValuePairs {
+	partitionToPairs := map[int32]*partitionEntries{}
+	for i, pair := range keyValuePairs {
 		if keyData, valueData, err := p.validateAndSerialize2(pair.Key, pair.Value); err != nil {
-			return nil, err
+			return nil, fmt.Errorf("entry %d: %w", i, err)
 		} else {
 			if partitionKey, err := ps.GetPartitionID(keyData); err != nil {
 				return nil, err
 			} else {
-				arr := partitionToPairs[partitionKey]
-				partitionToPairs[partitionKey] = append(arr, proto.NewPair(keyData, valueData))
+				pe, ok := partitionToPairs[partitionKey]
+				if !ok {
+					pe = &partitionEntries{}
+					partitionToPairs[partitionKey] = pe
+				}
+				pe.entries = append(pe.entries, pair)
+				pe.pairs = append(pe.pairs, proto.NewPair(keyData, valueData))
 			}
 		}
 	}
@@ -329,21 +466,42 @@ func (p *proxy) convertPairsToValues(pairs []proto.Pair) ([]interface{}, error)
 }
 
 func (p *proxy) putAll(keyValuePairs []types.Entry, f func(partitionID int32, entries []proto.Pair) cb.Future) error {
-	if partitionToPairs, err := p.partitionToPairs(keyValuePairs); err != nil {
+	partitionToPairs, err := p.partitionToPairs(keyValuePairs)
+	if err != nil {
 		return err
-	} else {
-		// create futures
-		futures := make([]cb.Future, 0, len(partitionToPairs))
-		for partitionID, entries := range partitionToPairs {
-			futures = append(futures, f(partitionID, entries))
-		}
-		for _, future := range futures {
-			if _, err := future.Result(); err != nil {
-				return err
-			}
+	}
+	return putAllPartitions(partitionToPairs, f)
+}
+
+// putAllPartitions sends the entries of each partition using f and waits for all of them.
+// The failed partitions are reported with a *hzerrors.PutAllError.
+func putAllPartitions(partitionToPairs map[int32]*partitionEntries, f func(partitionID int32, entries []proto.Pair) cb.Future) error {
+	partitionIDs := make([]int32, 0, len(partitionToPairs))
+	for partitionID := range partitionToPairs {
+		partitionIDs = append(partitionIDs, partitionID)
+	}
+	sort.Slice(partitionIDs, func(i, j int) bool {
+		return partitionIDs[i] < partitionIDs[j]
+	})
+	// create futures
+	futures := make([]cb.Future, len(partitionIDs))
+	for i, partitionID := range partitionIDs {
+		futures[i] = f(partitionID, partitionToPairs[partitionID].pairs)
+	}
+	var failures []hzerrors.PutAllFailure
+	for i, future := range futures {
+		if _, err := future.Result(); err != nil {
+			failures = append(failures, hzerrors.PutAllFailure{
+				Err:         err,
+				Entries:     partitionToPairs[partitionIDs[i]].entries,
+				PartitionID: partitionIDs[i],
+			})
 		}
-		return nil
 	}
+	if len(failures) > 0 {
+		return &hzerrors.PutAllError{Failures: failures}
+	}
+	return nil
 }
 
 func (p *proxy) stringToPartitionID(key string) (int32, error) {
@@ -18,9 +18,12 @@ package hazelcast
 
 import (
 	"context"
+	"fmt"
 
 	pubcluster "github.com/hazelcast/hazelcast-go-client/cluster"
+	"github.com/hazelcast/hazelcast-go-client/hzerrors"
 	"github.com/hazelcast/hazelcast-go-client/internal/check"
+	ihzerrors "github.com/hazelcast/hazelcast-go-client/internal/hzerrors"
 	"github.com/hazelcast/hazelcast-go-client/internal/proto"
 	"github.com/hazelcast/hazelcast-go-client/internal/proto/codec"
 	iserialization "github.com/hazelcast/hazelcast-go-client/internal/serialization"
@@ -36,6 +39,8 @@ List is not a partitioned Hazelcast data structure.
 So all the contents of the List are stored in a single machine (and in the backup).
 So, a single List will not scale by adding more members in the cluster.
 
+Index based methods return an error matching hzerrors.ErrIndexOutOfBounds if the index is out of the range of the list.
+
 For details, see https://docs.hazelcast.com/imdg/latest/data-structures/map.html
 */
 type List struct {
@@ -69,7 +74,7 @@ func (l *List) Add(ctx context.Context, element interface{}) (bool, error) {
 // AddAt inserts the specified element at the specified index.
 // Shifts the subsequent elements to the right.
 func (l *List) AddAt(ctx context.Context, index int, element interface{}) error {
-	indexAsInt32, err := check.NonNegativeInt32(index)
+	indexAsInt32, err := checkListIndex(index)
 	if err != nil {
 		return err
 	}
@@ -107,7 +112,7 @@ func (l *List) AddAllAt(ctx context.Context, index int, elements ...interface{})
 	if len(elements) == 0 {
 		return false, nil
 	}
-	indexAsInt32, err := check.NonNegativeInt32(index)
+	indexAsInt32, err := checkListIndex(index)
 	if err != nil {
 		return false, err
 	}
@@ -131,6 +136,12 @@ func (l *List) AddListener(ctx context.Context, includeValue bool, handler ListI
 	return l.addListener(ctx, includeValue, handler)
 }
 
+// AddItemListener adds an item listener for this list.
+// It is the same as AddListener.
+func (l *List) AddItemListener(ctx context.Context, includeValue bool, handler ListItemNotifiedHandler) (types.UUID, error) {
+	return l.addListener(ctx, includeValue, handler)
+}
+
 // Clear removes all elements from the list.
 func (l *List) Clear(ctx context.Context) error {
 	request := codec.EncodeListClearRequest(l.name)
@@ -173,7 +184,7 @@ func (l *List) ContainsAll(ctx context.Context, elements ...interface{}) (bool,
 
 // Get retrieves the element at given index.
 func (l *List) Get(ctx context.Context, index int) (interface{}, error) {
-	indexAsInt32, err := check.NonNegativeInt32(index)
+	indexAsInt32, err := checkListIndex(index)
 	if err != nil {
 		return nil, err
 	}
@@ -241,7 +252,7 @@ func (l *List) Remove(ctx context.Context, element interface{}) (bool, error) {
 // RemoveAt removes the element at the given index.
 // Returns the removed element.
 func (l *List) RemoveAt(ctx context.Context, index int) (interface{}, error) {
-	indexAsInt32, err := check.NonNegativeInt32(index)
+	indexAsInt32, err := checkListIndex(index)
 	if err != nil {
 		return nil, err
 	}
@@ -271,6 +282,12 @@ func (l *List) RemoveAll(ctx context.Context, elements ...interface{}) (bool, er
 	return codec.DecodeListCompareAndRemoveAllResponse(response), nil
 }
 
+// RemoveItemListener removes the item listener with the given subscription ID.
+// It is the same as RemoveListener.
+func (l *List) RemoveItemListener(ctx context.Context, subscriptionID types.UUID) error {
+	return l.RemoveListener(ctx, subscriptionID)
+}
+
 // RemoveListener removes the item listener with the given subscription ID.
 func (l *List) RemoveListener(ctx context.Context, subscriptionID types.UUID) error {
 	return l.listenerBinder.Remove(ctx, subscriptionID)
@@ -297,7 +314,7 @@ func (l *List) RetainAll(ctx context.Context, elements ...interface{}) (bool, er
 // Set replaces the element at the specified index in this list with the specified element.
 // Returns the previous element from the list.
 func (l *List) Set(ctx context.Context, index int, element interface{}) (interface{}, error) {
-	indexAsInt32, err := check.NonNegativeInt32(index)
+	indexAsInt32, err := checkListIndex(index)
 	if err != nil {
 		return nil, err
 	}
@@ -325,15 +342,20 @@ func (l *List) Size(ctx context.Context) (int, error) {
 
 // SubList returns a view of this list that contains elements between index numbers
 // from start (inclusive) to end (exclusive).
+// Returns an empty slice if start and end are equal.
 func (l *List) SubList(ctx context.Context, start int, end int) ([]interface{}, error) {
-	startAsInt32, err := check.NonNegativeInt32(start)
+	startAsInt32, err := checkListIndex(start)
 	if err != nil {
 		return nil, err
 	}
-	endAsInt32, err := check.NonNegativeInt32(end)
+	endAsInt32, err := checkListIndex(end)
 	if err != nil {
 		return nil, err
 	}
+	if start > end {
+		msg := fmt.Sprintf("start index %d is greater than end index %d", start, end)
+		return nil, ihzerrors.NewClientError(msg, hzerrors.ErrIllegalArgument, hzerrors.ErrIndexOutOfBounds)
+	}
 	request := codec.EncodeListSubRequest(l.name, startAsInt32, endAsInt32)
 	response, err := l.invokeOnPartition(ctx, request, l.partitionID)
 	if err != nil {
@@ -374,3 +396,12 @@ func (l *List) addListener(ctx context.Context, includeValue bool, handler ListI
 	err := l.listenerBinder.Add(ctx, subscriptionID, addRequest, removeRequest, listenerHandler)
 	return subscriptionID, err
 }
+
+// checkListIndex returns an error matching both hzerrors.ErrIndexOutOfBounds and hzerrors.ErrIllegalArgument if the index cannot be used for a list.
+func checkListIndex(index int) (int32, error) {
+	index32, err := check.NonNegativeInt32(index)
+	if err != nil {
+		return 0, ihzerrors.NewClientError(fmt.Sprintf("invalid list index %d", index), err, hzerrors.ErrIndexOutOfBounds)
+	}
+	return index32, nil
+}
@@ -18,12 +18,18 @@ package hazelcast
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"reflect"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/hazelcast/hazelcast-go-client/aggregate"
+	pubcluster "github.com/hazelcast/hazelcast-go-client/cluster"
+	"github.com/hazelcast/hazelcast-go-client/hzerrors"
 	"github.com/hazelcast/hazelcast-go-client/internal/cb"
+	"github.com/hazelcast/hazelcast-go-client/internal/check"
 	ihzerrors "github.com/hazelcast/hazelcast-go-client/internal/hzerrors"
 	"github.com/hazelcast/hazelcast-go-client/internal/proto"
 	"github.com/hazelcast/hazelcast-go-client/internal/proto/codec"
@@ -31,6 +37,7 @@ import (
 	"github.com/hazelcast/hazelcast-go-client/internal/serialization"
 	"github.com/hazelcast/hazelcast-go-client/nearcache"
 	"github.com/hazelcast/hazelcast-go-client/predicate"
+	"github.com/hazelcast/hazelcast-go-client/projection"
 	"github.com/hazelcast/hazelcast-go-client/types"
 )
 
@@ -95,6 +102,9 @@ Once the lock context is created, it can be used to lock/unlock entries and used
 	// release the lock once done with it
 	err = m.Unlock(lockCtx, "some-key")
 
+A lock acquired with a lease is released automatically once the lease expires.
+Use LockWithLeaseRenewal to renew the lease in the background while a long-running critical section holds the lock.
+
 As mentioned before, lock context is a regular context.Context which carry a special lock ID.
 You can pass any context.Context to any Map function, but in that case lock ownership between operations using the same hazelcast.Client instance is not possible.
 
@@ -112,6 +122,9 @@ These benefits do not come for free. See the following trade-offs:
   - If invalidation is enabled and entries are updated frequently, then invalidations will be costly.
   - Near Cache breaks the strong consistency guarantees; you might be reading stale data.
 
+Writes made through a client are read back by the same client, though.
+A Get which follows a Put on the same client never returns a value older than the one put, even before the invalidation event from the member arrives.
+
 Near Cache is highly recommended for data structures that are mostly read.
 
 You must enable the Near Cache on the client, without the need to configure it on the server.
@@ -142,6 +155,17 @@ The following types cannot be used as keys without setting SerializeKeys==true:
   - Slices
   - Structs with having at least one field with an incomparable type.
 
+The keys in the Near Cache can be stored on disk periodically and when the client shuts down, so that the Near Cache is populated again in the background right after the client restarts.
+That can be accomplished by enabling the preloader, shown in the example below:
+
+	ncc := nearcache.Config{
+		Name: "mymap*",
+		Preloader: nearcache.PreloaderConfig{
+			Enabled:   true,
+			Directory: "/var/lib/myapp/nearcache",
+		},
+	}
+
 Following Map methods support the Near Cache:
 
   - Clear
@@ -153,7 +177,9 @@ Following Map methods support the Near Cache:
   - ExecuteOnKeys
   - Get
   - GetAll
+  - LoadAll
   - LoadAllReplacing
+  - LoadAllWithKeys
   - LoadAllWithoutReplacing
   - LocalMapStats
   - Put
@@ -174,6 +200,8 @@ Following Map methods support the Near Cache:
   - Replace
   - ReplaceIfSame
   - Set
+  - SetTTL
+  - SetTTLAffected
   - SetWithTTL
   - SetWithTTLAndMaxIdle
   - TryPut
@@ -275,6 +303,17 @@ func (m *Map) AddListener(ctx context.Context, listener MapListener, includeValu
 	return m.addEntryListener(ctx, flags, includeValue, nil, nil, m.mapListenerEventHandler(listener))
 }
 
+/*
+AddListenerWithConfig adds a continuous entry listener to this map using the Key, Predicate, IncludeValue and IncludeInitial fields of config.
+The event types are determined by the handlers set in listener, the event type flags of config are ignored.
+If config.IncludeInitial is true and listener.EntryAdded is set, the entries already in the map are delivered to it before live events.
+Since the initial entries are fetched after the listener is registered, an entry modified in between may be delivered twice.
+*/
+func (m *Map) AddListenerWithConfig(ctx context.Context, listener MapListener, config MapEntryListenerConfig) (types.UUID, error) {
+	flags := m.prepareFlagsOfMapListener(listener)
+	return m.addEntryListenerWithConfig(ctx, flags, config, m.mapListenerEventHandler(listener))
+}
+
 // AddListenerWithKey adds a continuous entry listener on a specific key to this map.
 func (m *Map) AddListenerWithKey(ctx context.Context, listener MapListener, key interface{}, includeValue bool) (types.UUID, error) {
 	flags := m.prepareFlagsOfMapListener(listener)
@@ -282,6 +321,8 @@ func (m *Map) AddListenerWithKey(ctx context.Context, listener MapListener, key
 }
 
 // AddListenerWithPredicate adds a continuous entry listener to this map. Events are filtered by a predicate.
+// The predicate is evaluated on the members, so only the events of the entries which satisfy it are sent to the client.
+// The listener is registered again after reconnecting to the cluster, until it is removed with RemoveListener using the returned subscription ID.
 func (m *Map) AddListenerWithPredicate(ctx context.Context, listener MapListener, predicate predicate.Predicate, includeValue bool) (types.UUID, error) {
 	flags := m.prepareFlagsOfMapListener(listener)
 	return m.addEntryListener(ctx, flags, includeValue, nil, predicate, m.mapListenerEventHandler(listener))
@@ -294,10 +335,40 @@ func (m *Map) AddListenerWithPredicateAndKey(ctx context.Context, listener MapLi
 }
 
 // AddEntryListener adds a continuous entry listener to this map.
-// Deprecated: In favor of AddListener, AddListenerWithKey, AddListenerWithPredicate,
+// If config.IncludeInitial is true and EntryAdded events are enabled in config, the entries already in the map are delivered to the handler before live events.
+// Since the initial entries are fetched after the listener is registered, an entry modified in between may be delivered twice.
+// Deprecated: In favor of AddListener, AddListenerWithConfig, AddListenerWithKey, AddListenerWithPredicate,
 // AddListenerWithPredicateAndKey methods.
 func (m *Map) AddEntryListener(ctx context.Context, config MapEntryListenerConfig, handler EntryNotifiedHandler) (types.UUID, error) {
-	return m.addEntryListener(ctx, config.flags, config.IncludeValue, config.Key, config.Predicate, handler)
+	return m.addEntryListenerWithConfig(ctx, config.flags, config, handler)
+}
+
+/*
+AddEntryListenerUntilDone adds a continuous entry listener to this map which is removed automatically when the given context is done.
+It is useful in request-scoped code, where the listener must not outlive the request.
+The context is also used to add the listener, see AddEntryListener for the details of the listener configuration.
+The listener is removed in the background, so a few events may be delivered to the handler after the context is done.
+The listener may be removed earlier with RemoveEntryListener using the returned subscription ID, removing it twice is safe.
+Returns hzerrors.ErrIllegalArgument if the context can never be done, such as context.Background().
+*/
+func (m *Map) AddEntryListenerUntilDone(ctx context.Context, config MapEntryListenerConfig, handler EntryNotifiedHandler) (types.UUID, error) {
+	if ctx == nil || ctx.Done() == nil {
+		return types.UUID{}, ihzerrors.NewIllegalArgumentError("context must be cancellable", nil)
+	}
+	subscriptionID, err := m.AddEntryListener(ctx, config, handler)
+	if err != nil {
+		return types.UUID{}, err
+	}
+	go func() {
+		<-ctx.Done()
+		// the given context is done, so use a new one to remove the listener.
+		if err := m.RemoveEntryListener(context.Background(), subscriptionID); err != nil {
+			m.logger.Debug(func() string {
+				return fmt.Sprintf("hazelcast.Map.AddEntryListenerUntilDone: removing entry listener %s: %v", subscriptionID, err)
+			})
+		}
+	}()
+	return subscriptionID, nil
 }
 
 // AddIndex adds an index to this map for the specified entries so that queries can run faster.
@@ -345,6 +416,8 @@ func (m *Map) AggregateWithPredicate(ctx context.Context, agg aggregate.Aggregat
 }
 
 // Clear deletes all entries one by one and fires related events.
+// If the map has a MapStore, the entries are deleted from the store as well.
+// The Near Cache of the map, if any, is cleared.
 func (m *Map) Clear(ctx context.Context) error {
 	if m.hasNearCache {
 		return m.ncm.Clear(ctx, m)
@@ -395,6 +468,9 @@ func (m *Map) Evict(ctx context.Context, key interface{}) (bool, error) {
 }
 
 // EvictAll deletes all entries without firing related events.
+// Unlike Clear, the entries are removed only from the memory, and the MapStore of the map, if any, is not called.
+// Evicted entries are loaded from the MapStore on subsequent reads.
+// The Near Cache of the map, if any, is cleared.
 func (m *Map) EvictAll(ctx context.Context) error {
 	if m.hasNearCache {
 		return m.ncm.EvictAll(ctx, m)
@@ -404,7 +480,7 @@ func (m *Map) EvictAll(ctx context.Context) error {
 
 // ExecuteOnEntries applies the user defined EntryProcessor to all the entries in the map.
 func (m *Map) ExecuteOnEntries(ctx context.Context, entryProcessor interface{}) ([]types.Entry, error) {
-	processorData, err := m.validateAndSerialize(entryProcessor)
+	processorData, err := m.validateAndSerializeArg(entryProcessor)
 	if err != nil {
 		return nil, err
 	}
@@ -422,6 +498,10 @@ func (m *Map) ExecuteOnEntries(ctx context.Context, entryProcessor interface{})
 }
 
 // ExecuteOnKey applies the user defined EntryProcessor to the entry with the specified key in the map.
+// The entry processor runs on the member which owns the key and its result is returned.
+// If the entry processor returns null, the result is nil without an error.
+// If the map has a near cache, the key is invalidated in it.
+// See ExecuteOnKeyAs for a variant which returns the result as a specific type.
 func (m *Map) ExecuteOnKey(ctx context.Context, entryProcessor interface{}, key interface{}) (interface{}, error) {
 	if m.hasNearCache {
 		return m.ncm.ExecuteOnKey(ctx, m, entryProcessor, key)
@@ -429,7 +509,28 @@ func (m *Map) ExecuteOnKey(ctx context.Context, entryProcessor interface{}, key
 	return m.executeOnKeyFromRemote(ctx, entryProcessor, key)
 }
 
+/*
+ExecuteOnKeyAs applies the user defined EntryProcessor to the entry with the specified key in the map and returns the result as type T.
+If the result is not of type T, an error which wraps hzerrors.ErrClassCast is returned.
+A nil result is returned as the zero value of T.
+
+Results of IdentifiedDataSerializable and Portable types are created by the registered factories, so T is usually a pointer type:
+
+	res, err := hazelcast.ExecuteOnKeyAs[*Employee](ctx, m, &RaiseProcessor{Percent: 10}, "alice")
+*/
+func ExecuteOnKeyAs[T any](ctx context.Context, m *Map, entryProcessor interface{}, key interface{}) (T, error) {
+	v, err := m.ExecuteOnKey(ctx, entryProcessor, key)
+	if err != nil {
+		var zero T
+		return zero, err
+	}
+	return castResult[T](v)
+}
+
 // ExecuteOnKeys applies the user defined EntryProcessor to the entries with the specified keys in the map.
+// The non-null results of the entry processor are returned in no particular order.
+// The results for which the entry processor returned null are left out, so the result may be shorter than keys and its items do not correspond to keys by position.
+// If the map has a near cache, the keys are invalidated in it.
 func (m *Map) ExecuteOnKeys(ctx context.Context, entryProcessor interface{}, keys ...interface{}) ([]interface{}, error) {
 	if m.hasNearCache {
 		return m.ncm.ExecuteOnKeys(ctx, m, entryProcessor, keys)
@@ -438,8 +539,10 @@ func (m *Map) ExecuteOnKeys(ctx context.Context, entryProcessor interface{}, key
 }
 
 // ExecuteOnEntriesWithPredicate applies the user defined EntryProcessor to all the entries in the map which satisfies the predicate.
+// The predicate is evaluated on the members, so the entry processor runs only on the matching entries.
+// Returns the results of the entry processor mapped by the keys of the matching entries.
 func (m *Map) ExecuteOnEntriesWithPredicate(ctx context.Context, entryProcessor interface{}, pred predicate.Predicate) ([]types.Entry, error) {
-	processorData, err := m.validateAndSerialize(entryProcessor)
+	processorData, err := m.validateAndSerializeArg(entryProcessor)
 	if err != nil {
 		return nil, err
 	}
@@ -473,6 +576,7 @@ func (m *Map) ForceUnlock(ctx context.Context, key interface{}) error {
 	if keyData, err := m.validateAndSerialize(key); err != nil {
 		return err
 	} else {
+		m.lockLeaseRenewer.StopKey(m.name, keyData)
 		refID := m.refIDGen.NextID()
 		request := codec.EncodeMapForceUnlockRequest(m.name, keyData, refID)
 		_, err = m.invokeOnKey(ctx, request, keyData)
@@ -535,7 +639,7 @@ func (m *Map) evictAllFromRemote(ctx context.Context) error {
 }
 
 func (m *Map) executeOnKeyFromRemote(ctx context.Context, entryProcessor interface{}, key interface{}) (interface{}, error) {
-	processorData, err := m.validateAndSerialize(entryProcessor)
+	processorData, err := m.validateAndSerializeArg(entryProcessor)
 	if err != nil {
 		return nil, err
 	}
@@ -556,7 +660,7 @@ func (m *Map) executeOnKeysFromRemote(ctx context.Context, entryProcessor interf
 	if len(keys) == 0 {
 		return nil, nil
 	}
-	processorData, err := m.validateAndSerialize(entryProcessor)
+	processorData, err := m.validateAndSerializeArg(entryProcessor)
 	if err != nil {
 		return nil, err
 	}
@@ -620,6 +724,31 @@ func (m *Map) deleteFromRemote(ctx context.Context, key interface{}) error {
 	return nil
 }
 
+func (m *Map) setTTL(ctx context.Context, key interface{}, ttl time.Duration) (bool, error) {
+	if m.hasNearCache {
+		return m.ncm.SetTTL(ctx, m, key, ttl)
+	}
+	return m.setTTLFromRemote(ctx, key, ttl)
+}
+
+func (m *Map) setTTLFromRemote(ctx context.Context, key interface{}, ttl time.Duration) (bool, error) {
+	keyData, err := m.validateAndSerialize(key)
+	if err != nil {
+		return false, err
+	}
+	ttlMillis := ttl.Milliseconds()
+	if ttl < 0 {
+		// a negative TTL shorter than a millisecond must not turn into 0, which means infinite.
+		ttlMillis = ttlUnset
+	}
+	request := codec.EncodeMapSetTtlRequest(m.name, keyData, ttlMillis)
+	resp, err := m.invokeOnKey(ctx, request, keyData)
+	if err != nil {
+		return false, err
+	}
+	return codec.DecodeMapSetTtlResponse(resp), nil
+}
+
 func (m *Map) loadAllFromRemote(ctx context.Context, replaceExisting bool, keys []interface{}) error {
 	var request *proto.ClientMessage
 	if len(keys) == 0 {
@@ -631,8 +760,14 @@ func (m *Map) loadAllFromRemote(ctx context.Context, replaceExisting bool, keys
 		}
 		request = codec.EncodeMapLoadGivenKeysRequest(m.name, keyDatas, replaceExisting)
 	}
-	_, err := m.invokeOnRandomTarget(ctx, request, nil)
-	return err
+	if _, err := m.invokeOnRandomTarget(ctx, request, nil); err != nil {
+		// the member rejects loading with this message if there is no MapLoader for the map.
+		if errors.Is(err, hzerrors.ErrIllegalArgument) && strings.Contains(err.Error(), mapLoaderNotConfiguredMessage) {
+			return ihzerrors.NewIllegalArgumentError(fmt.Sprintf("loading map %s, is a MapLoader configured for it?", m.name), err)
+		}
+		return err
+	}
+	return nil
 }
 
 func (m *Map) putAllFromRemote(ctx context.Context, entries []types.Entry) error {
@@ -745,7 +880,7 @@ func (m *Map) removeFromRemote(ctx context.Context, key interface{}) (interface{
 }
 
 func (m *Map) removeAllFromRemote(ctx context.Context, predicate predicate.Predicate) error {
-	predicateData, err := m.validateAndSerialize(predicate)
+	predicateData, err := m.validateAndSerializeArg(predicate)
 	if err != nil {
 		return err
 	}
@@ -797,11 +932,15 @@ func (m *Map) removeIfSameFromRemote(ctx context.Context, key, value interface{}
 }
 
 func (m *Map) setFromRemote(ctx context.Context, key, value interface{}, ttl int64) error {
-	lid := iproxy.ExtractLockID(ctx)
 	keyData, valueData, err := m.validateAndSerialize2(key, value)
 	if err != nil {
 		return err
 	}
+	return m.setDataFromRemote(ctx, keyData, valueData, ttl)
+}
+
+func (m *Map) setDataFromRemote(ctx context.Context, keyData, valueData serialization.Data, ttl int64) error {
+	lid := iproxy.ExtractLockID(ctx)
 	request := codec.EncodeMapSetRequest(m.name, keyData, valueData, lid, ttl)
 	if _, err := m.invokeOnKey(ctx, request, keyData); err != nil {
 		return err
@@ -850,7 +989,14 @@ func (m *Map) tryPutFromRemote(ctx context.Context, key interface{}, value inter
 	return codec.DecodeMapTryPutResponse(response), nil
 }
 
-// GetAll returns the entries for the given keys.
+/*
+GetAll returns the entries for the given keys.
+The keys are grouped by partition and a single request is sent for each partition, which is usually much faster than calling Get for each key.
+Keys which do not exist in the map are not included in the result.
+If a key occurs more than once in keys, its entry is included in the result once.
+If the map has a near cache, only the keys which are not found in the near cache are requested from the cluster.
+See GetAllOrdered for a variant which returns the values in the order of the keys.
+*/
 func (m *Map) GetAll(ctx context.Context, keys ...interface{}) ([]types.Entry, error) {
 	if len(keys) == 0 {
 		return nil, nil
@@ -858,24 +1004,76 @@ func (m *Map) GetAll(ctx context.Context, keys ...interface{}) ([]types.Entry, e
 	if ctx == nil {
 		ctx = context.Background()
 	}
+	ok, err := m.newOrderedKeys(keys)
+	if err != nil {
+		return nil, err
+	}
 	if m.hasNearCache {
-		return m.ncm.GetAll(ctx, m, keys)
+		return m.ncm.GetAll(ctx, m, ok.keys, ok.keyDatas)
 	}
-	return m.getAll(ctx, keys)
+	return m.getAll(ctx, ok.keyDatas)
 }
 
-func (m *Map) getAll(ctx context.Context, keys []interface{}) ([]types.Entry, error) {
-	partitionToKeys, err := m.partitionToKeys(keys, false)
+func (m *Map) getAll(ctx context.Context, keyDatas []serialization.Data) ([]types.Entry, error) {
+	partitionToKeys, err := m.partitionToKeys(keyDatas)
 	if err != nil {
 		return nil, err
 	}
-	pairs, err := m.getAllFromRemote(ctx, len(keys), partitionToKeys)
+	pairs, err := m.getAllFromRemote(ctx, len(keyDatas), partitionToKeys)
 	if err != nil {
 		return nil, err
 	}
 	return m.convertPairsToEntries(pairs)
 }
 
+// GetAllOrdered returns the values for the given keys.
+// The returned slice is aligned with keys, so the value at index i belongs to keys[i].
+// The value for a key which does not exist in the map is nil.
+// If a key occurs more than once in keys, it is fetched once and its value is repeated in the result.
+func (m *Map) GetAllOrdered(ctx context.Context, keys []interface{}) ([]interface{}, error) {
+	if len(keys) == 0 {
+		return nil, nil
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	ok, err := m.newOrderedKeys(keys)
+	if err != nil {
+		return nil, err
+	}
+	values := make([]interface{}, len(keys))
+	if m.hasNearCache {
+		entries, err := m.ncm.GetAll(ctx, m, ok.keys, ok.keyDatas)
+		if err != nil {
+			return nil, err
+		}
+		for _, e := range entries {
+			keyData, err := m.convertToData(e.Key)
+			if err != nil {
+				return nil, err
+			}
+			ok.set(values, keyData, e.Value)
+		}
+		return values, nil
+	}
+	partitionToKeys, err := m.partitionToKeys(ok.keyDatas)
+	if err != nil {
+		return nil, err
+	}
+	pairs, err := m.getAllFromRemote(ctx, len(ok.keyDatas), partitionToKeys)
+	if err != nil {
+		return nil, err
+	}
+	for _, pair := range pairs {
+		value, err := m.convertToObject(pair.Value.(serialization.Data))
+		if err != nil {
+			return nil, err
+		}
+		ok.set(values, pair.Key.(serialization.Data), value)
+	}
+	return values, nil
+}
+
 // GetEntrySet returns a clone of the mappings contained in this map.
 func (m *Map) GetEntrySet(ctx context.Context) ([]types.Entry, error) {
 	request := codec.EncodeMapEntrySetRequest(m.name)
@@ -886,9 +1084,17 @@ func (m *Map) GetEntrySet(ctx context.Context) ([]types.Entry, error) {
 	}
 }
 
-// GetEntrySetWithPredicate returns a clone of the mappings contained in this map.
-func (m *Map) GetEntrySetWithPredicate(ctx context.Context, predicate predicate.Predicate) ([]types.Entry, error) {
-	if predData, err := m.validateAndSerialize(predicate); err != nil {
+/*
+GetEntrySetWithPredicate returns a clone of the mappings contained in this map.
+
+If the predicate is a paging predicate, only the entries in its current page are returned.
+The anchors returned by the member are stored in the paging predicate, so calling this method again after moving to the next page returns the entries in that page.
+*/
+func (m *Map) GetEntrySetWithPredicate(ctx context.Context, pred predicate.Predicate) ([]types.Entry, error) {
+	if pp, ok := pred.(*predicate.PagingPredicate); ok {
+		return m.getEntrySetWithPagingPredicate(ctx, pp)
+	}
+	if predData, err := m.validateAndSerializeArg(pred); err != nil {
 		return nil, err
 	} else {
 		request := codec.EncodeMapEntriesWithPredicateRequest(m.name, predData)
@@ -900,6 +1106,139 @@ func (m *Map) GetEntrySetWithPredicate(ctx context.Context, predicate predicate.
 	}
 }
 
+func (m *Map) getEntrySetWithPagingPredicate(ctx context.Context, pp *predicate.PagingPredicate) ([]types.Entry, error) {
+	holder, err := m.makePagingPredicateHolder(pp, codec.IterationTypeEntry)
+	if err != nil {
+		return nil, err
+	}
+	request := codec.EncodeMapEntriesWithPagingPredicateRequest(m.name, holder)
+	response, err := m.invokeOnRandomTarget(ctx, request, nil)
+	if err != nil {
+		return nil, err
+	}
+	pairs, anchorHolder := codec.DecodeMapEntriesWithPagingPredicateResponse(response)
+	entries, err := m.convertPairsToEntries(pairs)
+	if err != nil {
+		return nil, err
+	}
+	if err := m.updatePagingAnchors(pp, anchorHolder); err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
+
+/*
+GetEntrySetWithPredicateStream returns an iterator over the entries which satisfy the given predicate.
+Instead of returning all entries at once, the entries are fetched from the member in batches of at most batchSize entries, using a paging predicate.
+The next batch is fetched when the entries of the current batch are consumed, so at most one batch is kept in memory.
+If the predicate is nil, all entries are returned.
+The given predicate must not be a paging predicate.
+
+The entries are returned in their natural order.
+If the map is modified during the iteration, the modified entries may be skipped or returned more than once.
+
+The batches are fetched using the given context, so cancelling it stops the iteration with the context error.
+
+Iterating over the entries:
+
+	iter, err := m.GetEntrySetWithPredicateStream(ctx, predicate.Greater("age", 30), 1000)
+	if err != nil {
+		return err
+	}
+	for iter.HasNext() {
+		entry, err := iter.Next()
+		if err != nil {
+			return err
+		}
+		// handle entry
+	}
+*/
+func (m *Map) GetEntrySetWithPredicateStream(ctx context.Context, pred predicate.Predicate, batchSize int) (*EntryIterator, error) {
+	if batchSize <= 0 {
+		return nil, ihzerrors.NewIllegalArgumentError(fmt.Sprintf("batch size should be positive: %d", batchSize), nil)
+	}
+	if _, ok := pred.(*predicate.PagingPredicate); ok {
+		return nil, ihzerrors.NewIllegalArgumentError("paging predicates are not supported", nil)
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	return &EntryIterator{
+		ctx:       ctx,
+		m:         m,
+		pp:        predicate.Paging(pred, batchSize),
+		batchSize: batchSize,
+	}, nil
+}
+
+// EntryIterator iterates over the entries returned by Map.GetEntrySetWithPredicateStream.
+// It is not concurrency-safe.
+type EntryIterator struct {
+	ctx       context.Context
+	err       error
+	m         *Map
+	pp        *predicate.PagingPredicate
+	batch     []types.Entry
+	current   types.Entry
+	index     int
+	batchSize int
+	done      bool
+}
+
+// HasNext prepares the next entry for reading via Next method.
+// It fetches the next batch from the member if the current batch is consumed.
+// It returns true on success, or false if there is no next entry or an error happened before.
+// If fetching the next batch fails, it returns true and the error is returned by the following Next call.
+func (it *EntryIterator) HasNext() bool {
+	if it.err != nil {
+		return false
+	}
+	if it.index >= len(it.batch) {
+		if it.done {
+			return false
+		}
+		if err := it.fetchBatch(); err != nil {
+			it.err = err
+			return true
+		}
+		if len(it.batch) == 0 {
+			return false
+		}
+	}
+	it.current = it.batch[it.index]
+	it.index++
+	return true
+}
+
+// Next returns the current entry.
+// Every call to Next, even the first one, must be preceded by a call to HasNext.
+func (it *EntryIterator) Next() (types.Entry, error) {
+	if it.err != nil {
+		return types.Entry{}, it.err
+	}
+	return it.current, nil
+}
+
+func (it *EntryIterator) fetchBatch() error {
+	if err := it.ctx.Err(); err != nil {
+		return err
+	}
+	entries, err := it.m.getEntrySetWithPagingPredicate(it.ctx, it.pp)
+	if err != nil {
+		return err
+	}
+	// the batches are fetched in order, so only the anchor of the last batch is required for the next one.
+	// dropping the others keeps the size of the requests constant.
+	if anchors := it.pp.AnchorList(); len(anchors) > 1 {
+		it.pp.SetAnchorList(anchors[len(anchors)-1:])
+	}
+	it.pp.NextPage()
+	it.batch = entries
+	it.index = 0
+	it.done = len(entries) < it.batchSize
+	return nil
+}
+
 // GetEntryView returns the SimpleEntryView for the specified key.
 // If there is no entry view for the key, nil is returned.
 func (m *Map) GetEntryView(ctx context.Context, key interface{}) (*types.SimpleEntryView, error) {
@@ -911,35 +1250,104 @@ func (m *Map) GetEntryView(ctx context.Context, key interface{}) (*types.SimpleE
 		if response, err := m.invokeOnKey(ctx, request, keyData); err != nil {
 			return nil, err
 		} else {
-			ev, maxIdle := codec.DecodeMapGetEntryViewResponse(response)
-			if ev == nil {
-				return nil, nil
-			}
-			// XXX: creating a new SimpleEntryView here in order to convert key, data and use maxIdle
-			deserializedKey, err := m.convertToObject(ev.Key.(serialization.Data))
-			if err != nil {
-				return nil, err
-			}
-			deserializedValue, err := m.convertToObject(ev.Value.(serialization.Data))
-			if err != nil {
-				return nil, err
+			return m.decodeEntryView(response)
+		}
+	}
+}
+
+/*
+GetEntryViews returns the SimpleEntryView for each of the given keys.
+Keys which do not exist in the map are not included in the result.
+If a key occurs more than once in keys, its entry view is included in the result once.
+The order of the entry views in the result is not defined, use the Key field of an entry view to match it with its key.
+There is no batch request for entry views, so the requests for the keys are sent concurrently, which is usually much faster than calling GetEntryView for each key.
+At most 256 requests are in flight at the same time.
+The remaining requests are cancelled if one of them fails.
+*/
+func (m *Map) GetEntryViews(ctx context.Context, keys ...interface{}) ([]*types.SimpleEntryView, error) {
+	if len(keys) == 0 {
+		return nil, nil
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	ok, err := m.newOrderedKeys(keys)
+	if err != nil {
+		return nil, err
+	}
+	// cancelling the context stops the requests in flight if one of the requests fails.
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	lid := iproxy.ExtractLockID(ctx)
+	start := func(keyData serialization.Data) cb.Future {
+		request := codec.EncodeMapGetEntryViewRequest(m.name, keyData, lid)
+		return m.invoker.CB().TryContextFuture(ctx, func(ctx context.Context, attempt int) (interface{}, error) {
+			if attempt > 0 {
+				request = request.Copy()
 			}
-			newEntryView := types.NewSimpleEntryView(
-				deserializedKey,
-				deserializedValue,
-				ev.Cost,
-				ev.CreationTime,
-				ev.ExpirationTime,
-				ev.Hits,
-				ev.LastAccessTime,
-				ev.LastStoredTime,
-				ev.LastUpdateTime,
-				ev.Version,
-				ev.TTL,
-				maxIdle)
-			return newEntryView, nil
+			return m.invokeOnKey(ctx, request, keyData)
+		})
+	}
+	inFlight := len(ok.keyDatas)
+	if inFlight > maxEntryViewRequestsInFlight {
+		inFlight = maxEntryViewRequestsInFlight
+	}
+	futures := make([]cb.Future, 0, inFlight)
+	for _, keyData := range ok.keyDatas[:inFlight] {
+		futures = append(futures, start(keyData))
+	}
+	next := inFlight
+	evs := make([]*types.SimpleEntryView, 0, len(ok.keyDatas))
+	for i := 0; i < len(futures); i++ {
+		fr, err := futures[i].Result()
+		futures[i] = nil
+		if err != nil {
+			return nil, err
+		}
+		// a request is started for the next key as soon as one completes.
+		if next < len(ok.keyDatas) {
+			futures = append(futures, start(ok.keyDatas[next]))
+			next++
 		}
+		ev, err := m.decodeEntryView(fr.(*proto.ClientMessage))
+		if err != nil {
+			return nil, err
+		}
+		if ev != nil {
+			evs = append(evs, ev)
+		}
+	}
+	return evs, nil
+}
+
+func (m *Map) decodeEntryView(response *proto.ClientMessage) (*types.SimpleEntryView, error) {
+	ev, maxIdle := codec.DecodeMapGetEntryViewResponse(response)
+	if ev == nil {
+		return nil, nil
+	}
+	// XXX: creating a new SimpleEntryView here in order to convert key, data and use maxIdle
+	deserializedKey, err := m.convertToObject(ev.Key.(serialization.Data))
+	if err != nil {
+		return nil, err
+	}
+	deserializedValue, err := m.convertToObject(ev.Value.(serialization.Data))
+	if err != nil {
+		return nil, err
 	}
+	newEntryView := types.NewSimpleEntryView(
+		deserializedKey,
+		deserializedValue,
+		ev.Cost,
+		ev.CreationTime,
+		ev.ExpirationTime,
+		ev.Hits,
+		ev.LastAccessTime,
+		ev.LastStoredTime,
+		ev.LastUpdateTime,
+		ev.Version,
+		ev.TTL,
+		maxIdle)
+	return newEntryView, nil
 }
 
 // GetKeySet returns keys contained in this map.
@@ -962,8 +1370,12 @@ func (m *Map) GetKeySet(ctx context.Context) ([]interface{}, error) {
 }
 
 // GetKeySetWithPredicate returns keys contained in this map.
-func (m *Map) GetKeySetWithPredicate(ctx context.Context, predicate predicate.Predicate) ([]interface{}, error) {
-	if predicateData, err := m.validateAndSerializePredicate(predicate); err != nil {
+// Paging predicates are not supported, use GetEntrySetWithPredicate with a paging predicate instead.
+func (m *Map) GetKeySetWithPredicate(ctx context.Context, pred predicate.Predicate) ([]interface{}, error) {
+	if _, ok := pred.(*predicate.PagingPredicate); ok {
+		return nil, ihzerrors.NewIllegalArgumentError("paging predicates are not supported", nil)
+	}
+	if predicateData, err := m.validateAndSerializePredicate(pred); err != nil {
 		return nil, err
 	} else {
 		request := codec.EncodeMapKeySetWithPredicateRequest(m.name, predicateData)
@@ -986,8 +1398,12 @@ func (m *Map) GetValues(ctx context.Context) ([]interface{}, error) {
 }
 
 // GetValuesWithPredicate returns a list clone of the values contained in this map.
-func (m *Map) GetValuesWithPredicate(ctx context.Context, predicate predicate.Predicate) ([]interface{}, error) {
-	if predicateData, err := m.validateAndSerializePredicate(predicate); err != nil {
+// Paging predicates are not supported, use GetEntrySetWithPredicate with a paging predicate instead.
+func (m *Map) GetValuesWithPredicate(ctx context.Context, pred predicate.Predicate) ([]interface{}, error) {
+	if _, ok := pred.(*predicate.PagingPredicate); ok {
+		return nil, ihzerrors.NewIllegalArgumentError("paging predicates are not supported", nil)
+	}
+	if predicateData, err := m.validateAndSerializePredicate(pred); err != nil {
 		return nil, err
 	} else {
 		request := codec.EncodeMapValuesWithPredicateRequest(m.name, predicateData)
@@ -999,6 +1415,58 @@ func (m *Map) GetValuesWithPredicate(ctx context.Context, predicate predicate.Pr
 	}
 }
 
+// Increment atomically adds delta to the int64 value of the given key.
+// See IncrementAndGet for details.
+func (m *Map) Increment(ctx context.Context, key interface{}, delta int64) error {
+	_, err := m.IncrementAndGet(ctx, key, delta)
+	return err
+}
+
+/*
+IncrementAndGet atomically adds delta to the int64 value of the given key and returns the new value.
+If the key does not exist, its value is treated as 0, so the key is set to delta.
+Returns an error if the value of the key is not an int64.
+
+Entry processors must be implemented on the member side, so the value is updated with a compare-and-set on the key instead.
+Concurrent updates to the key are never lost, but they cause retries, so updating a heavily contended key may take several round trips.
+*/
+func (m *Map) IncrementAndGet(ctx context.Context, key interface{}, delta int64) (int64, error) {
+	keyData, err := m.validateAndSerialize(key)
+	if err != nil {
+		return 0, err
+	}
+	for {
+		// the value is read from the member, since a compare-and-set with a stale Near Cache value would never succeed.
+		v, err := m.getFromRemote(ctx, keyData)
+		if err != nil {
+			return 0, err
+		}
+		if v == nil {
+			old, err := m.PutIfAbsent(ctx, key, delta)
+			if err != nil {
+				return 0, err
+			}
+			if old == nil {
+				return delta, nil
+			}
+			continue
+		}
+		current, ok := v.(int64)
+		if !ok {
+			msg := fmt.Sprintf("incrementing the value of the key: expected int64 value, but got %T", v)
+			return 0, ihzerrors.NewClientError(msg, nil, hzerrors.ErrClassCast)
+		}
+		next := current + delta
+		ok, err = m.ReplaceIfSame(ctx, key, current, next)
+		if err != nil {
+			return 0, err
+		}
+		if ok {
+			return next, nil
+		}
+	}
+}
+
 // IsEmpty returns true if this map contains no key-value mappings.
 func (m *Map) IsEmpty(ctx context.Context) (bool, error) {
 	request := codec.EncodeMapIsEmptyRequest(m.name)
@@ -1023,15 +1491,50 @@ func (m *Map) IsLocked(ctx context.Context, key interface{}) (bool, error) {
 	}
 }
 
-// LoadAllWithoutReplacing loads all keys from the store at server side or loads the given keys if provided.
+/*
+LoadAll loads all keys from the MapLoader of the map at server side.
+If replaceExistingValues is true, the values of the existing keys are replaced with the loaded ones.
+The Near Cache of the map, if there is one, is cleared.
+Returns an error wrapping hzerrors.ErrIllegalArgument if there is no MapLoader configured for the map.
+*/
+func (m *Map) LoadAll(ctx context.Context, replaceExistingValues bool) error {
+	return m.loadAll(ctx, replaceExistingValues, nil)
+}
+
+/*
+LoadAllWithKeys loads the given keys from the MapLoader of the map at server side.
+If replaceExistingValues is true, the values of the existing keys are replaced with the loaded ones.
+The given keys are invalidated in the Near Cache of the map, if there is one.
+keys must not be nil, an empty slice loads nothing.
+Returns an error wrapping hzerrors.ErrIllegalArgument if there is no MapLoader configured for the map.
+*/
+func (m *Map) LoadAllWithKeys(ctx context.Context, keys []interface{}, replaceExistingValues bool) error {
+	if keys == nil {
+		return ihzerrors.NewIllegalArgumentError("keys should not be nil", nil)
+	}
+	if len(keys) == 0 {
+		return nil
+	}
+	return m.loadAll(ctx, replaceExistingValues, keys)
+}
+
+// LoadAllWithoutReplacing loads the given keys from the store at server side.
+// It does nothing if no keys are given, use LoadAll to load all keys.
 func (m *Map) LoadAllWithoutReplacing(ctx context.Context, keys ...interface{}) error {
-	return m.loadAll(ctx, false, keys...)
+	if len(keys) == 0 {
+		return nil
+	}
+	return m.loadAll(ctx, false, keys)
 }
 
-// LoadAllReplacing loads all keys from the store at server side or loads the given keys if provided.
+// LoadAllReplacing loads the given keys from the store at server side.
 // Replaces existing keys.
+// It does nothing if no keys are given, use LoadAll to load all keys.
 func (m *Map) LoadAllReplacing(ctx context.Context, keys ...interface{}) error {
-	return m.loadAll(ctx, true, keys...)
+	if len(keys) == 0 {
+		return nil
+	}
+	return m.loadAll(ctx, true, keys)
 }
 
 /*
@@ -1059,7 +1562,72 @@ func (m *Map) LockWithLease(ctx context.Context, key interface{}, leaseTime time
 	return m.lock(ctx, key, leaseTime.Milliseconds())
 }
 
+/*
+LockWithLeaseRenewal acquires the lock for the specified lease time and renews the lease in the background while the lock is held.
+Otherwise, it behaves the same as Lock function.
+
+The lease is renewed periodically, at one third of the lease time, until the key is unlocked using the same lock context, force unlocked, the map is destroyed or the client is shut down.
+Unlocking the key stops the renewal even if the lock was acquired more than once.
+
+The lease is renewed by the client, so the renewal is not possible if the client cannot reach the cluster.
+In that case, the lock is lost once the lease expires, and other owners may acquire it.
+Renewal failures are logged as warnings.
+If the lock is found to be held by another owner, the renewal stops.
+*/
+func (m *Map) LockWithLeaseRenewal(ctx context.Context, key interface{}, leaseTime time.Duration) error {
+	if leaseTime <= 0 {
+		return ihzerrors.NewIllegalArgumentError("lease time must be positive", nil)
+	}
+	lid := iproxy.ExtractLockID(ctx)
+	keyData, err := m.validateAndSerialize(key)
+	if err != nil {
+		return err
+	}
+	request := codec.EncodeMapLockRequest(m.name, keyData, lid, leaseTime.Milliseconds(), m.refIDGen.NextID())
+	if _, err := m.invokeOnKey(ctx, request, keyData); err != nil {
+		return err
+	}
+	m.lockLeaseRenewer.Start(m, keyData, lid, leaseTime)
+	return nil
+}
+
+// Project applies the given projection to all entries in the map and returns the results.
+// The results are in the order the cluster returned them.
+// The result of a projection may be nil, e.g., when the projected attribute does not exist in a value.
+func (m *Map) Project(ctx context.Context, proj projection.Projection) ([]interface{}, error) {
+	projData, err := m.validateAndSerializeProjection(proj)
+	if err != nil {
+		return nil, err
+	}
+	request := codec.EncodeMapProjectRequest(m.name, projData)
+	response, err := m.invokeOnRandomTarget(ctx, request, nil)
+	if err != nil {
+		return nil, err
+	}
+	return m.convertToObjects(codec.DecodeMapProjectResponse(response))
+}
+
+// ProjectWithPredicate applies the given projection to the entries in the map which satisfy the given predicate and returns the results.
+// See Project for details.
+func (m *Map) ProjectWithPredicate(ctx context.Context, proj projection.Projection, pred predicate.Predicate) ([]interface{}, error) {
+	projData, err := m.validateAndSerializeProjection(proj)
+	if err != nil {
+		return nil, err
+	}
+	predData, err := m.validateAndSerializePredicate(pred)
+	if err != nil {
+		return nil, err
+	}
+	request := codec.EncodeMapProjectWithPredicateRequest(m.name, projData, predData)
+	response, err := m.invokeOnRandomTarget(ctx, request, nil)
+	if err != nil {
+		return nil, err
+	}
+	return m.convertToObjects(codec.DecodeMapProjectWithPredicateResponse(response))
+}
+
 // Put sets the value for the given key and returns the old value.
+// The entry inherits the TTL and max idle of the map configuration, see MapConfig.
 func (m *Map) Put(ctx context.Context, key interface{}, value interface{}) (interface{}, error) {
 	return m.putWithTTL(ctx, key, value, int64(ttlUnset))
 }
@@ -1084,8 +1652,13 @@ func (m *Map) PutWithTTLAndMaxIdle(ctx context.Context, key interface{}, value i
 }
 
 // PutAll copies all the mappings from the specified map to this map.
+// The entries are grouped by partition and a single request is sent for each partition.
+// All keys and values are validated before any request is sent, the returned error identifies the first entry which is nil or cannot be serialized.
+// If the map has a near cache, all written keys are invalidated in it.
 // No atomicity guarantees are given. In the case of a failure, some key-value tuples may get written,
 // while others are not.
+// If the requests of some partitions fail, the returned error is a *hzerrors.PutAllError which contains the entries of the failed partitions,
+// so that only those can be retried.
 func (m *Map) PutAll(ctx context.Context, entries ...types.Entry) error {
 	if len(entries) == 0 {
 		return nil
@@ -1217,6 +1790,8 @@ func (m *Map) ReplaceIfSame(ctx context.Context, key interface{}, oldValue inter
 }
 
 // Set sets the value for the given key.
+// The entry inherits the TTL and max idle of the map configuration, see MapConfig.
+// The value is stored in the Near Cache if its LocalUpdatePolicy is nearcache.LocalUpdatePolicyCacheOnUpdate, otherwise the key is invalidated.
 func (m *Map) Set(ctx context.Context, key interface{}, value interface{}) error {
 	return m.set(ctx, key, value, ttlUnset)
 }
@@ -1224,36 +1799,27 @@ func (m *Map) Set(ctx context.Context, key interface{}, value interface{}) error
 // SetTTL updates the TTL value of the entry specified by the given key with a new TTL value.
 // Given TTL (maximum time in seconds for this entry to stay in the map) is used.
 // Set ttl to 0 for infinite timeout.
+// Set ttl to a negative value to use the TTL of the map configuration.
+// The value of the entry is not changed, but it is invalidated in the Near Cache, if there is one.
 func (m *Map) SetTTL(ctx context.Context, key interface{}, ttl time.Duration) error {
-	if keyData, err := m.validateAndSerialize(key); err != nil {
-		return err
-	} else {
-		request := codec.EncodeMapSetTtlRequest(m.name, keyData, ttl.Milliseconds())
-		_, err := m.invokeOnKey(ctx, request, keyData)
-		return err
-	}
+	_, err := m.setTTL(ctx, key, ttl)
+	return err
 }
 
 // SetTTLAffected updates the TTL value of the entry specified by the given key with a new TTL value.
 // Given TTL (maximum time in seconds for this entry to stay in the map) is used.
 // Returns true if entry is affected.
 // Set ttl to 0 for infinite timeout.
+// Set ttl to a negative value to use the TTL of the map configuration.
+// The value of the entry is not changed, but it is invalidated in the Near Cache, if there is one.
 func (m *Map) SetTTLAffected(ctx context.Context, key interface{}, ttl time.Duration) (bool, error) {
-	keyData, err := m.validateAndSerialize(key)
-	if err != nil {
-		return false, err
-	}
-	request := codec.EncodeMapSetTtlRequest(m.name, keyData, ttl.Milliseconds())
-	resp, err := m.invokeOnKey(ctx, request, keyData)
-	if err != nil {
-		return false, err
-	}
-	return codec.DecodeMapSetTtlResponse(resp), nil
+	return m.setTTL(ctx, key, ttl)
 }
 
 // SetWithTTL sets the value for the given key.
 // Given TTL (maximum time in seconds for this entry to stay in the map) is used.
 // Set ttl to 0 for infinite timeout.
+// The Near Cache is updated the same way as Set does.
 func (m *Map) SetWithTTL(ctx context.Context, key interface{}, value interface{}, ttl time.Duration) error {
 	return m.set(ctx, key, value, ttl.Milliseconds())
 }
@@ -1280,6 +1846,103 @@ func (m *Map) Size(ctx context.Context) (int, error) {
 	}
 }
 
+/*
+SubmitToKey applies the user defined EntryProcessor to the entry with the specified key in the map without waiting for the result.
+The returned EntryProcessorFuture is used to wait for the result of the entry processor.
+The key and the entry processor are validated and serialized before SubmitToKey returns, errors in those steps are returned by SubmitToKey.
+The request is sent in the background and retried the same way as ExecuteOnKey, other errors are reported by EntryProcessorFuture.Get.
+The entry processor runs with the given context, so cancelling it cancels the invocation.
+
+Submitting several entry processors and collecting the results:
+
+	futures := make([]*hazelcast.EntryProcessorFuture, len(keys))
+	for i, key := range keys {
+		if futures[i], err = m.SubmitToKey(ctx, key, &IncrementProcessor{}); err != nil {
+			return err
+		}
+	}
+	for _, f := range futures {
+		v, err := f.Get(ctx)
+		// handle v and err
+	}
+*/
+func (m *Map) SubmitToKey(ctx context.Context, key interface{}, entryProcessor interface{}) (*EntryProcessorFuture, error) {
+	if check.Nil(key) || check.Nil(entryProcessor) {
+		return nil, errNilArg()
+	}
+	var ncKey interface{}
+	if m.hasNearCache {
+		var err error
+		if ncKey, err = m.ncm.toNearCacheKey(key); err != nil {
+			return nil, err
+		}
+	}
+	processorData, err := m.validateAndSerializeArg(entryProcessor)
+	if err != nil {
+		return nil, err
+	}
+	keyData, err := m.validateAndSerialize(key)
+	if err != nil {
+		return nil, err
+	}
+	partitionID, err := m.partitionService.GetPartitionID(keyData)
+	if err != nil {
+		return nil, err
+	}
+	lid := iproxy.ExtractLockID(ctx)
+	request := codec.EncodeMapExecuteOnKeyRequest(m.name, processorData, keyData, lid)
+	now := time.Now()
+	fut := m.invoker.CB().TryContextFuture(ctx, func(ctx context.Context, attempt int) (interface{}, error) {
+		if attempt > 0 {
+			request = request.Copy()
+		}
+		if inv, err := m.invokeOnPartitionAsync(ctx, request, partitionID, now); err != nil {
+			return nil, err
+		} else {
+			return inv.GetWithContext(ctx)
+		}
+	})
+	f := &EntryProcessorFuture{doneCh: make(chan struct{})}
+	go func() {
+		defer close(f.doneCh)
+		resp, err := fut.Result()
+		if m.hasNearCache {
+			// the entry processor may have modified the entry even if it failed.
+			m.ncm.nc.Invalidate(ncKey)
+		}
+		if err != nil {
+			f.err = err
+			return
+		}
+		f.value, f.err = m.convertToObject(codec.DecodeMapExecuteOnKeyResponse(resp.(*proto.ClientMessage)))
+	}()
+	return f, nil
+}
+
+// EntryProcessorFuture is the pending result of an entry processor submitted with Map.SubmitToKey.
+type EntryProcessorFuture struct {
+	value  interface{}
+	err    error
+	doneCh chan struct{}
+}
+
+// Get waits for the entry processor to complete and returns its result.
+// If the context is done before that, the context error is returned, but the entry processor is not cancelled.
+// Get may be called several times, it returns the same result each time.
+func (f *EntryProcessorFuture) Get(ctx context.Context) (interface{}, error) {
+	select {
+	case <-f.doneCh:
+		return f.value, f.err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// Done returns a channel which is closed when the entry processor completes.
+func (f *EntryProcessorFuture) Done() <-chan struct{} {
+	return f.doneCh
+}
+
 // TryLock tries to acquire the lock for the specified key.
 // When the lock is not available, the current goroutine doesn't wait and returns false immediately.
 func (m *Map) TryLock(ctx context.Context, key interface{}) (bool, error) {
@@ -1315,6 +1978,44 @@ func (m *Map) TryPutWithTimeout(ctx context.Context, key interface{}, value inte
 	return m.tryPut(ctx, key, value, timeout.Milliseconds())
 }
 
+/*
+TryPutWithTTL tries to put the given key and value into this map with the given TTL and waits until the operation is completed or the given acquire timeout is reached.
+The key is locked using the lock context in ctx for the duration of the put, so it returns false if the key cannot be locked within acquireTimeout.
+The key is unlocked after the put, unless it was already locked using the same lock context.
+The entry expires and gets evicted after ttl.
+Set ttl to 0 for an entry which never expires.
+Returns an error if acquireTimeout or ttl is negative.
+*/
+func (m *Map) TryPutWithTTL(ctx context.Context, key interface{}, value interface{}, acquireTimeout time.Duration, ttl time.Duration) (bool, error) {
+	if acquireTimeout < 0 {
+		return false, ihzerrors.NewIllegalArgumentError("acquire timeout must be non-negative", nil)
+	}
+	if ttl < 0 {
+		return false, ihzerrors.NewIllegalArgumentError("ttl must be non-negative", nil)
+	}
+	keyData, err := m.validateAndSerialize(key)
+	if err != nil {
+		return false, err
+	}
+	ok, err := m.tryLock(ctx, key, leaseUnset, acquireTimeout.Milliseconds())
+	if err != nil || !ok {
+		return false, err
+	}
+	// set invalidates the Near Cache
+	err = m.set(ctx, key, value, ttl.Milliseconds())
+	// the key is unlocked without stopping the lock lease renewal, since the lock may have been acquired before this call.
+	// the lock is released even if ctx is canceled, so the key is not left locked.
+	lid := iproxy.ExtractLockID(ctx)
+	request := codec.EncodeMapUnlockRequest(m.name, keyData, lid, m.refIDGen.NextID())
+	if _, uerr := m.invokeOnKey(context.Background(), request, keyData); uerr != nil && err == nil {
+		err = uerr
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
 // TryRemove tries to remove the given key from this map and returns immediately.
 func (m *Map) TryRemove(ctx context.Context, key interface{}) (bool, error) {
 	return m.tryRemove(ctx, key, 0)
@@ -1331,6 +2032,7 @@ func (m *Map) Unlock(ctx context.Context, key interface{}) error {
 	if keyData, err := m.validateAndSerialize(key); err != nil {
 		return err
 	} else {
+		m.lockLeaseRenewer.StopLock(m.name, keyData, lid)
 		refID := m.refIDGen.NextID()
 		request := codec.EncodeMapUnlockRequest(m.name, keyData, lid, refID)
 		_, err = m.invokeOnKey(ctx, request, keyData)
@@ -1338,6 +2040,27 @@ func (m *Map) Unlock(ctx context.Context, key interface{}) error {
 	}
 }
 
+// DumpNearCache returns the metadata of the entries in the Near Cache of this map, without their values.
+// The result is a snapshot, it is not updated when the Near Cache changes.
+// Returns nil if the map does not have a Near Cache.
+func (m *Map) DumpNearCache() ([]nearcache.RecordInfo, error) {
+	if m.hasNearCache {
+		return m.ncm.Dump()
+	}
+	return nil, nil
+}
+
+// ReconcileNearCache fetches the latest invalidation metadata of this map from the members and removes the stale entries from its Near Cache immediately,
+// instead of waiting for the periodic reconciliation.
+// An entry is stale if an invalidation for its partition was missed after the entry was cached.
+// Does nothing if the map does not have a Near Cache or invalidation is not enabled for the Near Cache.
+func (m *Map) ReconcileNearCache(ctx context.Context) error {
+	if m.hasNearCache {
+		return m.ncm.Reconcile(ctx, m)
+	}
+	return nil
+}
+
 func (m *Map) LocalMapStats() LocalMapStats {
 	if m.hasNearCache {
 		return m.ncm.GetLocalMapStats()
@@ -1349,6 +2072,7 @@ func (m *Map) destroyLocally(ctx context.Context) bool {
 	m.logger.Trace(func() string {
 		return fmt.Sprintf("hazelcast.Map.destroyLocally: %s", m.name)
 	})
+	m.lockLeaseRenewer.StopMap(m.name)
 	if m.hasNearCache {
 		if err := m.ncm.Destroy(ctx, m.name); err != nil {
 			m.logger.Errorf("hazelcast.Map.destroyLocally: %w", err)
@@ -1376,24 +2100,84 @@ func (m *Map) addEntryListener(ctx context.Context, flags int32, includeValue bo
 		}
 	}
 	if predicate != nil {
-		if predicateData, err = m.validateAndSerialize(predicate); err != nil {
+		if predicateData, err = m.validateAndSerializeArg(predicate); err != nil {
 			return types.UUID{}, err
 		}
 	}
 	subscriptionID := types.NewUUID()
 	addRequest := m.makeListenerRequest(keyData, predicateData, flags, includeValue)
-	listenerHandler := func(msg *proto.ClientMessage) {
-		m.makeListenerDecoder(msg, keyData, predicateData, m.makeEntryNotifiedListenerHandler(handler))
-	}
+	listenerHandler := m.makeEntryListenerHandler(keyData, predicateData, handler)
 	removeRequest := codec.EncodeMapRemoveEntryListenerRequest(m.name, subscriptionID)
 	err = m.listenerBinder.Add(ctx, subscriptionID, addRequest, removeRequest, listenerHandler)
 	return subscriptionID, err
 }
 
-func (m *Map) loadAll(ctx context.Context, replaceExisting bool, keys ...interface{}) error {
-	if len(keys) == 0 {
-		return nil
+// addEntryListenerWithConfig adds an entry listener for the given event flags.
+// The initial entries are delivered first as EntryAdded events if config.IncludeInitial is true and flags include EntryAdded.
+func (m *Map) addEntryListenerWithConfig(ctx context.Context, flags int32, config MapEntryListenerConfig, handler EntryNotifiedHandler) (types.UUID, error) {
+	if !config.IncludeInitial || flags&int32(EntryAdded) == 0 {
+		return m.addEntryListener(ctx, flags, config.IncludeValue, config.Key, config.Predicate, handler)
+	}
+	h := &initialEntriesHandler{handler: handler}
+	subscriptionID, err := m.addEntryListener(ctx, flags, config.IncludeValue, config.Key, config.Predicate, h.handle)
+	if err != nil {
+		return types.UUID{}, err
+	}
+	events, err := m.initialEntryEvents(ctx, config)
+	if err != nil {
+		if rerr := m.RemoveEntryListener(ctx, subscriptionID); rerr != nil {
+			m.logger.Errorf("error removing entry listener %s: %w", subscriptionID, rerr)
+		}
+		return types.UUID{}, err
+	}
+	h.deliverInitial(events)
+	return subscriptionID, nil
+}
+
+func (m *Map) initialEntryEvents(ctx context.Context, config MapEntryListenerConfig) ([]*EntryNotified, error) {
+	var entries []types.Entry
+	switch {
+	case config.Key != nil && config.Predicate == nil:
+		value, err := m.Get(ctx, config.Key)
+		if err != nil {
+			return nil, err
+		}
+		if value != nil {
+			entries = []types.Entry{{Key: config.Key, Value: value}}
+		}
+	case config.Key != nil:
+		pred := predicate.And(predicate.Equal("__key", config.Key), config.Predicate)
+		es, err := m.GetEntrySetWithPredicate(ctx, pred)
+		if err != nil {
+			return nil, err
+		}
+		entries = es
+	case config.Predicate != nil:
+		es, err := m.GetEntrySetWithPredicate(ctx, config.Predicate)
+		if err != nil {
+			return nil, err
+		}
+		entries = es
+	default:
+		es, err := m.GetEntrySet(ctx)
+		if err != nil {
+			return nil, err
+		}
+		entries = es
+	}
+	events := make([]*EntryNotified, len(entries))
+	for i, e := range entries {
+		var value interface{}
+		if config.IncludeValue {
+			value = e.Value
+		}
+		events[i] = newEntryNotifiedEvent(m.name, pubcluster.MemberInfo{}, e.Key, value, nil, nil, 1, EntryAdded)
 	}
+	return events, nil
+}
+
+// loadAll loads all keys if keys is empty, otherwise only the given keys.
+func (m *Map) loadAll(ctx context.Context, replaceExisting bool, keys []interface{}) error {
 	if m.hasNearCache {
 		return m.ncm.LoadAll(ctx, m, replaceExisting, keys)
 	}
@@ -1459,6 +2243,26 @@ func (m *Map) putTransientWithTTLAndMaxIdle(ctx context.Context, key interface{}
 	return m.putTransientWithTTLAndMaxIdleFromRemote(ctx, key, value, ttl, maxIdle)
 }
 
+// renewLockLease resets the lease of the lock held by the lock ID in the context.
+// Locking again with the same lock ID resets the lease; the following unlock restores the lock count.
+// Returns false if the lock is held by another owner.
+func (m *Map) renewLockLease(ctx context.Context, keyData serialization.Data, lease time.Duration) (bool, error) {
+	lid := iproxy.ExtractLockID(ctx)
+	request := codec.EncodeMapTryLockRequest(m.name, keyData, lid, lease.Milliseconds(), 0, m.refIDGen.NextID())
+	response, err := m.invokeOnKey(ctx, request, keyData)
+	if err != nil {
+		return false, err
+	}
+	if !codec.DecodeMapTryLockResponse(response) {
+		return false, nil
+	}
+	request = codec.EncodeMapUnlockRequest(m.name, keyData, lid, m.refIDGen.NextID())
+	if _, err := m.invokeOnKey(ctx, request, keyData); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
 func (m *Map) tryLock(ctx context.Context, key interface{}, lease int64, timeout int64) (bool, error) {
 	lid := iproxy.ExtractLockID(ctx)
 	if keyData, err := m.validateAndSerialize(key); err != nil {
@@ -1487,6 +2291,13 @@ func (m *Map) makeListenerRequest(keyData, predicateData serialization.Data, fla
 	return codec.EncodeMapAddEntryListenerRequest(m.name, includeValue, flags, m.smart)
 }
 
+// makeEntryListenerHandler returns the handler which decodes the entry event messages of a listener and passes the events to handler.
+func (m *Map) makeEntryListenerHandler(keyData, predicateData serialization.Data, handler EntryNotifiedHandler) func(msg *proto.ClientMessage) {
+	return func(msg *proto.ClientMessage) {
+		m.makeListenerDecoder(msg, keyData, predicateData, m.makeEntryNotifiedListenerHandler(handler))
+	}
+}
+
 func (m *Map) makeListenerDecoder(msg *proto.ClientMessage, keyData, predicateData serialization.Data, handler entryNotifiedHandler) {
 	if keyData != nil {
 		if predicateData != nil {
@@ -1540,30 +2351,53 @@ func (m *Map) aggregate(ctx context.Context, req *proto.ClientMessage, decoder f
 	return obj, nil
 }
 
-func (m *Map) partitionToKeys(keys []interface{}, serializedKeys bool) (map[int32][]serialization.Data, error) {
+// partitionToKeys groups the serialized keys by their partition IDs.
+func (m *Map) partitionToKeys(keyDatas []serialization.Data) (map[int32][]serialization.Data, error) {
 	res := map[int32][]serialization.Data{}
 	ps := m.proxy.partitionService
-	var err error
-	for _, key := range keys {
-		var keyData serialization.Data
-		if serializedKeys {
-			keyData = key.(serialization.Data)
-		} else {
-			keyData, err = m.validateAndSerialize(key)
-			if err != nil {
-				return nil, err
-			}
-		}
+	for _, keyData := range keyDatas {
 		pk, err := ps.GetPartitionID(keyData)
 		if err != nil {
 			return nil, err
 		}
-		arr := res[pk]
-		res[pk] = append(arr, keyData)
+		res[pk] = append(res[pk], keyData)
 	}
 	return res, nil
 }
 
+// orderedKeys keeps the distinct keys of a key slice and their positions in it.
+type orderedKeys struct {
+	indexes  map[string][]int
+	keys     []interface{}
+	keyDatas []serialization.Data
+}
+
+func (m *Map) newOrderedKeys(keys []interface{}) (orderedKeys, error) {
+	ok := orderedKeys{indexes: make(map[string][]int, len(keys))}
+	for i, key := range keys {
+		keyData, err := m.validateAndSerialize(key)
+		if err != nil {
+			return orderedKeys{}, err
+		}
+		k := string(keyData)
+		idx, found := ok.indexes[k]
+		ok.indexes[k] = append(idx, i)
+		if found {
+			continue
+		}
+		ok.keys = append(ok.keys, key)
+		ok.keyDatas = append(ok.keyDatas, keyData)
+	}
+	return ok, nil
+}
+
+// set puts value to all positions of the given key in values.
+func (ok orderedKeys) set(values []interface{}, keyData serialization.Data, value interface{}) {
+	for _, i := range ok.indexes[string(keyData)] {
+		values[i] = value
+	}
+}
+
 func validateAndNormalizeIndexConfig(ic *types.IndexConfig) error {
 	attrSet := newAttributeSet()
 	for _, attr := range ic.Attributes {
@@ -1578,8 +2412,25 @@ func validateAndNormalizeIndexConfig(ic *types.IndexConfig) error {
 	if len(attrs) > maxIndexAttributes {
 		return ihzerrors.NewIllegalArgumentError(fmt.Sprintf("index cannot have more than %d attributes", maxIndexAttributes), nil)
 	}
-	if ic.Type == types.IndexTypeBitmap && len(attrs) > 1 {
-		return ihzerrors.NewIllegalArgumentError("composite bitmap indexes are not supported", nil)
+	switch ic.Type {
+	case types.IndexTypeSorted, types.IndexTypeHash:
+		if ic.BitmapIndexOptions != (types.BitmapIndexOptions{}) {
+			return ihzerrors.NewIllegalArgumentError("bitmap index options can only be set for bitmap indexes", nil)
+		}
+	case types.IndexTypeBitmap:
+		if len(attrs) > 1 {
+			return ihzerrors.NewIllegalArgumentError("composite bitmap indexes are not supported", nil)
+		}
+		if ic.BitmapIndexOptions.UniqueKey == "" {
+			ic.BitmapIndexOptions.UniqueKey = defaultBitmapIndexUniqueKey
+		}
+	default:
+		return ihzerrors.NewIllegalArgumentError(fmt.Sprintf("invalid index type: %d", ic.Type), nil)
+	}
+	switch ic.BitmapIndexOptions.UniqueKeyTransformation {
+	case types.UniqueKeyTransformationObject, types.UniqueKeyTransformationLong, types.UniqueKeyTransformationRaw:
+	default:
+		return ihzerrors.NewIllegalArgumentError(fmt.Sprintf("invalid unique key transformation: %d", ic.BitmapIndexOptions.UniqueKeyTransformation), nil)
 	}
 	ic.Attributes = attrs
 	return nil
@@ -1627,6 +2478,10 @@ type MapEntryListenerConfig struct {
 	Key          interface{}
 	flags        int32
 	IncludeValue bool
+	// IncludeInitial enables delivering the entries which are already in the map and match the Key and Predicate, if any.
+	// The initial entries are delivered as EntryAdded events before any live events, only if EntryAdded events are enabled.
+	// It is supported by AddListenerWithConfig and the deprecated AddEntryListener.
+	IncludeInitial bool
 }
 
 // NotifyEntryAdded enables receiving an entry event when an entry is added.
@@ -1689,6 +2544,50 @@ func (c *MapEntryListenerConfig) NotifyEntryLoaded(enable bool) {
 	flagsSetOrClear(&c.flags, int32(EntryLoaded), enable)
 }
 
+// initialEntriesHandler holds back live entry events until the initial entries are delivered.
+type initialEntriesHandler struct {
+	handler EntryNotifiedHandler
+	pending []*EntryNotified
+	mu      sync.Mutex
+	ready   bool
+}
+
+func (h *initialEntriesHandler) handle(event *EntryNotified) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if !h.ready {
+		h.pending = append(h.pending, event)
+		return
+	}
+	h.handler(event)
+}
+
+func (h *initialEntriesHandler) deliverInitial(events []*EntryNotified) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for _, e := range events {
+		h.handler(e)
+	}
+	for _, e := range h.pending {
+		h.handler(e)
+	}
+	h.pending = nil
+	h.ready = true
+}
+
+func castResult[T any](v interface{}) (T, error) {
+	var zero T
+	if v == nil {
+		return zero, nil
+	}
+	r, ok := v.(T)
+	if !ok {
+		msg := fmt.Sprintf("expected result of type %s, but got %T", reflect.TypeOf(&zero).Elem(), v)
+		return zero, ihzerrors.NewClientError(msg, nil, hzerrors.ErrClassCast)
+	}
+	return r, nil
+}
+
 type LocalMapStats struct {
 	NearCacheStats nearcache.Stats
 }
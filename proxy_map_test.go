@@ -0,0 +1,269 @@
+/*
+ * Copyright (c) 2008-2023, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package hazelcast_test
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	hz "github.com/hazelcast/hazelcast-go-client"
+	"github.com/hazelcast/hazelcast-go-client/hzerrors"
+	iserialization "github.com/hazelcast/hazelcast-go-client/internal/serialization"
+	"github.com/hazelcast/hazelcast-go-client/predicate"
+	"github.com/hazelcast/hazelcast-go-client/serialization"
+	"github.com/hazelcast/hazelcast-go-client/types"
+)
+
+const (
+	processorResultFactoryID = 101
+	processorResultClassID   = 1
+)
+
+type processorResult struct {
+	Name  string
+	Count int32
+}
+
+func (r processorResult) FactoryID() int32 {
+	return processorResultFactoryID
+}
+
+func (r processorResult) ClassID() int32 {
+	return processorResultClassID
+}
+
+func (r processorResult) WriteData(output serialization.DataOutput) {
+	output.WriteString(r.Name)
+	output.WriteInt32(r.Count)
+}
+
+func (r *processorResult) ReadData(input serialization.DataInput) {
+	r.Name = input.ReadString()
+	r.Count = input.ReadInt32()
+}
+
+type processorResultFactory struct{}
+
+func (f processorResultFactory) Create(id int32) serialization.IdentifiedDataSerializable {
+	if id == processorResultClassID {
+		return &processorResult{}
+	}
+	return nil
+}
+
+func (f processorResultFactory) FactoryID() int32 {
+	return processorResultFactoryID
+}
+
+func TestExecuteOnKeyResultCast(t *testing.T) {
+	sc := &serialization.Config{}
+	sc.SetIdentifiedDataSerializableFactories(processorResultFactory{})
+	ss, err := iserialization.NewService(sc, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the result of an entry processor is deserialized using the registered factory.
+	data, err := ss.ToData(&processorResult{Name: "foo", Count: 42})
+	if err != nil {
+		t.Fatal(err)
+	}
+	v, err := ss.ToObject(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r, err := hz.CastResult[*processorResult](v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, &processorResult{Name: "foo", Count: 42}, r)
+	// mismatched type
+	_, err = hz.CastResult[string](v)
+	assert.True(t, errors.Is(err, hzerrors.ErrClassCast))
+	assert.Contains(t, err.Error(), "expected result of type string, but got *hazelcast_test.processorResult")
+	// a nil result is returned as the zero value
+	r, err = hz.CastResult[*processorResult](nil)
+	assert.NoError(t, err)
+	assert.Nil(t, r)
+	s, err := hz.CastResult[string](nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "", s)
+}
+
+func TestInitialEntriesHandler(t *testing.T) {
+	var got []interface{}
+	handle, deliverInitial := hz.NewInitialEntriesHandler(func(event *hz.EntryNotified) {
+		got = append(got, event.Key)
+	})
+	handle(&hz.EntryNotified{Key: "live-1"})
+	handle(&hz.EntryNotified{Key: "live-2"})
+	assert.Empty(t, got)
+	deliverInitial([]*hz.EntryNotified{{Key: "initial-1"}, {Key: "initial-2"}})
+	handle(&hz.EntryNotified{Key: "live-3"})
+	assert.Equal(t, []interface{}{"initial-1", "initial-2", "live-1", "live-2", "live-3"}, got)
+}
+
+func TestMaxValueSize(t *testing.T) {
+	ss, err := iserialization.NewService(&serialization.Config{}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	value := strings.Repeat("v", 1000)
+	data, err := ss.ToData(value)
+	if err != nil {
+		t.Fatal(err)
+	}
+	size := len(data)
+	testCases := []struct {
+		name         string
+		maxValueSize int
+		fails        bool
+	}{
+		{name: "disabled", maxValueSize: 0},
+		{name: "under the limit", maxValueSize: size + 1},
+		{name: "at the limit", maxValueSize: size},
+		{name: "over the limit", maxValueSize: size - 1, fails: true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, valueData, err := hz.ValidateAndSerialize2(ss, tc.maxValueSize, "k", value)
+			if !tc.fails {
+				assert.NoError(t, err)
+				assert.Equal(t, data, valueData)
+				return
+			}
+			assert.True(t, errors.Is(err, hzerrors.ErrIllegalArgument))
+			assert.Contains(t, err.Error(), fmt.Sprintf("serialized value size %d exceeds the maximum value size %d", size, size-1))
+		})
+	}
+}
+
+func TestMaxValueSize_DoesNotApplyToArgs(t *testing.T) {
+	ss, err := iserialization.NewService(&serialization.Config{}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pred := predicate.Equal("field", strings.Repeat("v", 1000))
+	data, err := ss.ToData(pred)
+	if err != nil {
+		t.Fatal(err)
+	}
+	predData, err := hz.ValidateAndSerializeArg(ss, len(data)-1, pred)
+	assert.NoError(t, err)
+	assert.Equal(t, data, predData)
+}
+
+func TestPutAllPartitionFailure(t *testing.T) {
+	partitions := map[int32][]types.Entry{
+		1: {{Key: "k1", Value: "v1"}, {Key: "k2", Value: "v2"}},
+		2: {{Key: "k3", Value: "v3"}},
+		3: {{Key: "k4", Value: "v4"}},
+	}
+	written := map[interface{}]interface{}{}
+	err := hz.PutAllPartitions(partitions, func(partitionID int32, entries []types.Entry) error {
+		if partitionID == 2 {
+			return hzerrors.ErrOperationTimeout
+		}
+		for _, e := range entries {
+			written[e.Key] = e.Value
+		}
+		return nil
+	})
+	var pe *hzerrors.PutAllError
+	if !errors.As(err, &pe) {
+		t.Fatalf("expected *hzerrors.PutAllError, got: %v", err)
+	}
+	assert.True(t, errors.Is(err, hzerrors.ErrOperationTimeout))
+	assert.Len(t, pe.Failures, 1)
+	assert.Equal(t, int32(2), pe.Failures[0].PartitionID)
+	assert.Equal(t, []types.Entry{{Key: "k3", Value: "v3"}}, pe.FailedEntries())
+	// the entries of the other partitions are written
+	assert.Equal(t, map[interface{}]interface{}{"k1": "v1", "k2": "v2", "k4": "v4"}, written)
+}
+
+func TestPutAllNoFailure(t *testing.T) {
+	partitions := map[int32][]types.Entry{
+		1: {{Key: "k1", Value: "v1"}},
+	}
+	err := hz.PutAllPartitions(partitions, func(partitionID int32, entries []types.Entry) error {
+		return nil
+	})
+	assert.NoError(t, err)
+}
+
+func TestValidateAndNormalizeIndexConfig(t *testing.T) {
+	bitmapOpts := types.BitmapIndexOptions{UniqueKey: "id", UniqueKeyTransformation: types.UniqueKeyTransformationLong}
+	testCases := []struct {
+		name   string
+		ic     types.IndexConfig
+		target types.IndexConfig
+		valid  bool
+	}{
+		{
+			name:   "sorted",
+			ic:     types.IndexConfig{Type: types.IndexTypeSorted, Attributes: []string{"this.age", "name"}},
+			target: types.IndexConfig{Type: types.IndexTypeSorted, Attributes: []string{"age", "name"}},
+			valid:  true,
+		},
+		{
+			name:   "bitmap with options",
+			ic:     types.IndexConfig{Type: types.IndexTypeBitmap, Attributes: []string{"age"}, BitmapIndexOptions: bitmapOpts},
+			target: types.IndexConfig{Type: types.IndexTypeBitmap, Attributes: []string{"age"}, BitmapIndexOptions: bitmapOpts},
+			valid:  true,
+		},
+		{
+			name: "bitmap with default options",
+			ic:   types.IndexConfig{Type: types.IndexTypeBitmap, Attributes: []string{"age"}},
+			target: types.IndexConfig{
+				Type:               types.IndexTypeBitmap,
+				Attributes:         []string{"age"},
+				BitmapIndexOptions: types.BitmapIndexOptions{UniqueKey: "__key"},
+			},
+			valid: true,
+		},
+		{name: "no attributes", ic: types.IndexConfig{Type: types.IndexTypeSorted}},
+		{name: "empty attribute", ic: types.IndexConfig{Type: types.IndexTypeHash, Attributes: []string{""}}},
+		{name: "duplicate attributes", ic: types.IndexConfig{Type: types.IndexTypeHash, Attributes: []string{"age", "this.age"}}},
+		{name: "composite bitmap", ic: types.IndexConfig{Type: types.IndexTypeBitmap, Attributes: []string{"age", "name"}}},
+		{name: "sorted with bitmap options", ic: types.IndexConfig{Type: types.IndexTypeSorted, Attributes: []string{"age"}, BitmapIndexOptions: bitmapOpts}},
+		{name: "hash with bitmap options", ic: types.IndexConfig{Type: types.IndexTypeHash, Attributes: []string{"age"}, BitmapIndexOptions: bitmapOpts}},
+		{name: "invalid type", ic: types.IndexConfig{Type: 3, Attributes: []string{"age"}}},
+		{
+			name: "invalid unique key transformation",
+			ic:   types.IndexConfig{Type: types.IndexTypeBitmap, Attributes: []string{"age"}, BitmapIndexOptions: types.BitmapIndexOptions{UniqueKeyTransformation: 3}},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ic := tc.ic
+			err := hz.ValidateAndNormalizeIndexConfig(&ic)
+			if !tc.valid {
+				assert.True(t, errors.Is(err, hzerrors.ErrIllegalArgument), "expected ErrIllegalArgument, got: %v", err)
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			assert.ElementsMatch(t, tc.target.Attributes, ic.Attributes)
+			ic.Attributes = tc.target.Attributes
+			assert.Equal(t, tc.target, ic)
+		})
+	}
+}
@@ -18,6 +18,7 @@ package hazelcast
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"sync"
 	"time"
@@ -48,6 +49,7 @@ These guarantees are session guarantees which means that if no replica with the
 This does not mean that an update is lost.
 All of the updates are part of some replica and will be eventually reflected in the state of all other replicas.
 This error just means that you cannot observe your own writes because all replicas that contain your updates are currently unreachable.
+If a replica reports that it does not have the observed state, the invocation is retried on another replica, and the error is returned only after all replicas were tried.
 After you receive hzerrors.ErrConsistencyLostException, you can either wait for a sufficiently up-to-date replica to become reachable in which case the session can be continued or you can reset the session by calling the Reset function.
 If you have called the Reset function, a new session is started with the next invocation to a CRDT replica.
 
@@ -158,7 +160,6 @@ func (pn *PNCounter) crdtOperationTarget(excluded map[types.UUID]struct{}) (*clu
 func (pn *PNCounter) updateClock(clock iproxy.VectorClock) {
 	pn.mu.Lock()
 	defer pn.mu.Unlock()
-	// TODO: implement this properly
 	if pn.clock.After(clock) {
 		return
 	}
@@ -191,6 +192,7 @@ func (pn *PNCounter) invokeOnMember(ctx context.Context, makeReq func(target typ
 	// in the best case scenario, no members will be excluded, so excluded set is nil
 	var excluded map[types.UUID]struct{}
 	var lastUUID types.UUID
+	var lastErr error
 	var request *proto.ClientMessage
 	now := time.Now()
 	return pn.invoker.TryInvoke(ctx, func(ctx context.Context, attempt int) (interface{}, error) {
@@ -207,6 +209,10 @@ func (pn *PNCounter) invokeOnMember(ctx context.Context, makeReq func(target typ
 			pn.logger.Debug(func() string {
 				return fmt.Sprintf("attempt: %d, excluded members: %v", attempt, excluded)
 			})
+			if lastErr != nil {
+				// all replicas were tried, report the failure of the last one
+				return nil, cb.WrapNonRetryableError(lastErr)
+			}
 			// do not retry if no data members was found
 			err := ihzerrors.NewClientError("no data members in cluster", nil, hzerrors.ErrNoDataMember)
 			return nil, cb.WrapNonRetryableError(err)
@@ -217,7 +223,15 @@ func (pn *PNCounter) invokeOnMember(ctx context.Context, makeReq func(target typ
 		if err := pn.invoker.SendInvocation(ctx, inv); err != nil {
 			return nil, err
 		}
-		return inv.GetWithContext(ctx)
+		resp, err := inv.GetWithContext(ctx)
+		if err != nil {
+			lastErr = err
+			if staleReplicaError(err) {
+				// the replica cannot serve the observed state, retry on another one
+				return nil, unwrapNonRetryableError(err)
+			}
+		}
+		return resp, err
 	})
 }
 
@@ -246,3 +260,16 @@ func targetExcluded(target *cluster.MemberInfo, excludes map[types.UUID]struct{}
 	_, found := excludes[target.UUID]
 	return found
 }
+
+// staleReplicaError returns true if the error shows that the replica does not have the observed state or is not a member anymore.
+func staleReplicaError(err error) bool {
+	return errors.Is(err, hzerrors.ErrConsistencyLostException) || errors.Is(err, hzerrors.ErrTargetNotMember)
+}
+
+func unwrapNonRetryableError(err error) error {
+	var nre *cb.NonRetryableError
+	if errors.As(err, &nre) {
+		return nre.Err
+	}
+	return err
+}
@@ -18,10 +18,14 @@ package hazelcast
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"time"
 
 	pubcluster "github.com/hazelcast/hazelcast-go-client/cluster"
+	"github.com/hazelcast/hazelcast-go-client/hzerrors"
 	"github.com/hazelcast/hazelcast-go-client/internal/check"
+	ihzerrors "github.com/hazelcast/hazelcast-go-client/internal/hzerrors"
 	"github.com/hazelcast/hazelcast-go-client/internal/proto"
 	"github.com/hazelcast/hazelcast-go-client/internal/proto/codec"
 	iserialization "github.com/hazelcast/hazelcast-go-client/internal/serialization"
@@ -35,6 +39,9 @@ Queue is not a partitioned data-structure.
 All of the Queue content is stored in a single machine (and in the backup).
 Queue will not scale by adding more members in the cluster.
 
+Blocking operations, such as Put and Take, wait until the operation can be completed or the given context is done.
+If the deadline of the context is exceeded, the returned error matches hzerrors.ErrTimeout and context.DeadlineExceeded.
+
 For details see https://docs.hazelcast.com/imdg/latest/data-structures/queue.html
 */
 type Queue struct {
@@ -148,6 +155,26 @@ func (q *Queue) DrainWithMaxSize(ctx context.Context, maxSize int) ([]interface{
 	}
 }
 
+// DrainTo removes all items in the queue and appends them to the given slice.
+// Returns the updated slice.
+func (q *Queue) DrainTo(ctx context.Context, items []interface{}) ([]interface{}, error) {
+	values, err := q.Drain(ctx)
+	if err != nil {
+		return items, err
+	}
+	return append(items, values...), nil
+}
+
+// DrainToWithMaxSize removes maximum maxSize items in the queue and appends them to the given slice.
+// Returns the updated slice.
+func (q *Queue) DrainToWithMaxSize(ctx context.Context, items []interface{}, maxSize int) ([]interface{}, error) {
+	values, err := q.DrainWithMaxSize(ctx, maxSize)
+	if err != nil {
+		return items, err
+	}
+	return append(items, values...), nil
+}
+
 // GetAll returns all of the items in this queue.
 func (q *Queue) GetAll(ctx context.Context) ([]interface{}, error) {
 	request := codec.EncodeQueueIteratorRequest(q.name)
@@ -168,6 +195,18 @@ func (q *Queue) IsEmpty(ctx context.Context) (bool, error) {
 	}
 }
 
+// Offer adds the specified item to this queue if there is available space, without waiting.
+// Returns true when element is successfully added.
+func (q *Queue) Offer(ctx context.Context, value interface{}) (bool, error) {
+	return q.add(ctx, value, 0)
+}
+
+// OfferWithTimeout adds the specified item to this queue, waiting up to the given timeout for space to become available.
+// Returns true when element is successfully added, false if the timeout elapsed.
+func (q *Queue) OfferWithTimeout(ctx context.Context, value interface{}, timeout time.Duration) (bool, error) {
+	return q.add(ctx, value, timeout.Milliseconds())
+}
+
 // Peek retrieves the head of queue without removing it from the queue.
 func (q *Queue) Peek(ctx context.Context) (interface{}, error) {
 	request := codec.EncodeQueuePeekRequest(q.name)
@@ -197,7 +236,7 @@ func (q *Queue) Put(ctx context.Context, value interface{}) error {
 	} else {
 		request := codec.EncodeQueuePutRequest(q.name, valueData)
 		_, err := q.invokeOnPartition(ctx, request, q.partitionID)
-		return err
+		return q.wrapBlockingError("put", err)
 	}
 }
 
@@ -218,7 +257,7 @@ func (q *Queue) Remove(ctx context.Context, value interface{}) (bool, error) {
 	} else {
 		request := codec.EncodeQueueRemoveRequest(q.name, data)
 		if response, err := q.invokeOnPartition(ctx, request, q.partitionID); err != nil {
-			return false, nil
+			return false, err
 		} else {
 			return codec.DecodeQueueRemoveResponse(response), nil
 		}
@@ -278,7 +317,7 @@ func (q *Queue) Size(ctx context.Context) (int, error) {
 func (q *Queue) Take(ctx context.Context) (interface{}, error) {
 	request := codec.EncodeQueueTakeRequest(q.name)
 	if response, err := q.invokeOnPartition(ctx, request, q.partitionID); err != nil {
-		return nil, err
+		return nil, q.wrapBlockingError("take", err)
 	} else {
 		return q.convertToObject(codec.DecodeQueueTakeResponse(response))
 	}
@@ -286,11 +325,11 @@ func (q *Queue) Take(ctx context.Context) (interface{}, error) {
 
 func (q *Queue) add(ctx context.Context, value interface{}, timeout int64) (bool, error) {
 	if valueData, err := q.validateAndSerialize(value); err != nil {
-		return false, nil
+		return false, err
 	} else {
 		request := codec.EncodeQueueOfferRequest(q.name, valueData, timeout)
 		if response, err := q.invokeOnPartition(ctx, request, q.partitionID); err != nil {
-			return false, err
+			return false, q.wrapBlockingError("offer", err)
 		} else {
 			return codec.DecodeQueueOfferResponse(response), nil
 		}
@@ -324,8 +363,17 @@ func (q *Queue) addListener(ctx context.Context, includeValue bool, handler Queu
 func (q *Queue) poll(ctx context.Context, timeout int64) (interface{}, error) {
 	request := codec.EncodeQueuePollRequest(q.name, timeout)
 	if response, err := q.invokeOnPartition(ctx, request, q.partitionID); err != nil {
-		return nil, err
+		return nil, q.wrapBlockingError("poll", err)
 	} else {
 		return q.convertToObject(codec.DecodeQueuePollResponse(response))
 	}
 }
+
+// wrapBlockingError makes the error returned when the context deadline of a blocking operation is exceeded match hzerrors.ErrTimeout.
+func (q *Queue) wrapBlockingError(op string, err error) error {
+	if err != nil && errors.Is(err, context.DeadlineExceeded) {
+		msg := fmt.Sprintf("%s on queue %s timed out", op, q.name)
+		return ihzerrors.NewClientError(msg, err, hzerrors.ErrTimeout)
+	}
+	return err
+}
@@ -43,7 +43,7 @@ type ReplicatedMap struct {
 }
 
 func newReplicatedMap(p *proxy, refIDGenerator *iproxy.ReferenceIDGenerator) (*ReplicatedMap, error) {
-	nameData, err := p.validateAndSerialize(p.name)
+	nameData, err := p.validateAndSerializeArg(p.name)
 	if err != nil {
 		return nil, err
 	}
@@ -205,6 +205,7 @@ func (m *ReplicatedMap) Put(ctx context.Context, key interface{}, value interfac
 // PutAll copies all the mappings from the specified map to this map.
 // No atomicity guarantees are given. In the case of a failure, some key-value tuples may get written,
 // while others are not.
+// If the requests of some partitions fail, the returned error is a *hzerrors.PutAllError which contains the entries of the failed partitions.
 func (m *ReplicatedMap) PutAll(ctx context.Context, keyValuePairs ...types.Entry) error {
 	if ctx == nil {
 		ctx = context.Background()
@@ -265,7 +266,7 @@ func (m *ReplicatedMap) addEntryListener(ctx context.Context, key interface{}, p
 		}
 	}
 	if predicate != nil {
-		if predicateData, err = m.validateAndSerialize(predicate); err != nil {
+		if predicateData, err = m.validateAndSerializeArg(predicate); err != nil {
 			return types.UUID{}, err
 		}
 	}
@@ -19,6 +19,7 @@ package hazelcast
 import (
 	"context"
 	"fmt"
+	"sync/atomic"
 
 	"github.com/hazelcast/hazelcast-go-client/internal/check"
 	ihzerrors "github.com/hazelcast/hazelcast-go-client/internal/hzerrors"
@@ -51,7 +52,8 @@ A ReadOne is not destructive, so you can have multiple threads reading the same
 type Ringbuffer struct {
 	*proxy
 	partitionID int32
-	capacity    int64
+	// capacity is cached after it is fetched, it must be accessed atomically.
+	capacity int64
 }
 
 // ReadResultSet is used as return type in ReadMany() operations from a Ringbuffer
@@ -109,7 +111,9 @@ func newRingbuffer(p *proxy) (*Ringbuffer, error) {
 // If there is space in the Ringbuffer, the call will return the sequence of the written item.
 // If there is no space, it depends on the overflow policy what happens:
 // - OverflowPolicyOverwrite:  we just overwrite the oldest item in the Ringbuffer, and we violate the ttl
-// - OverflowPolicyFail: FAIL we return -1. The reason that FAIL exist is to give the opportunity to obey the ttl.
+// - OverflowPolicyFail: FAIL we return ReadResultSetSequenceUnavailable (-1). The reason that FAIL exist is to give the opportunity to obey the ttl.
+//
+// Note that the Ringbuffer is never full if it has no ttl, so OverflowPolicyFail behaves the same as OverflowPolicyOverwrite in that case.
 //
 // This sequence will always be unique for this Ringbuffer instance, so it can be used as a unique id generator if you are
 // publishing items on this Ringbuffer.
@@ -183,15 +187,17 @@ func (rb *Ringbuffer) ReadOne(ctx context.Context, sequence int64) (interface{},
 
 // Capacity returns the capacity of this Ringbuffer.
 func (rb *Ringbuffer) Capacity(ctx context.Context) (int64, error) {
-	if rb.capacity == ReadResultSetSequenceUnavailable {
-		request := codec.EncodeRingbufferCapacityRequest(rb.name)
-		response, err := rb.invokeOnPartition(ctx, request, rb.partitionID)
-		if err != nil {
-			return ReadResultSetSequenceUnavailable, err
-		}
-		rb.capacity = codec.DecodeRingbufferCapacityResponse(response)
+	if c := atomic.LoadInt64(&rb.capacity); c != ReadResultSetSequenceUnavailable {
+		return c, nil
+	}
+	request := codec.EncodeRingbufferCapacityRequest(rb.name)
+	response, err := rb.invokeOnPartition(ctx, request, rb.partitionID)
+	if err != nil {
+		return ReadResultSetSequenceUnavailable, err
 	}
-	return rb.capacity, nil
+	c := codec.DecodeRingbufferCapacityResponse(response)
+	atomic.StoreInt64(&rb.capacity, c)
+	return c, nil
 }
 
 // Size returns number of items in the Ringbuffer.
@@ -272,13 +278,16 @@ func (rb *Ringbuffer) ReadMany(ctx context.Context, startSequence int64, minCoun
 	if maxCount > MaxBatchSize {
 		return ReadResultSet{}, ihzerrors.NewIllegalArgumentError(fmt.Sprintf("maxCount can't be larger than %d", MaxBatchSize), nil)
 	}
-	capacity, err := rb.Capacity(context.Background())
-	if int64(maxCount) > capacity || err != nil {
-		return ReadResultSet{}, ihzerrors.NewIllegalArgumentError("the maxCount should be smaller than or equal to the capacity", err)
+	capacity, err := rb.Capacity(ctx)
+	if err != nil {
+		return ReadResultSet{}, err
+	}
+	if int64(maxCount) > capacity {
+		return ReadResultSet{}, ihzerrors.NewIllegalArgumentError("the maxCount should be smaller than or equal to the capacity", nil)
 	}
 	var serializedFilterData iserialization.Data
 	if filter != nil {
-		data, err := rb.validateAndSerialize(filter)
+		data, err := rb.validateAndSerializeArg(filter)
 		serializedFilterData = data
 		if err != nil {
 			return ReadResultSet{}, err
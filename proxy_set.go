@@ -151,7 +151,7 @@ func (s *Set) Remove(ctx context.Context, value interface{}) (bool, error) {
 	} else {
 		request := codec.EncodeSetRemoveRequest(s.name, data)
 		if response, err := s.invokeOnPartition(ctx, request, s.partitionID); err != nil {
-			return false, nil
+			return false, err
 		} else {
 			return codec.DecodeSetRemoveResponse(response), nil
 		}
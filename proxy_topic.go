@@ -18,9 +18,12 @@ package hazelcast
 
 import (
 	"context"
+	"fmt"
+	"sync"
 	"time"
 
 	pubcluster "github.com/hazelcast/hazelcast-go-client/cluster"
+	"github.com/hazelcast/hazelcast-go-client/internal/event"
 	"github.com/hazelcast/hazelcast-go-client/internal/proto"
 	"github.com/hazelcast/hazelcast-go-client/internal/proto/codec"
 	iserialization "github.com/hazelcast/hazelcast-go-client/internal/serialization"
@@ -37,23 +40,36 @@ including the new members joined after you added the listener.
 
 Messages are ordered, meaning that listeners(subscribers) will process the messages in the order they are actually
 published.
+
+Each listener runs in its own goroutine, so a slow listener does not delay the other listeners or events.
+Listeners are registered again when the client reconnects to the cluster.
 */
 type Topic struct {
 	*proxy
+	listeners   map[types.UUID]int64
+	listenersMu *sync.Mutex
 	partitionID int32
 }
 
+// TopicMessageHandler is called for each message published to the topic.
+// The event contains the message, the member which published it and the publish time.
 type TopicMessageHandler func(event *MessagePublished)
 
 func newTopic(p *proxy) (*Topic, error) {
 	if partitionID, err := p.stringToPartitionID(p.name); err != nil {
 		return nil, err
 	} else {
-		return &Topic{proxy: p, partitionID: partitionID}, nil
+		return &Topic{
+			proxy:       p,
+			partitionID: partitionID,
+			listeners:   map[types.UUID]int64{},
+			listenersMu: &sync.Mutex{},
+		}, nil
 	}
 }
 
 // AddMessageListener adds a subscriber to this topic.
+// The handler receives the messages in the order they are published.
 func (t *Topic) AddMessageListener(ctx context.Context, handler TopicMessageHandler) (types.UUID, error) {
 	return t.addListener(ctx, handler)
 }
@@ -83,13 +99,38 @@ func (t *Topic) PublishAll(ctx context.Context, messages ...interface{}) error {
 	}
 }
 
+// RemoveMessageListener removes the given subscription from this topic.
+func (t *Topic) RemoveMessageListener(ctx context.Context, subscriptionID types.UUID) error {
+	if err := t.listenerBinder.Remove(ctx, subscriptionID); err != nil {
+		return err
+	}
+	t.listenersMu.Lock()
+	dispatchID, ok := t.listeners[subscriptionID]
+	delete(t.listeners, subscriptionID)
+	t.listenersMu.Unlock()
+	if ok {
+		// unsubscribing waits for the running handler to finish.
+		// it is done in another goroutine, so this method can be called from the handler.
+		go t.eventDispatcher.Unsubscribe(topicMessageEventName(subscriptionID), dispatchID)
+	}
+	return nil
+}
+
 // RemoveListener removes the given subscription from this topic.
+// It is the same as RemoveMessageListener.
 func (t *Topic) RemoveListener(ctx context.Context, subscriptionID types.UUID) error {
-	return t.listenerBinder.Remove(ctx, subscriptionID)
+	return t.RemoveMessageListener(ctx, subscriptionID)
 }
 
 func (t *Topic) addListener(ctx context.Context, handler TopicMessageHandler) (types.UUID, error) {
 	subscriptionID := types.NewUUID()
+	// the messages are delivered to the handler through the event dispatcher,
+	// so the handler does not block the event worker which receives the messages.
+	eventName := topicMessageEventName(subscriptionID)
+	dispatchID := event.NextSubscriptionID()
+	t.eventDispatcher.Subscribe(eventName, dispatchID, func(e event.Event) {
+		handler(e.(*topicMessage).event)
+	})
 	addRequest := codec.EncodeTopicAddMessageListenerRequest(t.name, t.smart)
 	removeRequest := codec.EncodeTopicRemoveMessageListenerRequest(t.name, subscriptionID)
 	listenerHandler := func(msg *proto.ClientMessage) {
@@ -104,9 +145,30 @@ func (t *Topic) addListener(ctx context.Context, handler TopicMessageHandler) (t
 			if m := t.clusterService.GetMemberByUUID(uuid); m != nil {
 				member = *m
 			}
-			handler(newMessagePublished(t.name, item, time.Unix(0, publishTime*1_000_000), member))
+			e := newMessagePublished(t.name, item, time.Unix(0, publishTime*1_000_000), member)
+			t.eventDispatcher.Publish(&topicMessage{name: eventName, event: e})
 		})
 	}
-	err := t.listenerBinder.Add(ctx, subscriptionID, addRequest, removeRequest, listenerHandler)
-	return subscriptionID, err
+	if err := t.listenerBinder.Add(ctx, subscriptionID, addRequest, removeRequest, listenerHandler); err != nil {
+		t.eventDispatcher.Unsubscribe(eventName, dispatchID)
+		return subscriptionID, err
+	}
+	t.listenersMu.Lock()
+	t.listeners[subscriptionID] = dispatchID
+	t.listenersMu.Unlock()
+	return subscriptionID, nil
+}
+
+// topicMessage is dispatched only to the listener with the same event name.
+type topicMessage struct {
+	event *MessagePublished
+	name  string
+}
+
+func (m *topicMessage) EventName() string {
+	return m.name
+}
+
+func topicMessageEventName(subscriptionID types.UUID) string {
+	return fmt.Sprintf("%s.%s", eventMessagePublished, subscriptionID)
 }
@@ -18,6 +18,7 @@ package hazelcast_test
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"math"
 	"sync/atomic"
@@ -27,6 +28,7 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	hz "github.com/hazelcast/hazelcast-go-client"
+	"github.com/hazelcast/hazelcast-go-client/hzerrors"
 	"github.com/hazelcast/hazelcast-go-client/internal/it"
 	"github.com/hazelcast/hazelcast-go-client/internal/it/skip"
 )
@@ -128,8 +130,16 @@ func TestQueue_Contains(t *testing.T) {
 		if ok, err := q.Contains(context.Background(), "v1"); err != nil {
 			t.Fatal(err)
 		} else {
-			assert.True(t, true, ok)
+			assert.True(t, ok)
 		}
+		assert.Equal(t, false, it.MustValue(q.Contains(context.Background(), "v2")))
+	})
+}
+
+func TestQueue_ContainsWithNilElement(t *testing.T) {
+	it.QueueTester(t, func(t *testing.T, q *hz.Queue) {
+		_, err := q.Contains(context.Background(), nil)
+		assert.Error(t, err)
 	})
 }
 
@@ -155,6 +165,34 @@ func TestQueue_Drain(t *testing.T) {
 		}
 	})
 }
+
+func TestQueue_DrainTo(t *testing.T) {
+	it.QueueTester(t, func(t *testing.T, q *hz.Queue) {
+		it.MustValue(q.AddAll(context.Background(), int64(1), int64(2), int64(3)))
+		items := []interface{}{int64(0)}
+		items, err := q.DrainTo(context.Background(), items)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, []interface{}{int64(0), int64(1), int64(2), int64(3)}, items)
+		assert.Equal(t, true, it.MustValue(q.IsEmpty(context.Background())))
+	})
+}
+
+func TestQueue_DrainToWithMaxSize(t *testing.T) {
+	it.QueueTester(t, func(t *testing.T, q *hz.Queue) {
+		it.MustValue(q.AddAll(context.Background(), int64(1), int64(2), int64(3)))
+		items, err := q.DrainToWithMaxSize(context.Background(), nil, 2)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, []interface{}{int64(1), int64(2)}, items)
+		assert.Equal(t, 1, it.MustValue(q.Size(context.Background())))
+		_, err = q.DrainToWithMaxSize(context.Background(), nil, -1)
+		assert.True(t, errors.Is(err, hzerrors.ErrIllegalArgument))
+	})
+}
+
 func TestQueue_GetAll(t *testing.T) {
 	it.QueueTester(t, func(t *testing.T, q *hz.Queue) {
 		targetValues := []interface{}{int64(1), int64(2), int64(3), int64(4)}
@@ -190,6 +228,34 @@ func TestQueue_DrainWithMaxSize_Error(t *testing.T) {
 	})
 }
 
+func TestQueue_IsEmpty(t *testing.T) {
+	it.QueueTester(t, func(t *testing.T, q *hz.Queue) {
+		assert.Equal(t, true, it.MustValue(q.IsEmpty(context.Background())))
+		it.MustValue(q.Add(context.Background(), "v1"))
+		assert.Equal(t, false, it.MustValue(q.IsEmpty(context.Background())))
+		it.MustValue(q.Poll(context.Background()))
+		assert.Equal(t, true, it.MustValue(q.IsEmpty(context.Background())))
+	})
+}
+
+func TestQueue_Offer(t *testing.T) {
+	it.QueueTester(t, func(t *testing.T, q *hz.Queue) {
+		if ok, err := q.Offer(context.Background(), "value"); err != nil {
+			t.Fatal(err)
+		} else {
+			assert.True(t, ok)
+		}
+		if ok, err := q.OfferWithTimeout(context.Background(), "other-value", 1*time.Second); err != nil {
+			t.Fatal(err)
+		} else {
+			assert.True(t, ok)
+		}
+		assert.Equal(t, []interface{}{"value", "other-value"}, it.MustValue(q.Drain(context.Background())))
+		_, err := q.Offer(context.Background(), nil)
+		assert.True(t, errors.Is(err, hzerrors.ErrIllegalArgument))
+	})
+}
+
 func TestQueue_Peek(t *testing.T) {
 	it.QueueTester(t, func(t *testing.T, q *hz.Queue) {
 		if value, err := q.Peek(context.Background()); err != nil {
@@ -300,3 +366,60 @@ func TestQueue_Take(t *testing.T) {
 		}
 	})
 }
+
+func TestQueue_TakeBlocksUntilItemIsOffered(t *testing.T) {
+	it.QueueTester(t, func(t *testing.T, q *hz.Queue) {
+		ctx := context.Background()
+		var offered int32
+		go func() {
+			time.Sleep(500 * time.Millisecond)
+			atomic.StoreInt32(&offered, 1)
+			if _, err := q.Offer(ctx, "value"); err != nil {
+				panic(err)
+			}
+		}()
+		value, err := q.Take(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, int32(1), atomic.LoadInt32(&offered))
+		assert.Equal(t, "value", value)
+	})
+}
+
+func TestQueue_TakeTimeout(t *testing.T) {
+	it.QueueTester(t, func(t *testing.T, q *hz.Queue) {
+		ctx, cancel := context.WithTimeout(context.Background(), 500*time.Millisecond)
+		defer cancel()
+		_, err := q.Take(ctx)
+		assert.True(t, errors.Is(err, hzerrors.ErrTimeout))
+		assert.True(t, errors.Is(err, context.DeadlineExceeded))
+	})
+}
+
+func TestQueue_TakeCanceled(t *testing.T) {
+	it.QueueTester(t, func(t *testing.T, q *hz.Queue) {
+		ctx, cancel := context.WithCancel(context.Background())
+		time.AfterFunc(500*time.Millisecond, cancel)
+		_, err := q.Take(ctx)
+		assert.True(t, errors.Is(err, context.Canceled))
+		assert.False(t, errors.Is(err, hzerrors.ErrTimeout))
+	})
+}
+
+func TestQueue_NilValue(t *testing.T) {
+	it.QueueTester(t, func(t *testing.T, q *hz.Queue) {
+		ctx := context.Background()
+		var nilPtr *string
+		_, err := q.Add(ctx, nil)
+		assert.True(t, errors.Is(err, hzerrors.ErrIllegalArgument))
+		_, err = q.Add(ctx, nilPtr)
+		assert.True(t, errors.Is(err, hzerrors.ErrIllegalArgument))
+		_, err = q.AddAll(ctx, "value", nil)
+		assert.True(t, errors.Is(err, hzerrors.ErrIllegalArgument))
+		_, err = q.Contains(ctx, nil)
+		assert.True(t, errors.Is(err, hzerrors.ErrIllegalArgument))
+		// polling an empty queue reads back nil
+		assert.Equal(t, nil, it.MustValue(q.Poll(ctx)))
+	})
+}
@@ -18,12 +18,14 @@ package hazelcast_test
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"reflect"
 	"sync/atomic"
 	"testing"
 
 	hz "github.com/hazelcast/hazelcast-go-client"
+	"github.com/hazelcast/hazelcast-go-client/hzerrors"
 	"github.com/hazelcast/hazelcast-go-client/internal/it"
 	"github.com/hazelcast/hazelcast-go-client/predicate"
 	"github.com/hazelcast/hazelcast-go-client/types"
@@ -254,3 +256,29 @@ func TestReplicatedMap_AddEntryListenerToKeyWithPredicate(t *testing.T) {
 		})
 	})
 }
+
+func TestReplicatedMap_NilKeyAndValue(t *testing.T) {
+	it.ReplicatedMapTester(t, func(t *testing.T, m *hz.ReplicatedMap) {
+		ctx := context.Background()
+		var nilPtr *string
+		if _, err := m.Put(ctx, nil, "value"); !errors.Is(err, hzerrors.ErrIllegalArgument) {
+			t.Fatalf("expected hzerrors.ErrIllegalArgument but received: %v", err)
+		}
+		if _, err := m.Put(ctx, "key", nil); !errors.Is(err, hzerrors.ErrIllegalArgument) {
+			t.Fatalf("expected hzerrors.ErrIllegalArgument but received: %v", err)
+		}
+		if _, err := m.Put(ctx, "key", nilPtr); !errors.Is(err, hzerrors.ErrIllegalArgument) {
+			t.Fatalf("expected hzerrors.ErrIllegalArgument but received: %v", err)
+		}
+		if _, err := m.Get(ctx, nil); !errors.Is(err, hzerrors.ErrIllegalArgument) {
+			t.Fatalf("expected hzerrors.ErrIllegalArgument but received: %v", err)
+		}
+		if _, err := m.ContainsValue(ctx, nil); !errors.Is(err, hzerrors.ErrIllegalArgument) {
+			t.Fatalf("expected hzerrors.ErrIllegalArgument but received: %v", err)
+		}
+		// a missing key reads back as nil
+		if v := it.MustValue(m.Get(ctx, "missing-key")); v != nil {
+			t.Fatalf("expected nil but received: %v", v)
+		}
+	})
+}
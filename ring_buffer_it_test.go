@@ -54,6 +54,62 @@ func TestRingbuffer_AddNilElement(t *testing.T) {
 	})
 }
 
+func TestRingbuffer_Add_PastCapacityWithOverflowPolicyOverwrite(t *testing.T) {
+	makeName := func() string {
+		return it.NewUniqueObjectName("ttl-Ringbuffer")
+	}
+	it.RingbufferTesterWithConfigAndName(t, makeName, nil, func(t *testing.T, rb *hz.Ringbuffer) {
+		ctx := context.Background()
+		const count = it.RingbufferCapacity + 5
+		for i := 0; i < count; i++ {
+			seq := it.MustValue(rb.Add(ctx, fmt.Sprintf("item-%d", i), hz.OverflowPolicyOverwrite))
+			assert.Equal(t, int64(i), seq)
+		}
+		// the oldest items are overwritten, even though they did not expire
+		assert.Equal(t, int64(count-it.RingbufferCapacity), it.MustValue(rb.HeadSequence(ctx)))
+		assert.Equal(t, int64(count-1), it.MustValue(rb.TailSequence(ctx)))
+		assert.Equal(t, int64(it.RingbufferCapacity), it.MustValue(rb.Size(ctx)))
+		rs, err := rb.ReadMany(ctx, 0, 1, it.RingbufferCapacity, nil)
+		require.NoError(t, err)
+		require.Equal(t, it.RingbufferCapacity, rs.Size())
+		for i := 0; i < rs.Size(); i++ {
+			seq := it.MustValue(rs.GetSequence(i)).(int64)
+			assert.Equal(t, int64(count-it.RingbufferCapacity+i), seq)
+			assert.Equal(t, fmt.Sprintf("item-%d", seq), it.MustValue(rs.Get(i)))
+		}
+		assert.Equal(t, int64(count), rs.GetNextSequenceToReadFrom())
+	})
+}
+
+func TestRingbuffer_Add_PastCapacityWithOverflowPolicyFail(t *testing.T) {
+	makeName := func() string {
+		return it.NewUniqueObjectName("ttl-Ringbuffer")
+	}
+	it.RingbufferTesterWithConfigAndName(t, makeName, nil, func(t *testing.T, rb *hz.Ringbuffer) {
+		ctx := context.Background()
+		for i := 0; i < it.RingbufferCapacity; i++ {
+			seq := it.MustValue(rb.Add(ctx, fmt.Sprintf("item-%d", i), hz.OverflowPolicyFail))
+			assert.Equal(t, int64(i), seq)
+		}
+		assert.Equal(t, int64(0), it.MustValue(rb.RemainingCapacity(ctx)))
+		// the items did not expire, so adding fails and nothing is overwritten
+		assert.Equal(t, hz.ReadResultSetSequenceUnavailable, it.MustValue(rb.Add(ctx, "overflow", hz.OverflowPolicyFail)))
+		assert.Equal(t, hz.ReadResultSetSequenceUnavailable, it.MustValue(rb.AddAll(ctx, hz.OverflowPolicyFail, "overflow1", "overflow2")))
+		assert.Equal(t, int64(0), it.MustValue(rb.HeadSequence(ctx)))
+		assert.Equal(t, int64(it.RingbufferCapacity-1), it.MustValue(rb.TailSequence(ctx)))
+		rs, err := rb.ReadMany(ctx, 0, 1, it.RingbufferCapacity, nil)
+		require.NoError(t, err)
+		require.Equal(t, it.RingbufferCapacity, rs.Size())
+		for i := 0; i < rs.Size(); i++ {
+			assert.Equal(t, int64(i), it.MustValue(rs.GetSequence(i)))
+			assert.Equal(t, fmt.Sprintf("item-%d", i), it.MustValue(rs.Get(i)))
+		}
+		// overwriting is still possible with OverflowPolicyOverwrite
+		assert.Equal(t, int64(it.RingbufferCapacity), it.MustValue(rb.Add(ctx, "overwrite", hz.OverflowPolicyOverwrite)))
+		assert.Equal(t, int64(1), it.MustValue(rb.HeadSequence(ctx)))
+	})
+}
+
 func TestRingbuffer_AddAll(t *testing.T) {
 	it.RingbufferTester(t, func(t *testing.T, rb *hz.Ringbuffer) {
 		_, err := rb.AddAll(context.Background(), hz.OverflowPolicyOverwrite, "foo", "bar")
@@ -286,6 +286,8 @@ type PortableWriter interface {
 	// GetRawDataOutput returns raw DataOutput to write unnamed fields like IdentifiedDataSerializable does.
 	// All unnamed fields must be written after portable fields.
 	// Attempts to write named fields after GetRawDataOutput is called will panic.
+	// The position of the raw data is recorded the first time GetRawDataOutput is called,
+	// subsequent calls return the same DataOutput.
 	GetRawDataOutput() DataOutput
 	// WriteDate writes a date.
 	WriteDate(fieldName string, t *types.LocalDate)
@@ -382,6 +384,9 @@ type PortableReader interface {
 	// IdentifiedDataSerializable does. All unnamed fields must be read after
 	// portable fields. Attempts to read named fields after GetRawDataInput is
 	// called will panic.
+	// Named fields may be read in any order before GetRawDataInput is called,
+	// but unnamed fields must be read in the order they were written.
+	// If no raw data was written, reading from the returned DataInput panics with an EOF error.
 	GetRawDataInput() DataInput
 	// ReadDate reads the date.
 	// It may return nil.
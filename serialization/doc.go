@@ -53,6 +53,16 @@ Types of time.Time are converted to Java using the table below:
 Slices of the types above are serialized as arrays in the Hazelcast server side and the Hazelcast Java client.
 Reference types are not supported for builtin types, e.g., *int64.
 
+A []interface{} is serialized as java.util.ArrayList, and a map[string]interface{} or map[interface{}]interface{} as java.util.HashMap.
+Their elements are serialized recursively, so they may contain any serializable value, including other slices and maps.
+A slice or map which contains itself cannot be serialized.
+A java.util.HashMap is deserialized as a map[string]interface{} if all of its keys are strings, and as a map[interface{}]interface{} otherwise.
+
+Maps are serialized as java.util.HashMap only if there is no custom serializer for their type and no global serializer is configured.
+Earlier versions of the client serialized them with the Go specific gob format.
+Maps stored by earlier versions are still deserialized, but earlier versions cannot deserialize maps stored by this version.
+Configure a custom or global serializer for maps if clients of different versions share the same data.
+
 Hazelcast Go client supports several serializers apart from the builtin serializer for default types.
 They are Compact Serializer, Identified Data Serializer, Portable Serializer, JSON Serializer.
 
@@ -423,6 +433,18 @@ You should register the serializer in the configuration with the corresponding t
 	config := hazelcast.Config{}
 	config.Serialization.SetCustomSerializer(reflect.TypeOf(Employee{}), &EmployeeCustomSerializer{})
 
+Enum types which implement fmt.Stringer can be serialized by name using SetStringerEnumSerializer.
+The name of each value is the result of its String method, and two values with the same name are reported as an error.
+StringerEnumValues finds the named values of an integer enum type:
+
+	values, err := serialization.StringerEnumValues(ColorRed)
+	if err != nil {
+		panic(err)
+	}
+	if err := config.Serialization.SetStringerEnumSerializer(100, values...); err != nil {
+		panic(err)
+	}
+
 # Global Serializer
 
 If a serializer cannot be found for a value, the global serializer is used.
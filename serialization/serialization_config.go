@@ -17,8 +17,10 @@
 package serialization
 
 import (
+	"fmt"
 	"reflect"
 
+	"github.com/hazelcast/hazelcast-go-client/hzerrors"
 	ihzerrors "github.com/hazelcast/hazelcast-go-client/internal/hzerrors"
 )
 
@@ -80,6 +82,9 @@ func (c *Config) Validate() error {
 	if c.customSerializers == nil {
 		c.customSerializers = map[reflect.Type]Serializer{}
 	}
+	if err := c.checkDuplicateFactoryIDs(); err != nil {
+		return err
+	}
 	return c.Compact.Validate()
 }
 
@@ -89,6 +94,13 @@ func (b *Config) SetIdentifiedDataSerializableFactories(factories ...IdentifiedD
 	b.identifiedDataSerializableFactories = append(b.identifiedDataSerializableFactories, factories...)
 }
 
+// AddIdentifiedDataSerializableFactory adds an identified data serializable factory.
+// The factory is registered by its factory ID.
+// Validate returns an error if more than one factory with the same factory ID is registered.
+func (b *Config) AddIdentifiedDataSerializableFactory(factory IdentifiedDataSerializableFactory) {
+	b.identifiedDataSerializableFactories = append(b.identifiedDataSerializableFactories, factory)
+}
+
 // IdentifiedDataSerializableFactories returns a copy of identified data serializable factories.
 // Identified data serializable factories is a map of factory IDs and corresponding IdentifiedDataSerializable factories.
 func (b *Config) IdentifiedDataSerializableFactories() []IdentifiedDataSerializableFactory {
@@ -103,6 +115,13 @@ func (b *Config) SetPortableFactories(factories ...PortableFactory) {
 	b.portableFactories = append(b.portableFactories, factories...)
 }
 
+// AddPortableFactory adds a portable factory.
+// The factory is registered by its factory ID.
+// Validate returns an error if more than one factory with the same factory ID is registered.
+func (b *Config) AddPortableFactory(factory PortableFactory) {
+	b.portableFactories = append(b.portableFactories, factory)
+}
+
 // PortableFactories returns a copy of portable factories.
 // Portable factories is a map of factory IDs and corresponding Portable factories.
 func (b *Config) PortableFactories() []PortableFactory {
@@ -158,6 +177,26 @@ func (b *Config) GlobalSerializer() Serializer {
 	return b.globalSerializer
 }
 
+func (b *Config) checkDuplicateFactoryIDs() error {
+	ids := map[int32]struct{}{}
+	for _, f := range b.identifiedDataSerializableFactories {
+		fid := f.FactoryID()
+		if _, ok := ids[fid]; ok {
+			return fmt.Errorf("duplicate identified data serializable factory ID %d: %w", fid, hzerrors.ErrIllegalArgument)
+		}
+		ids[fid] = struct{}{}
+	}
+	ids = map[int32]struct{}{}
+	for _, f := range b.portableFactories {
+		fid := f.FactoryID()
+		if _, ok := ids[fid]; ok {
+			return fmt.Errorf("duplicate portable factory ID %d: %w", fid, hzerrors.ErrIllegalArgument)
+		}
+		ids[fid] = struct{}{}
+	}
+	return nil
+}
+
 func (b *Config) ensureCustomSerializers() {
 	if b.customSerializers == nil {
 		b.customSerializers = map[reflect.Type]Serializer{}
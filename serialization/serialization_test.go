@@ -62,3 +62,45 @@ func TestClassDefinitionAddDuplicateField(t *testing.T) {
 	}
 	assert.True(t, errors.Is(err, hzerrors.ErrIllegalArgument))
 }
+
+func TestConfig_AddIdentifiedDataSerializableFactory_DuplicateFactoryID(t *testing.T) {
+	var cfg serialization.Config
+	cfg.AddIdentifiedDataSerializableFactory(&testIDSFactory{id: 1})
+	cfg.AddIdentifiedDataSerializableFactory(&testIDSFactory{id: 2})
+	assert.NoError(t, cfg.Validate())
+	cfg.AddIdentifiedDataSerializableFactory(&testIDSFactory{id: 1})
+	assert.True(t, errors.Is(cfg.Validate(), hzerrors.ErrIllegalArgument))
+}
+
+func TestConfig_AddPortableFactory_DuplicateFactoryID(t *testing.T) {
+	var cfg serialization.Config
+	cfg.AddPortableFactory(&testPortableFactory{id: 1})
+	cfg.AddPortableFactory(&testPortableFactory{id: 2})
+	assert.NoError(t, cfg.Validate())
+	cfg.AddPortableFactory(&testPortableFactory{id: 2})
+	assert.True(t, errors.Is(cfg.Validate(), hzerrors.ErrIllegalArgument))
+}
+
+type testIDSFactory struct {
+	id int32
+}
+
+func (f testIDSFactory) Create(classID int32) serialization.IdentifiedDataSerializable {
+	return nil
+}
+
+func (f testIDSFactory) FactoryID() int32 {
+	return f.id
+}
+
+type testPortableFactory struct {
+	id int32
+}
+
+func (f testPortableFactory) Create(classID int32) serialization.Portable {
+	return nil
+}
+
+func (f testPortableFactory) FactoryID() int32 {
+	return f.id
+}
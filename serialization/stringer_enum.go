@@ -0,0 +1,142 @@
+/*
+ * Copyright (c) 2008-2023, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package serialization
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+
+	"github.com/hazelcast/hazelcast-go-client/hzerrors"
+	ihzerrors "github.com/hazelcast/hazelcast-go-client/internal/hzerrors"
+)
+
+// maxStringerEnumProbe is the number of integer values StringerEnumValues tries.
+const maxStringerEnumProbe = 1 << 10
+
+// StringerEnumValues returns the values of the integer enum type of sample.
+// Go does not expose declared constants by reflection, so the values are found by trying the integers in [0, 1024) and keeping the ones which have a name.
+// A value has no name if its String method returns the default form of the stringer tool, e.g., Color(42).
+// Use explicit values with SetStringerEnumSerializer if the enum has values out of that range.
+func StringerEnumValues(sample fmt.Stringer) ([]fmt.Stringer, error) {
+	if sample == nil {
+		return nil, ihzerrors.NewIllegalArgumentError("sample enum value cannot be nil", nil)
+	}
+	t := reflect.TypeOf(sample)
+	var values []fmt.Stringer
+	for i := 0; i < maxStringerEnumProbe; i++ {
+		v := reflect.New(t).Elem()
+		switch t.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			if v.OverflowInt(int64(i)) {
+				return stringerEnumValuesOrError(t, values)
+			}
+			v.SetInt(int64(i))
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+			if v.OverflowUint(uint64(i)) {
+				return stringerEnumValuesOrError(t, values)
+			}
+			v.SetUint(uint64(i))
+		default:
+			return nil, ihzerrors.NewIllegalArgumentError(fmt.Sprintf("enum type %s must have an integer kind", t), nil)
+		}
+		s, ok := v.Interface().(fmt.Stringer)
+		if !ok {
+			// String is defined on the pointer receiver.
+			return nil, ihzerrors.NewIllegalArgumentError(fmt.Sprintf("enum type %s must implement fmt.Stringer", t), nil)
+		}
+		if s.String() == t.Name()+"("+strconv.Itoa(i)+")" {
+			continue
+		}
+		values = append(values, s)
+	}
+	return stringerEnumValuesOrError(t, values)
+}
+
+func stringerEnumValuesOrError(t reflect.Type, values []fmt.Stringer) ([]fmt.Stringer, error) {
+	if len(values) == 0 {
+		return nil, ihzerrors.NewIllegalArgumentError(fmt.Sprintf("no named values found for enum type %s", t), nil)
+	}
+	return values, nil
+}
+
+// SetStringerEnumSerializer registers a custom serializer for the enum type of the given values.
+// The serializer writes the string form of a value, so the enum can be read by name by other clients.
+// The name of each value is the result of its String method.
+// An error is returned if no values are given, values have different types or two values have the same name,
+// since a name must map to a single value on read.
+// See StringerEnumValues for finding the values of an integer enum type.
+func (b *Config) SetStringerEnumSerializer(id int32, values ...fmt.Stringer) error {
+	s, err := newStringerEnumSerializer(id, values)
+	if err != nil {
+		return err
+	}
+	return b.SetCustomSerializer(s.t, s)
+}
+
+type stringerEnumSerializer struct {
+	t      reflect.Type
+	values map[string]fmt.Stringer
+	id     int32
+}
+
+func newStringerEnumSerializer(id int32, values []fmt.Stringer) (*stringerEnumSerializer, error) {
+	if len(values) == 0 {
+		return nil, ihzerrors.NewIllegalArgumentError("at least one enum value is required", nil)
+	}
+	if values[0] == nil {
+		return nil, ihzerrors.NewIllegalArgumentError("enum value cannot be nil", nil)
+	}
+	t := reflect.TypeOf(values[0])
+	m := make(map[string]fmt.Stringer, len(values))
+	for _, v := range values {
+		if vt := reflect.TypeOf(v); vt != t {
+			return nil, ihzerrors.NewIllegalArgumentError(fmt.Sprintf("enum value %v has type %v, expected %s", v, vt, t), nil)
+		}
+		name := v.String()
+		if ev, ok := m[name]; ok {
+			if ev == v {
+				continue
+			}
+			return nil, fmt.Errorf("ambiguous enum name %q for %s values %#v and %#v: %w", name, t, ev, v, hzerrors.ErrIllegalArgument)
+		}
+		m[name] = v
+	}
+	return &stringerEnumSerializer{id: id, t: t, values: m}, nil
+}
+
+func (s *stringerEnumSerializer) ID() int32 {
+	return s.id
+}
+
+func (s *stringerEnumSerializer) Read(input DataInput) interface{} {
+	name := input.ReadString()
+	v, ok := s.values[name]
+	if !ok {
+		panic(ihzerrors.NewSerializationError(fmt.Sprintf("unknown %s enum name: %q", s.t, name), nil))
+	}
+	return v
+}
+
+func (s *stringerEnumSerializer) Write(output DataOutput, object interface{}) {
+	v := object.(fmt.Stringer)
+	name := v.String()
+	if ev, ok := s.values[name]; !ok || ev != v {
+		panic(ihzerrors.NewSerializationError(fmt.Sprintf("unknown %s enum value: %#v", s.t, object), nil))
+	}
+	output.WriteString(name)
+}
@@ -0,0 +1,117 @@
+/*
+ * Copyright (c) 2008-2023, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package serialization_test
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hazelcast/hazelcast-go-client/hzerrors"
+	iserialization "github.com/hazelcast/hazelcast-go-client/internal/serialization"
+	"github.com/hazelcast/hazelcast-go-client/serialization"
+)
+
+type color int
+
+const (
+	colorRed color = iota + 1
+	colorGreen
+	colorBlue
+)
+
+func (c color) String() string {
+	switch c {
+	case colorRed:
+		return "RED"
+	case colorGreen:
+		return "GREEN"
+	case colorBlue:
+		return "BLUE"
+	}
+	return "color(" + strconv.Itoa(int(c)) + ")"
+}
+
+type level uint8
+
+const (
+	levelLow level = iota
+	levelHigh
+	levelHigher
+)
+
+func (l level) String() string {
+	if l == levelLow {
+		return "LOW"
+	}
+	return "HIGH"
+}
+
+func TestStringerEnumSerializer_RoundTrip(t *testing.T) {
+	var cfg serialization.Config
+	require.NoError(t, cfg.SetStringerEnumSerializer(100, colorRed, colorGreen, colorBlue))
+	ss, err := iserialization.NewService(&cfg, nil)
+	require.NoError(t, err)
+	for _, c := range []color{colorRed, colorGreen, colorBlue} {
+		data, err := ss.ToData(c)
+		require.NoError(t, err)
+		obj, err := ss.ToObject(data)
+		require.NoError(t, err)
+		assert.Equal(t, c, obj)
+	}
+}
+
+func TestStringerEnumSerializer_UnknownValue(t *testing.T) {
+	var cfg serialization.Config
+	require.NoError(t, cfg.SetStringerEnumSerializer(100, colorRed, colorGreen))
+	ss, err := iserialization.NewService(&cfg, nil)
+	require.NoError(t, err)
+	_, err = ss.ToData(colorBlue)
+	assert.True(t, errors.Is(err, hzerrors.ErrHazelcastSerialization))
+}
+
+func TestStringerEnumSerializer_AmbiguousName(t *testing.T) {
+	var cfg serialization.Config
+	err := cfg.SetStringerEnumSerializer(100, levelLow, levelHigh, levelHigher)
+	assert.True(t, errors.Is(err, hzerrors.ErrIllegalArgument))
+	assert.Contains(t, err.Error(), `"HIGH"`)
+}
+
+func TestStringerEnumSerializer_InvalidValues(t *testing.T) {
+	var cfg serialization.Config
+	err := cfg.SetStringerEnumSerializer(100)
+	assert.True(t, errors.Is(err, hzerrors.ErrIllegalArgument))
+	err = cfg.SetStringerEnumSerializer(100, colorRed, levelLow)
+	assert.True(t, errors.Is(err, hzerrors.ErrIllegalArgument))
+	err = cfg.SetStringerEnumSerializer(0, colorRed)
+	assert.True(t, errors.Is(err, hzerrors.ErrIllegalArgument))
+}
+
+func TestStringerEnumValues(t *testing.T) {
+	values, err := serialization.StringerEnumValues(colorRed)
+	require.NoError(t, err)
+	assert.Equal(t, []fmt.Stringer{colorRed, colorGreen, colorBlue}, values)
+	// level has no default form, so each value up to the overflow has a name
+	_, err = serialization.StringerEnumValues(levelLow)
+	require.NoError(t, err)
+	_, err = serialization.StringerEnumValues(serialization.JSON("foo"))
+	assert.True(t, errors.Is(err, hzerrors.ErrIllegalArgument))
+}
@@ -18,6 +18,7 @@ package hazelcast_test
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"sync/atomic"
 	"testing"
@@ -25,6 +26,7 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	"github.com/hazelcast/hazelcast-go-client"
+	"github.com/hazelcast/hazelcast-go-client/hzerrors"
 	"github.com/hazelcast/hazelcast-go-client/internal/it"
 )
 
@@ -121,6 +123,21 @@ func TestSet_Clear(t *testing.T) {
 	})
 }
 
+func TestSet_Contains(t *testing.T) {
+	it.SetTester(t, func(t *testing.T, s *hazelcast.Set) {
+		it.MustBool(s.Add(context.Background(), "v1"))
+		assert.Equal(t, true, it.MustBool(s.Contains(context.Background(), "v1")))
+		assert.Equal(t, false, it.MustBool(s.Contains(context.Background(), "v2")))
+	})
+}
+
+func TestSet_ContainsWithNilElement(t *testing.T) {
+	it.SetTester(t, func(t *testing.T, s *hazelcast.Set) {
+		_, err := s.Contains(context.Background(), nil)
+		assert.Error(t, err)
+	})
+}
+
 func TestSet_GetAll(t *testing.T) {
 	it.SetTester(t, func(t *testing.T, s *hazelcast.Set) {
 		it.MustValue(s.AddAll(context.Background(), "v1", "v2", "v3"))
@@ -208,3 +225,20 @@ func TestSet_Size(t *testing.T) {
 		assert.Equal(t, 3, size)
 	})
 }
+
+func TestSet_NilValue(t *testing.T) {
+	it.SetTester(t, func(t *testing.T, s *hazelcast.Set) {
+		ctx := context.Background()
+		var nilPtr *string
+		_, err := s.Add(ctx, nil)
+		assert.True(t, errors.Is(err, hzerrors.ErrIllegalArgument))
+		_, err = s.Add(ctx, nilPtr)
+		assert.True(t, errors.Is(err, hzerrors.ErrIllegalArgument))
+		_, err = s.AddAll(ctx, "value", nil)
+		assert.True(t, errors.Is(err, hzerrors.ErrIllegalArgument))
+		_, err = s.Contains(ctx, nil)
+		assert.True(t, errors.Is(err, hzerrors.ErrIllegalArgument))
+		_, err = s.Remove(ctx, nil)
+		assert.True(t, errors.Is(err, hzerrors.ErrIllegalArgument))
+	})
+}
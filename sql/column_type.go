@@ -16,6 +16,8 @@
 
 package sql
 
+import "fmt"
+
 // ColumnType SQL column type.
 type ColumnType int32
 
@@ -37,3 +39,14 @@ const (
 	ColumnTypeNull                  ColumnType = 14
 	ColumnTypeJSON                  ColumnType = 15
 )
+
+// String returns the SQL name of the column type, such as "VARCHAR" or "TIMESTAMP WITH TIME ZONE".
+func (t ColumnType) String() string {
+	if t == ColumnTypeNull {
+		return "NULL"
+	}
+	if name, ok := columnTypeNames[t]; ok {
+		return name
+	}
+	return fmt.Sprintf("ColumnType(%d)", int32(t))
+}
@@ -0,0 +1,44 @@
+/*
+ * Copyright (c) 2008-2023, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sql_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/hazelcast/hazelcast-go-client/sql"
+)
+
+func TestColumnType_String(t *testing.T) {
+	tcs := []struct {
+		target string
+		ct     sql.ColumnType
+	}{
+		{ct: sql.ColumnTypeVarchar, target: "VARCHAR"},
+		{ct: sql.ColumnTypeInt, target: "INT"},
+		{ct: sql.ColumnTypeTimestampWithTimeZone, target: "TIMESTAMP WITH TIME ZONE"},
+		{ct: sql.ColumnTypeNull, target: "NULL"},
+		{ct: sql.ColumnTypeJSON, target: "JSON"},
+		{ct: sql.ColumnType(100), target: "ColumnType(100)"},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.target, func(t *testing.T) {
+			assert.Equal(t, tc.target, tc.ct.String())
+		})
+	}
+}
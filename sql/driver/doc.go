@@ -168,22 +168,30 @@ The names in parentheses correspond to SQL types:
 
   - string (varchar)
   - int8 (tinyint)
-  - int16 (smallint)
-  - int32 (integer)
-  - int64 (bigint)
+  - int16, uint8 (smallint)
+  - int32, uint16 (integer)
+  - int, int64 (bigint)
   - bool (boolean)
   - float32 (real)
   - float64 (double)
-  - types.Decimal (decimal)
-  - time.Time not supported, use one of types.LocalDate, types.LocalTime, types.LocalDateTime or types.OffsetDateTime
+  - types.Decimal, *big.Int (decimal)
+  - time.Time (timestamp with time zone)
   - types.LocalDate (date)
   - types.LocalTime (time)
   - types.LocalDateTime (timestamp)
   - types.OffsetDateTime (timestamp with time zone)
   - serialization.JSON (json)
 
+Parameters of type int and *big.Int are converted to int64 and types.Decimal respectively before they are sent to the member.
+Pointers to types.Decimal, time.Time and the date/time types above are dereferenced.
+nil arguments are not allowed.
+Arguments of other types are accepted only if they can be serialized with a compact, portable, identified data serializable, custom or global serializer.
+Otherwise, they are rejected with an error which contains the position of the argument.
+Use int64 or *big.Int for uint32, uint and uint64 values.
+
 Using Date/Time Types
 
+time.Time values are sent as TIMESTAMP WITH TIME ZONE.
 In order to force using a specific date/time type, create a time.Time value and cast it to the target type:
 
 	t := time.Now()
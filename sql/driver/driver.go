@@ -40,6 +40,8 @@ func Open(config hazelcast.Config) *sql.DB {
 	_ = config.Validate()
 	icc := &client.Config{
 		Name:          config.ClientName,
+		NamePrefix:    config.ClientNamePrefix,
+		UniqueName:    config.UniqueClientName,
 		Cluster:       &config.Cluster,
 		Failover:      &config.Failover,
 		Serialization: &config.Serialization,
@@ -22,11 +22,13 @@ import (
 	"errors"
 	"fmt"
 	"math/big"
+	"reflect"
 	"sync"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	hz "github.com/hazelcast/hazelcast-go-client"
 	"github.com/hazelcast/hazelcast-go-client/cluster"
@@ -182,6 +184,34 @@ func (f recordFactory) FactoryID() int32 {
 	return factoryID
 }
 
+type CompactRecord struct {
+	Name string
+	Age  int32
+}
+
+type compactRecordSerializer struct{}
+
+func (compactRecordSerializer) Type() reflect.Type {
+	return reflect.TypeOf(CompactRecord{})
+}
+
+func (compactRecordSerializer) TypeName() string {
+	return "CompactRecord"
+}
+
+func (compactRecordSerializer) Read(reader serialization.CompactReader) interface{} {
+	return CompactRecord{
+		Name: *reader.ReadString("name"),
+		Age:  reader.ReadInt32("age"),
+	}
+}
+
+func (compactRecordSerializer) Write(writer serialization.CompactWriter, value interface{}) {
+	rec := value.(CompactRecord)
+	writer.WriteString("name", &rec.Name)
+	writer.WriteInt32("age", rec.Age)
+}
+
 func TestSQLQuery(t *testing.T) {
 	it.SkipIf(t, "hz < 5.0")
 	testCases := []struct {
@@ -295,6 +325,61 @@ func TestSQLScanJSON(t *testing.T) {
 	})
 }
 
+func TestSQLScanNulls(t *testing.T) {
+	it.SkipIf(t, "hz < 5.0")
+	it.SQLTester(t, func(t *testing.T, client *hz.Client, config *hz.Config, m *hz.Map, mapName string) {
+		ctx := context.Background()
+		db := driver.Open(*config)
+		defer db.Close()
+		ms := createMappingStr(mapName, "bigint", "varchar")
+		it.Must(createMapping(t, db, ms))
+		it.MustValue(db.Exec(fmt.Sprintf(`INSERT INTO "%s" (__key, this) VALUES(?, ?)`, mapName), 0, "zero"))
+		it.MustValue(db.Exec(fmt.Sprintf(`INSERT INTO "%s" (__key, this) VALUES(?, ?)`, mapName), 1, "null"))
+		// the row with key 0 has zero values, the row with key 1 has NULL values.
+		query := fmt.Sprintf(`
+			SELECT
+				__key,
+				CASE WHEN __key = 1 THEN NULL ELSE CAST(0 AS INTEGER) END,
+				CASE WHEN __key = 1 THEN NULL ELSE CAST(0 AS DOUBLE) END,
+				CASE WHEN __key = 1 THEN NULL ELSE '' END,
+				CASE WHEN __key = 1 THEN NULL ELSE CAST('2023-01-02' AS DATE) END,
+				CASE WHEN __key = 1 THEN NULL ELSE CAST('2023-01-02T03:04:05' AS TIMESTAMP) END
+			FROM "%s" ORDER BY __key`, mapName)
+		rows := mustRows(db.QueryContext(ctx, query))
+		defer rows.Close()
+		var key int64
+		var i sql.NullInt32
+		var f *float64
+		var s sql.NullString
+		var d sql.NullTime
+		var ts *time.Time
+		require.True(t, rows.Next())
+		if err := rows.Scan(&key, &i, &f, &s, &d, &ts); err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, sql.NullInt32{Int32: 0, Valid: true}, i)
+		require.NotNil(t, f)
+		assert.Equal(t, float64(0), *f)
+		assert.Equal(t, sql.NullString{String: "", Valid: true}, s)
+		assert.True(t, d.Valid)
+		assert.Equal(t, 2023, d.Time.Year())
+		require.NotNil(t, ts)
+		assert.Equal(t, 5, ts.Second())
+		require.True(t, rows.Next())
+		if err := rows.Scan(&key, &i, &f, &s, &d, &ts); err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, int64(1), key)
+		assert.False(t, i.Valid)
+		assert.Nil(t, f)
+		assert.False(t, s.Valid)
+		assert.False(t, d.Valid)
+		assert.Nil(t, ts)
+		assert.False(t, rows.Next())
+		require.NoError(t, rows.Err())
+	})
+}
+
 func TestSQLWithPortableData(t *testing.T) {
 	it.SkipIf(t, "hz < 5.0")
 	cb := func(c *hz.Config) {
@@ -387,6 +472,48 @@ func TestSQLWithPortableData(t *testing.T) {
 	})
 }
 
+func TestSQLWithCompactData(t *testing.T) {
+	it.SkipIf(t, "hz < 5.2")
+	cb := func(c *hz.Config) {
+		c.Serialization.Compact.SetSerializers(compactRecordSerializer{})
+	}
+	it.SQLTesterWithConfigBuilder(t, cb, func(t *testing.T, client *hz.Client, config *hz.Config, m *hz.Map, mapName string) {
+		db := driver.Open(*config)
+		defer db.Close()
+		it.MustValue(db.Exec(fmt.Sprintf(`
+			CREATE MAPPING "%s" (
+				__key BIGINT,
+				name VARCHAR,
+				age INTEGER
+			)
+			TYPE IMAP
+			OPTIONS (
+				'keyFormat' = 'bigint',
+				'valueFormat' = 'compact',
+				'valueCompactTypeName' = 'CompactRecord'
+			)
+		`, mapName)))
+		rec := CompactRecord{Name: "Ford Prefect", Age: 42}
+		it.Must(m.Set(context.Background(), int64(1), rec))
+		// select the value itself
+		row := db.QueryRow(fmt.Sprintf(`SELECT __key, this FROM "%s"`, mapName))
+		var k int64
+		var v interface{}
+		if err := row.Scan(&k, &v); err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, rec, v)
+		// select individual fields
+		row = db.QueryRow(fmt.Sprintf(`SELECT __key, name, age FROM "%s"`, mapName))
+		var name string
+		var age int32
+		if err := row.Scan(&k, &name, &age); err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, []interface{}{int64(1), "Ford Prefect", int32(42)}, []interface{}{k, name, age})
+	})
+}
+
 func TestSQLWithPortableDateTime(t *testing.T) {
 	it.SkipIf(t, "hz < 5.0")
 	cb := func(c *hz.Config) {
@@ -468,6 +595,120 @@ func TestSQLWithPortableDateTime(t *testing.T) {
 	})
 }
 
+func TestSQLTemporalParameters(t *testing.T) {
+	it.SkipIf(t, "hz < 5.0")
+	dt := time.Date(2021, 12, 22, 23, 40, 12, 3400, time.FixedZone("", -5*60*60))
+	localDate := types.LocalDate(time.Date(2021, 12, 22, 0, 0, 0, 0, time.Local))
+	localTime := types.LocalTime(time.Date(0, 1, 1, 23, 40, 12, 3400, time.Local))
+	localDateTime := types.LocalDateTime(time.Date(2021, 12, 22, 23, 40, 12, 3400, time.Local))
+	offsetDateTime := types.OffsetDateTime(dt)
+	testCases := []struct {
+		param     interface{}
+		target    time.Time
+		valueFmt  string
+		valueScan func(row *sql.Row) (time.Time, error)
+	}{
+		{
+			valueFmt: "date",
+			param:    localDate,
+			target:   time.Time(localDate),
+			valueScan: func(row *sql.Row) (time.Time, error) {
+				var v types.LocalDate
+				err := row.Scan(&v)
+				return time.Time(v), err
+			},
+		},
+		{
+			valueFmt: "time",
+			param:    &localTime,
+			target:   time.Time(localTime),
+			valueScan: func(row *sql.Row) (time.Time, error) {
+				var v types.LocalTime
+				err := row.Scan(&v)
+				return time.Time(v), err
+			},
+		},
+		{
+			valueFmt: "timestamp",
+			param:    localDateTime,
+			target:   time.Time(localDateTime),
+			valueScan: func(row *sql.Row) (time.Time, error) {
+				var v types.LocalDateTime
+				err := row.Scan(&v)
+				return time.Time(v), err
+			},
+		},
+		{
+			valueFmt: "timestamp with time zone",
+			param:    &offsetDateTime,
+			target:   dt,
+			valueScan: func(row *sql.Row) (time.Time, error) {
+				var v types.OffsetDateTime
+				err := row.Scan(&v)
+				return time.Time(v), err
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.valueFmt, func(t *testing.T) {
+			it.SQLTester(t, func(t *testing.T, client *hz.Client, config *hz.Config, m *hz.Map, mapName string) {
+				db := driver.Open(*config)
+				defer db.Close()
+				it.Must(createMapping(t, db, createMappingStr(mapName, "bigint", tc.valueFmt)))
+				it.MustValue(db.Exec(fmt.Sprintf(`INSERT INTO "%s" (__key, this) VALUES(?, ?)`, mapName), 1, tc.param))
+				row := db.QueryRow(fmt.Sprintf(`SELECT this FROM "%s" WHERE this = ?`, mapName), tc.param)
+				v, err := tc.valueScan(row)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if !tc.target.Equal(v) {
+					t.Fatalf("%v != %v", tc.target, v)
+				}
+			})
+		})
+	}
+}
+
+func TestSQLColumnTypes(t *testing.T) {
+	it.SkipIf(t, "hz < 5.0")
+	it.SQLTester(t, func(t *testing.T, client *hz.Client, config *hz.Config, m *hz.Map, mapName string) {
+		db := driver.Open(*config)
+		defer db.Close()
+		it.Must(createMapping(t, db, createMappingStr(mapName, "bigint", "varchar")))
+		it.MustValue(db.Exec(fmt.Sprintf(`INSERT INTO "%s" (__key, this) VALUES(?, ?)`, mapName), 1, "foo"))
+		rows := mustRows(db.Query(fmt.Sprintf(`SELECT __key, this, CAST(__key AS DECIMAL), CAST(__key AS INT) FROM "%s"`, mapName)))
+		defer rows.Close()
+		cts, err := rows.ColumnTypes()
+		if err != nil {
+			t.Fatal(err)
+		}
+		var names []string
+		var scanTypes []reflect.Type
+		for _, ct := range cts {
+			names = append(names, ct.DatabaseTypeName())
+			scanTypes = append(scanTypes, ct.ScanType())
+			_, ok := ct.Nullable()
+			assert.True(t, ok)
+		}
+		assert.Equal(t, []string{"BIGINT", "VARCHAR", "DECIMAL", "INT"}, names)
+		assert.Equal(t, []reflect.Type{reflect.TypeOf(int64(0)), reflect.TypeOf(""), reflect.TypeOf(types.Decimal{}), reflect.TypeOf(int32(0))}, scanTypes)
+	})
+}
+
+func TestSQLUnsupportedParameter(t *testing.T) {
+	it.SkipIf(t, "hz < 5.0")
+	it.SQLTester(t, func(t *testing.T, client *hz.Client, config *hz.Config, m *hz.Map, mapName string) {
+		db := driver.Open(*config)
+		defer db.Close()
+		it.Must(createMapping(t, db, createMappingStr(mapName, "bigint", "bigint")))
+		_, err := db.Exec(fmt.Sprintf(`INSERT INTO "%s" (__key, this) VALUES(?, ?)`, mapName), 1, uint64(42))
+		if !assert.Error(t, err) {
+			t.FailNow()
+		}
+		assert.Contains(t, err.Error(), "at position 2")
+	})
+}
+
 func TestSQLQueryWithCursorBufferSize(t *testing.T) {
 	it.SkipIf(t, "hz < 5.0")
 	fn := func(i int) interface{} { return int32(i) }
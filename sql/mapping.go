@@ -0,0 +1,187 @@
+/*
+ * Copyright (c) 2008-2023, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sql
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	ihzerrors "github.com/hazelcast/hazelcast-go-client/internal/hzerrors"
+)
+
+// MappingFormat is the serialization format of the key or the value of a mapping.
+type MappingFormat string
+
+const (
+	// MappingFormatJSONFlat maps the top level fields of a JSON object to columns.
+	MappingFormatJSONFlat MappingFormat = "json-flat"
+	// MappingFormatJSON maps the JSON value to a single column of JSON type.
+	MappingFormatJSON MappingFormat = "json"
+	// MappingFormatCompact maps the fields of a Compact serialized value to columns.
+	// The type name must be specified with the keyCompactTypeName or valueCompactTypeName option.
+	MappingFormatCompact MappingFormat = "compact"
+	// MappingFormatPortable maps the fields of a Portable value to columns.
+	// The factory and class IDs must be specified with the keyPortableFactoryId, keyPortableClassId or valuePortableFactoryId, valuePortableClassId options.
+	MappingFormatPortable MappingFormat = "portable"
+	// MappingFormatVarchar maps a string value to a single column of VARCHAR type.
+	MappingFormatVarchar MappingFormat = "varchar"
+	// MappingFormatInt maps an int32 value to a single column of INT type.
+	MappingFormatInt MappingFormat = "int"
+	// MappingFormatBigInt maps an int64 value to a single column of BIGINT type.
+	MappingFormatBigInt MappingFormat = "bigint"
+	// MappingFormatDouble maps a float64 value to a single column of DOUBLE type.
+	MappingFormatDouble MappingFormat = "double"
+)
+
+var columnTypeNames = map[ColumnType]string{
+	ColumnTypeVarchar:               "VARCHAR",
+	ColumnTypeBoolean:               "BOOLEAN",
+	ColumnTypeTinyInt:               "TINYINT",
+	ColumnTypeSmallInt:              "SMALLINT",
+	ColumnTypeInt:                   "INT",
+	ColumnTypeBigInt:                "BIGINT",
+	ColumnTypeDecimal:               "DECIMAL",
+	ColumnTypeReal:                  "REAL",
+	ColumnTypeDouble:                "DOUBLE",
+	ColumnTypeDate:                  "DATE",
+	ColumnTypeTime:                  "TIME",
+	ColumnTypeTimestamp:             "TIMESTAMP",
+	ColumnTypeTimestampWithTimeZone: "TIMESTAMP WITH TIME ZONE",
+	ColumnTypeObject:                "OBJECT",
+	ColumnTypeJSON:                  "JSON",
+}
+
+// MappingColumn is a column of a mapping.
+type MappingColumn struct {
+	// Name is the name of the column.
+	// Use "__key" and "this" to refer to the whole key and value respectively.
+	Name string
+	// Type is the SQL type of the column.
+	Type ColumnType
+}
+
+/*
+Mapping describes an SQL mapping for a Hazelcast map.
+Use the DDL method to generate the corresponding CREATE MAPPING statement, or CreateMapping to run it.
+
+The following describes a map with BIGINT keys and JSON values with the "name" and "age" fields:
+
+	m := sql.Mapping{
+		MapName:     "employees",
+		KeyFormat:   sql.MappingFormatBigInt,
+		ValueFormat: sql.MappingFormatJSONFlat,
+		Columns: []sql.MappingColumn{
+			{Name: "__key", Type: sql.ColumnTypeBigInt},
+			{Name: "name", Type: sql.ColumnTypeVarchar},
+			{Name: "age", Type: sql.ColumnTypeInt},
+		},
+	}
+*/
+type Mapping struct {
+	// Options contains additional mapping options, such as valueCompactTypeName.
+	Options map[string]string
+	// MapName is the name of the map.
+	MapName string
+	// KeyFormat is the serialization format of the keys.
+	KeyFormat MappingFormat
+	// ValueFormat is the serialization format of the values.
+	ValueFormat MappingFormat
+	// Columns are the columns of the mapping.
+	// If no columns are specified, the columns are resolved by the member, which requires at least one entry in the map.
+	Columns []MappingColumn
+	// IfNotExists prevents failing if a mapping with the same name already exists.
+	IfNotExists bool
+}
+
+// DDL returns the CREATE MAPPING statement for this mapping.
+func (m Mapping) DDL() (string, error) {
+	if m.MapName == "" {
+		return "", ihzerrors.NewIllegalArgumentError("mapping: map name cannot be empty", nil)
+	}
+	if m.KeyFormat == "" {
+		return "", ihzerrors.NewIllegalArgumentError("mapping: key format cannot be empty", nil)
+	}
+	if m.ValueFormat == "" {
+		return "", ihzerrors.NewIllegalArgumentError("mapping: value format cannot be empty", nil)
+	}
+	var sb strings.Builder
+	sb.WriteString("CREATE MAPPING ")
+	if m.IfNotExists {
+		sb.WriteString("IF NOT EXISTS ")
+	}
+	sb.WriteString(quoteIdentifier(m.MapName))
+	if len(m.Columns) > 0 {
+		cols := make([]string, len(m.Columns))
+		for i, c := range m.Columns {
+			if c.Name == "" {
+				return "", ihzerrors.NewIllegalArgumentError("mapping: column name cannot be empty", nil)
+			}
+			tn, ok := columnTypeNames[c.Type]
+			if !ok {
+				return "", ihzerrors.NewIllegalArgumentError(fmt.Sprintf("mapping: invalid type for column %s", c.Name), nil)
+			}
+			cols[i] = fmt.Sprintf("%s %s", quoteIdentifier(c.Name), tn)
+		}
+		sb.WriteString(" (")
+		sb.WriteString(strings.Join(cols, ", "))
+		sb.WriteString(")")
+	}
+	sb.WriteString(" TYPE IMap OPTIONS (")
+	opts := []string{
+		fmt.Sprintf("%s = %s", quoteLiteral("keyFormat"), quoteLiteral(string(m.KeyFormat))),
+		fmt.Sprintf("%s = %s", quoteLiteral("valueFormat"), quoteLiteral(string(m.ValueFormat))),
+	}
+	// sorting the option keys, so the output is deterministic.
+	keys := make([]string, 0, len(m.Options))
+	for k := range m.Options {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		opts = append(opts, fmt.Sprintf("%s = %s", quoteLiteral(k), quoteLiteral(m.Options[k])))
+	}
+	sb.WriteString(strings.Join(opts, ", "))
+	sb.WriteString(")")
+	return sb.String(), nil
+}
+
+// CreateMapping creates the given mapping using the SQL service.
+func CreateMapping(ctx context.Context, s Service, m Mapping) error {
+	ddl, err := m.DDL()
+	if err != nil {
+		return err
+	}
+	stmt := NewStatement(ddl)
+	if err := stmt.SetExpectedResultType(ExpectedResultTypeUpdateCount); err != nil {
+		return err
+	}
+	res, err := s.ExecuteStatement(ctx, stmt)
+	if err != nil {
+		return fmt.Errorf("creating mapping: %w", err)
+	}
+	return res.Close()
+}
+
+func quoteIdentifier(s string) string {
+	return `"` + strings.ReplaceAll(s, `"`, `""`) + `"`
+}
+
+func quoteLiteral(s string) string {
+	return `'` + strings.ReplaceAll(s, `'`, `''`) + `'`
+}
@@ -0,0 +1,120 @@
+/*
+ * Copyright (c) 2008-2023, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sql_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/hazelcast/hazelcast-go-client/hzerrors"
+	"github.com/hazelcast/hazelcast-go-client/sql"
+)
+
+func TestMapping_DDL(t *testing.T) {
+	testCases := []struct {
+		name    string
+		target  string
+		mapping sql.Mapping
+	}{
+		{
+			name: "json value",
+			mapping: sql.Mapping{
+				MapName:     "employees",
+				KeyFormat:   sql.MappingFormatBigInt,
+				ValueFormat: sql.MappingFormatJSONFlat,
+				Columns: []sql.MappingColumn{
+					{Name: "__key", Type: sql.ColumnTypeBigInt},
+					{Name: "name", Type: sql.ColumnTypeVarchar},
+					{Name: "age", Type: sql.ColumnTypeInt},
+				},
+			},
+			target: `CREATE MAPPING "employees" ("__key" BIGINT, "name" VARCHAR, "age" INT) TYPE IMap OPTIONS ('keyFormat' = 'bigint', 'valueFormat' = 'json-flat')`,
+		},
+		{
+			name: "if not exists without columns",
+			mapping: sql.Mapping{
+				MapName:     "my-map",
+				KeyFormat:   sql.MappingFormatVarchar,
+				ValueFormat: sql.MappingFormatJSON,
+				IfNotExists: true,
+			},
+			target: `CREATE MAPPING IF NOT EXISTS "my-map" TYPE IMap OPTIONS ('keyFormat' = 'varchar', 'valueFormat' = 'json')`,
+		},
+		{
+			name: "compact value with options",
+			mapping: sql.Mapping{
+				MapName:     `quoted"map`,
+				KeyFormat:   sql.MappingFormatInt,
+				ValueFormat: sql.MappingFormatCompact,
+				Columns: []sql.MappingColumn{
+					{Name: "created", Type: sql.ColumnTypeTimestampWithTimeZone},
+				},
+				Options: map[string]string{
+					"valueCompactTypeName": "person",
+					"comment":              "it's",
+				},
+			},
+			target: `CREATE MAPPING "quoted""map" ("created" TIMESTAMP WITH TIME ZONE) TYPE IMap OPTIONS ('keyFormat' = 'int', 'valueFormat' = 'compact', 'comment' = 'it''s', 'valueCompactTypeName' = 'person')`,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ddl, err := tc.mapping.DDL()
+			if err != nil {
+				t.Fatal(err)
+			}
+			assert.Equal(t, tc.target, ddl)
+		})
+	}
+}
+
+func TestMapping_DDLInvalid(t *testing.T) {
+	testCases := []struct {
+		name    string
+		mapping sql.Mapping
+	}{
+		{
+			name:    "no map name",
+			mapping: sql.Mapping{KeyFormat: sql.MappingFormatInt, ValueFormat: sql.MappingFormatJSON},
+		},
+		{
+			name:    "no key format",
+			mapping: sql.Mapping{MapName: "m", ValueFormat: sql.MappingFormatJSON},
+		},
+		{
+			name:    "no value format",
+			mapping: sql.Mapping{MapName: "m", KeyFormat: sql.MappingFormatInt},
+		},
+		{
+			name: "invalid column type",
+			mapping: sql.Mapping{
+				MapName:     "m",
+				KeyFormat:   sql.MappingFormatInt,
+				ValueFormat: sql.MappingFormatJSONFlat,
+				Columns:     []sql.MappingColumn{{Name: "foo", Type: sql.ColumnTypeNull}},
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := tc.mapping.DDL()
+			assert.True(t, errors.Is(err, hzerrors.ErrIllegalArgument))
+		})
+	}
+}
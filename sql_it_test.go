@@ -28,6 +28,7 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	hz "github.com/hazelcast/hazelcast-go-client"
 	"github.com/hazelcast/hazelcast-go-client/hzerrors"
@@ -51,17 +52,23 @@ func TestSQL(t *testing.T) {
 		f    func(t *testing.T)
 	}{
 		{name: "ConcurrentQueries", f: sqlConcurrentQueriesTest},
+		{name: "CreateMapping", f: sqlCreateMappingTest},
+		{name: "GoNativeParams", f: sqlGoNativeParamsTest},
 		{name: "Query", f: sqlQueryTest},
 		{name: "QueryWithCursorBufferSize", f: sqlQueryWithCursorBufferSizeTest},
 		{name: "ResultForRowAndNonRowResults", f: sqlResultForRowAndNonRowResultsTest},
 		{name: "ResultIteratorRequestedMoreThanOnce", f: sqlResultIteratorRequestedMoreThanOnceTest},
 		{name: "RowFindByColumnName", f: sqlRowFindByColumnNameTest},
+		{name: "RowMetadataBeforeRows", f: sqlRowMetadataBeforeRowsTest},
 		{name: "ServiceExecute", f: sqlServiceExecuteTest},
 		{name: "ServiceExecuteMismatchExpectedResultType", f: sqlServiceExecuteMismatchExpectedResultTypeTest},
 		{name: "ServiceExecuteMismatchedParams", f: sqlServiceExecuteMismatchedParamsTest},
 		{name: "ServiceExecuteProvidedSuggestion", f: sqlServiceExecuteProvidedSuggestionTest},
 		{name: "ServiceExecuteStatementMismatchedParams", f: sqlServiceExecuteStatementMismatchedParamsTest},
 		{name: "StatementWithQueryTimeout", f: sqlStatementWithQueryTimeoutTest},
+		{name: "CancelContextDuringFetch", f: sqlCancelContextDuringFetchTest},
+		{name: "ConnectionLostBetweenPages", f: sqlConnectionLostBetweenPagesTest},
+		{name: "WithCompactData", f: sqlWithCompactDataTest},
 		{name: "WithPortableData", f: sqlWithPortableDataTest},
 		{name: "WithPortableDateTime", f: sqlWithPortableDateTimeTest},
 	}
@@ -209,6 +216,34 @@ func (f recordFactory) FactoryID() int32 {
 	return factoryID
 }
 
+type CompactRecord struct {
+	Name string
+	Age  int32
+}
+
+type compactRecordSerializer struct{}
+
+func (compactRecordSerializer) Type() reflect.Type {
+	return reflect.TypeOf(CompactRecord{})
+}
+
+func (compactRecordSerializer) TypeName() string {
+	return "CompactRecord"
+}
+
+func (compactRecordSerializer) Read(reader serialization.CompactReader) interface{} {
+	return CompactRecord{
+		Name: *reader.ReadString("name"),
+		Age:  reader.ReadInt32("age"),
+	}
+}
+
+func (compactRecordSerializer) Write(writer serialization.CompactWriter, value interface{}) {
+	rec := value.(CompactRecord)
+	writer.WriteString("name", &rec.Name)
+	writer.WriteInt32("age", rec.Age)
+}
+
 func sqlQueryTest(t *testing.T) {
 	it.SkipIf(t, "hz < 5.0")
 	testCases := []struct {
@@ -295,6 +330,53 @@ func sqlQueryTest(t *testing.T) {
 	}
 }
 
+func sqlWithCompactDataTest(t *testing.T) {
+	it.SkipIf(t, "hz < 5.2")
+	cb := func(c *hz.Config) {
+		c.Serialization.Compact.SetSerializers(compactRecordSerializer{})
+	}
+	it.SQLTesterWithConfigBuilder(t, cb, func(t *testing.T, client *hz.Client, config *hz.Config, m *hz.Map, mapName string) {
+		ctx := context.Background()
+		it.MustValue(client.SQL().Execute(ctx, fmt.Sprintf(`
+			CREATE MAPPING "%s" (
+				__key BIGINT,
+				name VARCHAR,
+				age INTEGER
+			)
+			TYPE IMAP
+			OPTIONS (
+				'keyFormat' = 'bigint',
+				'valueFormat' = 'compact',
+				'valueCompactTypeName' = 'CompactRecord'
+			)
+		`, mapName)))
+		rec := CompactRecord{Name: "Ford Prefect", Age: 42}
+		it.Must(m.Set(ctx, int64(1), rec))
+		// select the value itself
+		row, err := queryRow(client, fmt.Sprintf(`SELECT __key, this FROM "%s"`, mapName))
+		if err != nil {
+			t.Fatal(err)
+		}
+		var k int64
+		var v interface{}
+		if err := assignValues(row, &k, &v); err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, rec, v)
+		// select individual fields
+		row, err = queryRow(client, fmt.Sprintf(`SELECT __key, name, age FROM "%s"`, mapName))
+		if err != nil {
+			t.Fatal(err)
+		}
+		var name string
+		var age int32
+		if err := assignValues(row, &k, &name, &age); err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, []interface{}{int64(1), "Ford Prefect", int32(42)}, []interface{}{k, name, age})
+	})
+}
+
 func sqlWithPortableDataTest(t *testing.T) {
 	it.SkipIf(t, "hz < 5.0")
 	cb := func(c *hz.Config) {
@@ -512,6 +594,82 @@ func sqlStatementWithQueryTimeoutTest(t *testing.T) {
 	}
 }
 
+func sqlCancelContextDuringFetchTest(t *testing.T) {
+	it.SkipIf(t, "hz < 5.0")
+	it.SQLTester(t, func(t *testing.T, client *hz.Client, config *hz.Config, _ *hz.Map, _ string) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		// the stream has no data, so fetching the next page blocks until the query is canceled.
+		stmt := sql.NewStatement("select v from table(generate_stream(0))")
+		result := it.MustValue(client.SQL().ExecuteStatement(ctx, stmt)).(sql.Result)
+		defer result.Close()
+		iter := it.MustValue(result.Iterator()).(sql.RowsIterator)
+		errCh := make(chan error, 1)
+		go func() {
+			for iter.HasNext() {
+				if _, err := iter.Next(); err != nil {
+					errCh <- err
+					return
+				}
+			}
+			errCh <- nil
+		}()
+		time.Sleep(1 * time.Second)
+		cancel()
+		select {
+		case err := <-errCh:
+			assert.True(t, errors.Is(err, context.Canceled))
+		case <-time.After(5 * time.Second):
+			t.Fatalf("fetch was not aborted after the context was canceled")
+		}
+		// closing the result after the cancellation is a no-op, since the query was already closed.
+		assert.NoError(t, result.Close())
+	})
+}
+
+func sqlConnectionLostBetweenPagesTest(t *testing.T) {
+	it.SkipIf(t, "hz < 5.0, ssl")
+	ctx := context.Background()
+	port := it.NextPort()
+	tc := it.StartNewClusterWithConfig(1, it.SQLXMLConfig(t.Name(), "localhost", port), port)
+	defer tc.Shutdown()
+	config := tc.DefaultConfig()
+	client := it.MustClient(hz.StartNewClientWithConfig(ctx, config))
+	defer client.Shutdown(ctx)
+	disconnected := make(chan struct{})
+	var once sync.Once
+	it.MustValue(client.AddLifecycleListener(func(ev hz.LifecycleStateChanged) {
+		if ev.State == hz.LifecycleStateDisconnected {
+			once.Do(func() { close(disconnected) })
+		}
+	}))
+	// each page contains a single row, so every call to Next after the first one fetches a page.
+	stmt := sql.NewStatement("select v from table(generate_series(1, 100))")
+	it.Must(stmt.SetCursorBufferSize(1))
+	result := it.MustValue(client.SQL().ExecuteStatement(ctx, stmt)).(sql.Result)
+	iter := it.MustValue(result.Iterator()).(sql.RowsIterator)
+	require.True(t, iter.HasNext())
+	it.MustValue(iter.Next())
+	it.MustBool(tc.RC.TerminateMember(ctx, tc.ClusterID, tc.MemberUUIDs[0]))
+	select {
+	case <-disconnected:
+	case <-time.After(30 * time.Second):
+		t.Fatalf("the client was not disconnected")
+	}
+	start := time.Now()
+	var err error
+	for err == nil && iter.HasNext() {
+		_, err = iter.Next()
+	}
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, hzerrors.ErrTargetDisconnected), err)
+	assert.Contains(t, err.Error(), "connection lost, query aborted")
+	// the error is returned without waiting for the invocation timeout.
+	assert.Less(t, int64(time.Since(start)), int64(10*time.Second))
+	assert.NoError(t, result.Close())
+	assert.NoError(t, result.Close())
+}
+
 func sqlConcurrentQueriesTest(t *testing.T) {
 	it.SkipIf(t, "hz < 5.0")
 	it.SQLTester(t, func(t *testing.T, client *hz.Client, config *hz.Config, m *hz.Map, mapName string) {
@@ -547,6 +705,61 @@ func sqlConcurrentQueriesTest(t *testing.T) {
 	})
 }
 
+func sqlCreateMappingTest(t *testing.T) {
+	it.SkipIf(t, "hz < 5.0")
+	it.SQLTester(t, func(t *testing.T, client *hz.Client, config *hz.Config, m *hz.Map, mapName string) {
+		ctx := context.Background()
+		mapping := sql.Mapping{
+			MapName:     mapName,
+			KeyFormat:   sql.MappingFormatBigInt,
+			ValueFormat: sql.MappingFormatJSONFlat,
+			Columns: []sql.MappingColumn{
+				{Name: "__key", Type: sql.ColumnTypeBigInt},
+				{Name: "name", Type: sql.ColumnTypeVarchar},
+			},
+		}
+		it.Must(sql.CreateMapping(ctx, client.SQL(), mapping))
+		it.Must(m.Set(ctx, int64(1), serialization.JSON(`{"name":"Ford Prefect"}`)))
+		row := it.MustValue(queryRow(client, fmt.Sprintf(`SELECT name FROM "%s" WHERE __key = 1`, mapName))).(sql.Row)
+		var name string
+		it.Must(assignValues(row, &name))
+		assert.Equal(t, "Ford Prefect", name)
+	})
+}
+
+func sqlGoNativeParamsTest(t *testing.T) {
+	it.SkipIf(t, "hz < 5.0")
+	it.SQLTester(t, func(t *testing.T, client *hz.Client, config *hz.Config, m *hz.Map, mapName string) {
+		ctx := context.Background()
+		mapping := sql.Mapping{
+			MapName:     mapName,
+			KeyFormat:   sql.MappingFormatBigInt,
+			ValueFormat: sql.MappingFormatJSONFlat,
+			Columns: []sql.MappingColumn{
+				{Name: "__key", Type: sql.ColumnTypeBigInt},
+				{Name: "created", Type: sql.ColumnTypeTimestampWithTimeZone},
+				{Name: "amount", Type: sql.ColumnTypeDecimal},
+				{Name: "note", Type: sql.ColumnTypeVarchar},
+			},
+		}
+		it.Must(sql.CreateMapping(ctx, client.SQL(), mapping))
+		created := time.Date(2023, 2, 3, 4, 5, 6, 0, time.FixedZone("", 3*60*60))
+		amount := big.NewInt(1234567890)
+		var note *string
+		q := fmt.Sprintf(`INSERT INTO "%s" (__key, created, amount, note) VALUES (?, ?, ?, ?)`, mapName)
+		res := it.MustValue(client.SQL().Execute(ctx, q, 42, created, amount, note)).(sql.Result)
+		it.Must(res.Close())
+		q = fmt.Sprintf(`SELECT __key, created, amount, note FROM "%s" WHERE __key = ?`, mapName)
+		row := it.MustValue(queryRow(client, q, 42)).(sql.Row)
+		assert.Equal(t, int64(42), it.MustValue(row.Get(0)))
+		assert.True(t, created.Equal(time.Time(it.MustValue(row.Get(1)).(types.OffsetDateTime))))
+		dec := it.MustValue(row.Get(2)).(types.Decimal)
+		assert.Equal(t, 0, amount.Cmp(dec.UnscaledValue()))
+		assert.Equal(t, 0, dec.Scale())
+		assert.Nil(t, it.MustValue(row.Get(3)))
+	})
+}
+
 func sqlServiceExecuteTest(t *testing.T) {
 	it.SkipIf(t, "hz < 5.0")
 	it.SQLTester(t, func(t *testing.T, client *hz.Client, config *hz.Config, m *hz.Map, mapName string) {
@@ -960,3 +1173,51 @@ func assignValues(row sql.Row, targets ...interface{}) error {
 	}
 	return nil
 }
+
+func sqlRowMetadataBeforeRowsTest(t *testing.T) {
+	it.SkipIf(t, "hz < 5.0")
+	it.SQLTester(t, func(t *testing.T, client *hz.Client, config *hz.Config, m *hz.Map, mapName string) {
+		ctx := context.Background()
+		it.MustValue(client.SQL().Execute(ctx, fmt.Sprintf(`
+			CREATE MAPPING "%s" TYPE IMAP
+			OPTIONS (
+				'keyFormat' = 'bigint',
+				'valueFormat' = 'varchar'
+			)`, mapName)))
+		it.Must(m.Set(ctx, int64(1), "foo"))
+		q := fmt.Sprintf(`
+			SELECT
+				__key,
+				this,
+				CAST(__key AS INTEGER) AS i,
+				CAST(__key AS DOUBLE) AS d,
+				CAST(__key AS DECIMAL) AS dec,
+				__key > 0 AS b,
+				CAST('2023-01-02' AS DATE) AS dt,
+				CAST('2023-01-02T03:04:05' AS TIMESTAMP) AS ts
+			FROM "%s"`, mapName)
+		result := it.MustValue(client.SQL().Execute(ctx, q)).(sql.Result)
+		defer result.Close()
+		// the metadata is available before the rows are read
+		md := it.MustValue(result.RowMetadata()).(sql.RowMetadata)
+		target := []struct {
+			name string
+			ct   sql.ColumnType
+		}{
+			{name: "__key", ct: sql.ColumnTypeBigInt},
+			{name: "this", ct: sql.ColumnTypeVarchar},
+			{name: "i", ct: sql.ColumnTypeInt},
+			{name: "d", ct: sql.ColumnTypeDouble},
+			{name: "dec", ct: sql.ColumnTypeDecimal},
+			{name: "b", ct: sql.ColumnTypeBoolean},
+			{name: "dt", ct: sql.ColumnTypeDate},
+			{name: "ts", ct: sql.ColumnTypeTimestamp},
+		}
+		require.Equal(t, len(target), md.ColumnCount())
+		for i, tc := range target {
+			col := it.MustValue(md.GetColumn(i)).(sql.ColumnMetadata)
+			assert.Equal(t, tc.name, col.Name())
+			assert.Equal(t, tc.ct, col.Type(), "column %s: %s != %s", tc.name, tc.ct, col.Type())
+		}
+	})
+}
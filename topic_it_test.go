@@ -18,11 +18,17 @@ package hazelcast_test
 
 import (
 	"context"
+	"fmt"
+	"sync"
 	"sync/atomic"
 	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
 
 	hz "github.com/hazelcast/hazelcast-go-client"
 	"github.com/hazelcast/hazelcast-go-client/internal/it"
+	"github.com/hazelcast/hazelcast-go-client/types"
 )
 
 func TestTopic_Publish(t *testing.T) {
@@ -76,3 +82,105 @@ func TestTopic_PublishAll(t *testing.T) {
 		it.Never(t, func() bool { return int32(3) != atomic.LoadInt32(&handlerValue) })
 	})
 }
+
+func TestTopic_MessageListenersReceiveMessagesInOrder(t *testing.T) {
+	it.TopicTester(t, func(t *testing.T, tp *hz.Topic) {
+		ctx := context.Background()
+		const messageCount = 100
+		type receiver struct {
+			mu       *sync.Mutex
+			messages []interface{}
+		}
+		receivers := []*receiver{{mu: &sync.Mutex{}}, {mu: &sync.Mutex{}}}
+		for _, r := range receivers {
+			r := r
+			sid, err := tp.AddMessageListener(ctx, func(event *hz.MessagePublished) {
+				r.mu.Lock()
+				r.messages = append(r.messages, event.Value)
+				r.mu.Unlock()
+				assert.Equal(t, tp.Name(), event.TopicName)
+				assert.False(t, event.PublishTime.IsZero())
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer tp.RemoveMessageListener(ctx, sid)
+		}
+		target := make([]interface{}, messageCount)
+		for i := 0; i < messageCount; i++ {
+			target[i] = fmt.Sprintf("message-%d", i)
+			if err := tp.Publish(ctx, target[i]); err != nil {
+				t.Fatal(err)
+			}
+		}
+		for _, r := range receivers {
+			r := r
+			it.Eventually(t, func() bool {
+				r.mu.Lock()
+				defer r.mu.Unlock()
+				return len(r.messages) == messageCount
+			})
+			r.mu.Lock()
+			assert.Equal(t, target, r.messages)
+			r.mu.Unlock()
+		}
+	})
+}
+
+func TestTopic_SlowMessageListenerDoesNotBlockOthers(t *testing.T) {
+	it.TopicTester(t, func(t *testing.T, tp *hz.Topic) {
+		ctx := context.Background()
+		releaseCh := make(chan struct{})
+		defer close(releaseCh)
+		_, err := tp.AddMessageListener(ctx, func(event *hz.MessagePublished) {
+			<-releaseCh
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		var count int32
+		_, err = tp.AddMessageListener(ctx, func(event *hz.MessagePublished) {
+			atomic.AddInt32(&count, 1)
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		for i := 0; i < 3; i++ {
+			if err := tp.Publish(ctx, i); err != nil {
+				t.Fatal(err)
+			}
+		}
+		it.Eventually(t, func() bool {
+			return atomic.LoadInt32(&count) == 3
+		})
+	})
+}
+
+func TestTopic_RemoveMessageListenerFromHandler(t *testing.T) {
+	it.TopicTester(t, func(t *testing.T, tp *hz.Topic) {
+		ctx := context.Background()
+		var count int32
+		var sid atomic.Value
+		id, err := tp.AddMessageListener(ctx, func(event *hz.MessagePublished) {
+			atomic.AddInt32(&count, 1)
+			if err := tp.RemoveMessageListener(ctx, sid.Load().(types.UUID)); err != nil {
+				panic(err)
+			}
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		sid.Store(id)
+		if err := tp.Publish(ctx, "m1"); err != nil {
+			t.Fatal(err)
+		}
+		it.Eventually(t, func() bool {
+			return atomic.LoadInt32(&count) == 1
+		})
+		if err := tp.Publish(ctx, "m2"); err != nil {
+			t.Fatal(err)
+		}
+		time.Sleep(500 * time.Millisecond)
+		assert.Equal(t, int32(1), atomic.LoadInt32(&count))
+	})
+}
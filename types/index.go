@@ -16,30 +16,49 @@
 
 package types
 
+// IndexType is the type of a map index.
 type IndexType int32
 
 const (
+	// IndexTypeSorted indexes the attribute values in order, so it accelerates both equality and range predicates.
 	IndexTypeSorted IndexType = 0
-	IndexTypeHash   IndexType = 1
+	// IndexTypeHash accelerates equality predicates only.
+	IndexTypeHash IndexType = 1
+	// IndexTypeBitmap accelerates equality predicates on attributes with few distinct values.
 	IndexTypeBitmap IndexType = 2
 )
 
+// UniqueKeyTransformation specifies how the unique keys of a bitmap index are transformed before indexing.
 type UniqueKeyTransformation int32
 
 const (
+	// UniqueKeyTransformationObject assigns an ID to each distinct unique key object.
 	UniqueKeyTransformationObject UniqueKeyTransformation = 0
-	UniqueKeyTransformationLong   UniqueKeyTransformation = 1
-	UniqueKeyTransformationRaw    UniqueKeyTransformation = 2
+	// UniqueKeyTransformationLong converts the integer unique keys to int64 and assigns an ID to each distinct value.
+	UniqueKeyTransformationLong UniqueKeyTransformation = 1
+	// UniqueKeyTransformationRaw converts the integer unique keys to int64 and uses the value as the ID.
+	UniqueKeyTransformationRaw UniqueKeyTransformation = 2
 )
 
+// IndexConfig is the configuration of a map index, see Map.AddIndex.
 type IndexConfig struct {
-	Name               string
-	Attributes         []string
+	// Name is the optional name of the index.
+	Name string
+	// Attributes are the names of the indexed attributes.
+	// There must be at least one attribute, and only one for bitmap indexes.
+	Attributes []string
+	// BitmapIndexOptions may only be set for bitmap indexes.
 	BitmapIndexOptions BitmapIndexOptions
-	Type               IndexType
+	// Type is the type of the index.
+	Type IndexType
 }
 
+// BitmapIndexOptions are the options of a bitmap index.
 type BitmapIndexOptions struct {
-	UniqueKey               string
+	// UniqueKey is the attribute which uniquely identifies the entries.
+	// The default is "__key", the key of the entry.
+	UniqueKey string
+	// UniqueKeyTransformation specifies how the unique keys are transformed before indexing.
+	// The default is UniqueKeyTransformationObject.
 	UniqueKeyTransformation UniqueKeyTransformation
 }
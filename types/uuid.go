@@ -30,13 +30,24 @@ type UUID struct {
 
 // NewUUID is used to generate a random UUID v4 using rand.Reader as the CSRNG.
 func NewUUID() UUID {
+	u, _ := NewUUIDFromReader(rand.Reader)
+	return u
+}
+
+// NewUUIDFromReader generates a UUID v4 using the random bytes read from the given reader.
+// The reader should be a cryptographically secure random source, such as crypto/rand.Reader.
+// Passing a reader with a fixed output, such as a math/rand.Rand with a fixed seed, results in a deterministic sequence of UUIDs, which is only useful for testing.
+// Returns the error from the reader if less than 16 bytes could be read.
+func NewUUIDFromReader(r io.Reader) (UUID, error) {
 	buf := make([]byte, 16)
-	_, _ = io.ReadFull(rand.Reader, buf)
+	if _, err := io.ReadFull(r, buf); err != nil {
+		return UUID{}, err
+	}
 	buf[6] &= 0x0f // clear version
 	buf[6] |= 0x40 // set to version 4
 	buf[8] &= 0x3f // clear variant
 	buf[8] |= 0x80 // set to IETF variant
-	return NewUUIDWith(binary.BigEndian.Uint64(buf[0:8]), binary.BigEndian.Uint64(buf[8:]))
+	return NewUUIDWith(binary.BigEndian.Uint64(buf[0:8]), binary.BigEndian.Uint64(buf[8:])), nil
 }
 
 func NewUUIDWith(mostSigBits, leastSigBits uint64) UUID {
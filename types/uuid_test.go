@@ -17,6 +17,9 @@
 package types_test
 
 import (
+	"bytes"
+	"io"
+	"math/rand"
 	"testing"
 
 	"github.com/hazelcast/hazelcast-go-client/types"
@@ -35,6 +38,36 @@ func TestNewUUID(t *testing.T) {
 	}
 }
 
+func TestNewUUIDFromReader_FixedSeed(t *testing.T) {
+	r1 := rand.New(rand.NewSource(42))
+	r2 := rand.New(rand.NewSource(42))
+	for i := 0; i < 10; i++ {
+		u1, err := types.NewUUIDFromReader(r1)
+		if err != nil {
+			t.Fatal(err)
+		}
+		u2, err := types.NewUUIDFromReader(r2)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, u1, u2)
+	}
+}
+
+func TestNewUUIDFromReader_Version4(t *testing.T) {
+	b := bytes.Repeat([]byte{0xFF}, 16)
+	uuid, err := types.NewUUIDFromReader(bytes.NewReader(b))
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "ffffffff-ffff-4fff-bfff-ffffffffffff", uuid.String())
+}
+
+func TestNewUUIDFromReader_ShortRead(t *testing.T) {
+	_, err := types.NewUUIDFromReader(bytes.NewReader(make([]byte, 15)))
+	assert.Equal(t, io.ErrUnexpectedEOF, err)
+}
+
 func TestNewUUIDWith(t *testing.T) {
 	uuid1 := types.NewUUID()
 	uuid2 := types.NewUUIDWith(uuid1.MostSignificantBits(), uuid1.LeastSignificantBits())